@@ -0,0 +1,101 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"cloud.google.com/go/spanner"
+
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/relay"
+	"product-catalog-service/internal/app/product/repo"
+	"product-catalog-service/internal/infra/publisher"
+	"product-catalog-service/internal/pkg/clock"
+
+	"github.com/joho/godotenv"
+)
+
+func main() {
+	_ = godotenv.Load()
+	p, i, d := os.Getenv("SPANNER_PROJECT_ID"), os.Getenv("SPANNER_INSTANCE_ID"), os.Getenv("SPANNER_DATABASE_ID")
+	if p == "" || i == "" || d == "" {
+		log.Fatal("Env vars missing")
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	c, err := spanner.NewClient(ctx, fmt.Sprintf("projects/%s/instances/%s/databases/%s", p, i, d))
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer c.Close()
+
+	pub, closePub, err := newPublisher()
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer closePub()
+
+	cfg := relay.DefaultConfig()
+	if v := os.Getenv("RELAY_BATCH_SIZE"); v != "" {
+		if cfg.BatchSize, err = strconv.Atoi(v); err != nil {
+			log.Fatalf("RELAY_BATCH_SIZE: %v", err)
+		}
+	}
+	if v := os.Getenv("RELAY_POLL_INTERVAL"); v != "" {
+		if cfg.PollInterval, err = time.ParseDuration(v); err != nil {
+			log.Fatalf("RELAY_POLL_INTERVAL: %v", err)
+		}
+	}
+	if v := os.Getenv("RELAY_MAX_ATTEMPTS"); v != "" {
+		if cfg.MaxAttempts, err = strconv.ParseInt(v, 10, 64); err != nil {
+			log.Fatalf("RELAY_MAX_ATTEMPTS: %v", err)
+		}
+	}
+
+	ck := clock.System{}
+	r := relay.New(repo.NewOutboxStore(c, ck), pub, ck, cfg)
+
+	log.Printf("Outbox relay started")
+	if err := r.Run(ctx); err != nil {
+		log.Fatal(err)
+	}
+	log.Printf("Outbox relay stopped")
+}
+
+// newPublisher selects the sink from RELAY_OUTPUT: empty or "stdout" writes
+// CloudEvents as JSON lines to stdout, an http(s) URL receives one POST per
+// event (RELAY_CE_MODE "structured" or "binary"), and anything else is
+// treated as a file to append to.
+func newPublisher() (contracts.Publisher, func(), error) {
+	out, source := os.Getenv("RELAY_OUTPUT"), os.Getenv("RELAY_CE_SOURCE")
+	switch {
+	case out == "" || out == "stdout":
+		return publisher.NewJSONL(os.Stdout, source), func() {}, nil
+	case strings.HasPrefix(out, "http://") || strings.HasPrefix(out, "https://"):
+		mode := publisher.Mode(os.Getenv("RELAY_CE_MODE"))
+		if mode == "" {
+			mode = publisher.ModeStructured
+		}
+		p, err := publisher.NewHTTP(&http.Client{Timeout: 10 * time.Second}, out, source, mode)
+		if err != nil {
+			return nil, nil, err
+		}
+		return p, func() {}, nil
+	}
+
+	f, err := os.OpenFile(out, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, nil, err
+	}
+	return publisher.NewJSONL(f, source), func() { _ = f.Close() }, nil
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"cloud.google.com/go/spanner"
+
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/app/product/domain/services"
+	"product-catalog-service/internal/app/product/repo"
+	"product-catalog-service/internal/app/product/repo/memrepo"
+	"product-catalog-service/internal/infra/memstore"
+	"product-catalog-service/internal/infra/spannerx"
+	"product-catalog-service/internal/pkg/clock"
+	"product-catalog-service/internal/pkg/committer"
+	"product-catalog-service/internal/pkg/pagetoken"
+)
+
+type backend struct {
+	products contracts.ProductRepo
+	outbox   contracts.OutboxRepo
+	reads    contracts.ProductReadModel
+	keys     contracts.IdempotencyRepo
+	schedule contracts.DiscountSchedule
+	rates    contracts.ExchangeRateRepo
+	history  contracts.PriceHistoryRepo
+	taxes    contracts.TaxRepo
+	lists    contracts.PriceListRepo
+	comm     committer.Committer
+	// unrelayed is set when no cmd/relay process can reach the outbox, and
+	// the server must drain it itself.
+	unrelayed contracts.OutboxStore
+	close     func()
+}
+
+// newBackend wires the storage selected by STORAGE_BACKEND: "spanner"
+// (default) or "memory", which keeps everything in process and needs no
+// emulator.
+func newBackend(ctx context.Context, kind string, ck clock.Clock, tokens *pagetoken.Codec, conv *services.CurrencyConverter, lowestPriceDays int, taxMode domain.TaxMode) (*backend, error) {
+	switch kind {
+	case "", "spanner":
+		p, i, d := os.Getenv("SPANNER_PROJECT_ID"), os.Getenv("SPANNER_INSTANCE_ID"), os.Getenv("SPANNER_DATABASE_ID")
+		if p == "" || i == "" || d == "" {
+			return nil, fmt.Errorf("env vars missing")
+		}
+		c, err := spanner.NewClient(ctx, fmt.Sprintf("projects/%s/instances/%s/databases/%s", p, i, d))
+		if err != nil {
+			return nil, err
+		}
+		return &backend{
+			products: repo.NewProductRepo(c, ck),
+			outbox:   repo.NewOutboxRepo(ck),
+			reads:    repo.NewSpannerReadModel(c, ck, tokens, conv, lowestPriceDays, taxMode),
+			keys:     repo.NewIdempotencyRepo(c),
+			schedule: repo.NewDiscountSchedule(c),
+			rates:    repo.NewExchangeRateRepo(c, ck),
+			history:  repo.NewPriceHistoryRepo(c, ck),
+			taxes:    repo.NewTaxRepo(c, ck),
+			lists:    repo.NewPriceListRepo(c, ck),
+			comm:     spannerx.NewCommitter(c),
+			close:    c.Close,
+		}, nil
+	case "memory":
+		st := memstore.NewStore()
+		return &backend{
+			products:  memrepo.NewProductRepo(st, ck),
+			outbox:    memrepo.NewOutboxRepo(ck),
+			reads:     memrepo.NewReadModel(st, ck, tokens, conv, lowestPriceDays, taxMode),
+			keys:      memrepo.NewIdempotencyRepo(st),
+			schedule:  memrepo.NewDiscountSchedule(st),
+			rates:     memrepo.NewExchangeRateRepo(st, ck),
+			history:   memrepo.NewPriceHistoryRepo(st, ck),
+			taxes:     memrepo.NewTaxRepo(st, ck),
+			lists:     memrepo.NewPriceListRepo(st, ck),
+			comm:      memstore.NewCommitter(st),
+			unrelayed: memrepo.NewOutboxStore(st, ck),
+			close:     func() {},
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown STORAGE_BACKEND %q", kind)
+	}
+}
@@ -2,71 +2,161 @@ package main
 
 import (
 	"context"
-	"fmt"
+	"crypto/rand"
 	"log"
 	"net"
 	"os"
+	"os/signal"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
 
-	"cloud.google.com/go/spanner"
 	"google.golang.org/grpc"
 
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/app/product/domain/services"
+	"product-catalog-service/internal/app/product/idempotency"
 	"product-catalog-service/internal/app/product/queries/get_product"
+	"product-catalog-service/internal/app/product/queries/list_exchange_rates"
+	"product-catalog-service/internal/app/product/queries/list_price_history"
 	"product-catalog-service/internal/app/product/queries/list_products"
-	"product-catalog-service/internal/app/product/repo"
+	"product-catalog-service/internal/app/product/queries/quote_price"
+	"product-catalog-service/internal/app/product/relay"
+	"product-catalog-service/internal/app/product/sweeper"
 	"product-catalog-service/internal/app/product/transport/grpc/product"
 	"product-catalog-service/internal/app/product/usecases/activate_product"
+	"product-catalog-service/internal/app/product/usecases/add_variant"
+	"product-catalog-service/internal/app/product/usecases/advance_discounts"
 	"product-catalog-service/internal/app/product/usecases/apply_discount"
 	"product-catalog-service/internal/app/product/usecases/archive_product"
+	"product-catalog-service/internal/app/product/usecases/change_base_price"
+	"product-catalog-service/internal/app/product/usecases/create_price_list"
 	"product-catalog-service/internal/app/product/usecases/create_product"
 	"product-catalog-service/internal/app/product/usecases/deactivate_product"
 	"product-catalog-service/internal/app/product/usecases/remove_discount"
+	"product-catalog-service/internal/app/product/usecases/remove_price"
+	"product-catalog-service/internal/app/product/usecases/remove_price_list_entry"
+	"product-catalog-service/internal/app/product/usecases/remove_variant"
+	"product-catalog-service/internal/app/product/usecases/restore_product"
+	"product-catalog-service/internal/app/product/usecases/set_category_tax_class"
+	"product-catalog-service/internal/app/product/usecases/set_exchange_rate"
+	"product-catalog-service/internal/app/product/usecases/set_identifiers"
+	"product-catalog-service/internal/app/product/usecases/set_price"
+	"product-catalog-service/internal/app/product/usecases/set_price_list_entry"
+	"product-catalog-service/internal/app/product/usecases/set_price_tiers"
+	"product-catalog-service/internal/app/product/usecases/set_tax_class"
+	"product-catalog-service/internal/app/product/usecases/set_tax_rate"
+	"product-catalog-service/internal/app/product/usecases/update_price_list"
 	"product-catalog-service/internal/app/product/usecases/update_product"
-	"product-catalog-service/internal/infra/spannerx"
+	"product-catalog-service/internal/app/product/usecases/update_variant"
+	"product-catalog-service/internal/infra/publisher"
 	"product-catalog-service/internal/pkg/clock"
-	"product-catalog-service/internal/pkg/committer"
+	"product-catalog-service/internal/pkg/pagetoken"
 	pb "product-catalog-service/proto/product/v1"
 
 	"github.com/joho/godotenv"
 )
 
-type comm struct{ c *spanner.Client }
+func main() {
+	_ = godotenv.Load()
 
-func (s *comm) Apply(ctx context.Context, p *committer.Plan) error {
-	_, err := s.c.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
-		var ms []*spanner.Mutation
-		for _, m := range p.Mutations() {
-			if sm, ok := m.(spannerx.Mutation); ok {
-				ms = append(ms, sm.M)
-			}
+	ck := clock.System{}
+
+	rounding, err := domain.ParseRounding(os.Getenv("PRICE_ROUNDING_MODE"), os.Getenv("PRICE_CHARM_ENDING"))
+	if err != nil {
+		log.Fatalf("PRICE_ROUNDING_MODE/PRICE_CHARM_ENDING: %v", err)
+	}
+	conv := services.NewCurrencyConverter(rounding)
+
+	lowestPriceDays := 30
+	if v := os.Getenv("LOWEST_PRICE_DAYS"); v != "" {
+		if lowestPriceDays, err = strconv.Atoi(v); err != nil || lowestPriceDays <= 0 {
+			log.Fatalf("LOWEST_PRICE_DAYS: want a positive number of days, got %q", v)
 		}
-		return txn.BufferWrite(ms)
-	})
-	return err
-}
+	}
 
-func main() {
-	_ = godotenv.Load()
-	p, i, d := os.Getenv("SPANNER_PROJECT_ID"), os.Getenv("SPANNER_INSTANCE_ID"), os.Getenv("SPANNER_DATABASE_ID")
-	if p == "" || i == "" || d == "" {
-		log.Fatal("Env vars missing")
+	taxMode, err := domain.ParseTaxMode(os.Getenv("PRICE_TAX_MODE"))
+	if err != nil {
+		log.Fatalf("PRICE_TAX_MODE: %v", err)
 	}
 
-	c, err := spanner.NewClient(context.Background(), fmt.Sprintf("projects/%s/instances/%s/databases/%s", p, i, d))
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	tokens := pagetoken.NewCodec(pageTokenKey())
+	b, err := newBackend(ctx, os.Getenv("STORAGE_BACKEND"), ck, tokens, conv, lowestPriceDays, taxMode)
 	if err != nil {
 		log.Fatal(err)
 	}
-	defer c.Close()
+	defer b.close()
 
-	ck, cm := clock.System{}, &comm{c}
-	pr, or := repo.NewProductRepo(c, ck), repo.NewOutboxRepo(ck)
-	rm := repo.NewSpannerReadModel(c, ck)
+	idem := idempotency.NewGuard(b.keys, ck)
+	pr, or, ph, rm, cm := b.products, b.outbox, b.history, b.reads, idem.Committer(b.comm)
 
-	h := product.NewHandler(
-		create_product.New(pr, or, cm, ck), update_product.New(pr, or, cm, ck),
-		activate_product.New(pr, or, cm, ck), deactivate_product.New(pr, or, cm, ck),
-		archive_product.New(pr, or, cm, ck), apply_discount.New(pr, or, cm, ck),
-		remove_discount.New(pr, or, cm, ck), get_product.New(rm), list_products.New(rm),
-	)
+	retention := 30 * 24 * time.Hour
+	if v := os.Getenv("ARCHIVE_RETENTION"); v != "" {
+		if retention, err = time.ParseDuration(v); err != nil {
+			log.Fatalf("ARCHIVE_RETENTION: %v", err)
+		}
+	}
+
+	sweep := sweeper.DefaultConfig()
+	if v := os.Getenv("DISCOUNT_SWEEP_INTERVAL"); v != "" {
+		if sweep.PollInterval, err = time.ParseDuration(v); err != nil {
+			log.Fatalf("DISCOUNT_SWEEP_INTERVAL: %v", err)
+		}
+	}
+	sweep.Retention = time.Duration(lowestPriceDays) * 24 * time.Hour
+	// Background workers stop with the server and are waited for, so none
+	// is cut off mid-commit by the backend closing.
+	var workers sync.WaitGroup
+	workers.Add(1)
+	go func() {
+		defer workers.Done()
+		_ = sweeper.New(b.schedule, advance_discounts.New(pr, or, ph, b.comm, ck), ck, sweep).Run(ctx)
+	}()
+	if b.unrelayed != nil {
+		pub := publisher.NewJSONL(os.Stdout, os.Getenv("RELAY_CE_SOURCE"))
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			_ = relay.New(b.unrelayed, pub, ck, relay.DefaultConfig()).Run(ctx)
+		}()
+	}
+
+	h := product.NewHandler(product.Deps{
+		CreateProduct:        create_product.New(pr, or, ph, cm, ck),
+		UpdateProduct:        update_product.New(pr, or, cm, ck),
+		ActivateProduct:      activate_product.New(pr, or, cm, ck),
+		DeactivateProduct:    deactivate_product.New(pr, or, cm, ck),
+		ArchiveProduct:       archive_product.New(pr, or, cm, ck),
+		RestoreProduct:       restore_product.New(pr, or, cm, ck, retention),
+		ApplyDiscount:        apply_discount.New(pr, or, ph, cm, ck),
+		RemoveDiscount:       remove_discount.New(pr, or, ph, cm, ck),
+		SetPrice:             set_price.New(pr, or, ph, cm, ck),
+		RemovePrice:          remove_price.New(pr, or, ph, cm, ck),
+		ChangeBasePrice:      change_base_price.New(pr, or, ph, cm, ck),
+		SetTaxClass:          set_tax_class.New(pr, or, cm, ck),
+		SetIdentifiers:       set_identifiers.New(pr, or, cm, ck),
+		SetPriceTiers:        set_price_tiers.New(pr, or, cm, ck),
+		AddVariant:           add_variant.New(pr, or, cm, ck),
+		UpdateVariant:        update_variant.New(pr, or, cm, ck),
+		RemoveVariant:        remove_variant.New(pr, or, cm, ck),
+		SetExchangeRate:      set_exchange_rate.New(b.rates, cm, ck),
+		SetTaxRate:           set_tax_rate.New(b.taxes, cm),
+		SetCategoryTaxClass:  set_category_tax_class.New(b.taxes, cm),
+		CreatePriceList:      create_price_list.New(b.lists, cm),
+		UpdatePriceList:      update_price_list.New(b.lists, cm),
+		SetPriceListEntry:    set_price_list_entry.New(b.lists, pr, cm),
+		RemovePriceListEntry: remove_price_list_entry.New(b.lists, cm),
+		GetProduct:           get_product.New(rm),
+		ListProducts:         list_products.New(rm),
+		QuotePrice:           quote_price.New(pr, b.lists, b.rates, b.taxes, conv, taxMode, ck),
+		ListPriceHistory:     list_price_history.New(ph, tokens, rounding),
+		ListExchangeRates:    list_exchange_rates.New(b.rates),
+		Idempotency:          idem,
+	})
 
 	s := grpc.NewServer()
 	pb.RegisterProductServiceServer(s, h)
@@ -78,8 +168,29 @@ func main() {
 	if err != nil {
 		log.Fatal(err)
 	}
+	go func() {
+		<-ctx.Done()
+		s.GracefulStop()
+	}()
 	log.Printf("Listening on :%s", port)
 	if err := s.Serve(l); err != nil {
 		log.Fatal(err)
 	}
+	workers.Wait()
+	log.Printf("Server stopped")
+}
+
+// pageTokenKey returns the page-token signing key. Without PAGE_TOKEN_SECRET
+// a random key is used, so tokens do not survive a restart and are not
+// accepted by other replicas.
+func pageTokenKey() []byte {
+	if v := os.Getenv("PAGE_TOKEN_SECRET"); v != "" {
+		return []byte(v)
+	}
+	log.Printf("PAGE_TOKEN_SECRET not set, using a random page token key")
+	k := make([]byte, 32)
+	if _, err := rand.Read(k); err != nil {
+		log.Fatal(err)
+	}
+	return k
 }
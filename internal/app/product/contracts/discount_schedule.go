@@ -0,0 +1,13 @@
+package contracts
+
+import (
+	"context"
+	"time"
+)
+
+// DiscountSchedule finds products whose discount schedule is behind the
+// clock: a scheduled discount has started, a discount has ended, or an
+// expired one ended before pruneBefore.
+type DiscountSchedule interface {
+	DueProducts(ctx context.Context, now, pruneBefore time.Time, limit int) ([]string, error)
+}
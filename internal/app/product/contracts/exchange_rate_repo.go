@@ -0,0 +1,20 @@
+package contracts
+
+import (
+	"context"
+	"time"
+
+	"product-catalog-service/internal/app/product/domain"
+)
+
+type ExchangeRateRepo interface {
+	// RateAt returns the from-to rate in effect at t, or
+	// domain.ErrExchangeRateNotFound.
+	RateAt(ctx context.Context, from, to domain.Currency, at time.Time) (*domain.ExchangeRate, error)
+	// List returns every stored rate, newest first within a pair; an empty
+	// from or to matches any currency.
+	List(ctx context.Context, from, to domain.Currency) ([]*domain.ExchangeRate, error)
+	// UpsertMut stores r, replacing a rate for the same pair and effective
+	// time.
+	UpsertMut(r *domain.ExchangeRate) Mutation
+}
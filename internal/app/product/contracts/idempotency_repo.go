@@ -0,0 +1,20 @@
+package contracts
+
+import (
+	"context"
+	"time"
+)
+
+type IdempotencyRecord struct {
+	Key         string
+	Operation   string
+	RequestHash string
+	Response    []byte
+	CreatedAt   time.Time
+}
+
+type IdempotencyRepo interface {
+	// Get returns nil without error when the key has not been used.
+	Get(ctx context.Context, key string) (*IdempotencyRecord, error)
+	InsertMut(rec IdempotencyRecord) Mutation
+}
@@ -0,0 +1,31 @@
+package contracts
+
+import (
+	"context"
+	"time"
+)
+
+type OutboxMessage struct {
+	EventID     string
+	EventType   string
+	AggregateID string
+	Payload     []byte
+	CreatedAt   time.Time
+	Attempts    int64
+}
+
+// OutboxStore is the relay-side view of the outbox table. Claim leases up to
+// limit pending messages until leaseUntil so that concurrent relays never
+// publish the same row twice; a lease that expires makes the row claimable
+// again.
+type OutboxStore interface {
+	Claim(ctx context.Context, limit int, leaseUntil time.Time) ([]OutboxMessage, error)
+	MarkPublished(ctx context.Context, eventID string) error
+	// MarkFailed records a failed attempt. A nil nextAttemptAt means the
+	// message has exhausted its retries and will not be claimed again.
+	MarkFailed(ctx context.Context, eventID string, attempts int64, nextAttemptAt *time.Time, lastErr string) error
+}
+
+type Publisher interface {
+	Publish(ctx context.Context, msg OutboxMessage) error
+}
@@ -0,0 +1,34 @@
+package contracts
+
+import (
+	"context"
+	"time"
+
+	"product-catalog-service/internal/app/product/domain"
+)
+
+type PriceHistoryRepo interface {
+	// List returns the product's price records in currency, or in every
+	// currency when it is empty, ordered by currency and then oldest first.
+	List(ctx context.Context, productID string, currency domain.Currency) ([]*domain.PriceRecord, error)
+	// ListPage returns up to f.Limit of the records List would, starting
+	// after f.AfterCurrency and f.AfterFrom when AfterFrom is set. With
+	// f.Since set it leaves out the records superseded by then.
+	ListPage(ctx context.Context, f PriceHistoryFilter) ([]*domain.PriceRecord, error)
+	// Latest returns the product's newest record in each currency.
+	Latest(ctx context.Context, productID string) (map[domain.Currency]*domain.PriceRecord, error)
+	// RecordMut stores r, replacing a record for the same product, currency
+	// and time.
+	RecordMut(r *domain.PriceRecord) Mutation
+}
+
+type PriceHistoryFilter struct {
+	ProductID     string
+	Currency      domain.Currency
+	AfterCurrency domain.Currency
+	AfterFrom     time.Time
+	// Since keeps, in each currency, the last record from at or before it
+	// and every later one.
+	Since time.Time
+	Limit int
+}
@@ -0,0 +1,18 @@
+package contracts
+
+import (
+	"context"
+
+	"product-catalog-service/internal/app/product/domain"
+)
+
+type PriceListRepo interface {
+	// GetByID returns the list with all its entries.
+	GetByID(ctx context.Context, id string) (*domain.PriceList, error)
+	// Select returns the lists sel picks with their entries for
+	// productIDs. A list picked by ID must exist; no selection picks
+	// nothing.
+	Select(ctx context.Context, sel PriceListSelector, productIDs []string) ([]*domain.PriceList, error)
+	InsertMut(l *domain.PriceList) Mutation
+	UpdateMut(l *domain.PriceList) Mutation
+}
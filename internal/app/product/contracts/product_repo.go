@@ -8,6 +8,12 @@ import (
 
 type ProductRepo interface {
 	GetByID(ctx context.Context, id string) (*domain.Product, error)
+	// SKUOwner returns the ID of the product that uses sku, as its own SKU
+	// or a variant's, or "" when none does.
+	SKUOwner(ctx context.Context, sku string) (string, error)
+	// GTINOwner returns the ID of the product with gtin, or "" when none
+	// has it.
+	GTINOwner(ctx context.Context, gtin domain.GTIN) (string, error)
 	InsertMut(p *domain.Product) Mutation
 	UpdateMut(p *domain.Product) Mutation
 }
@@ -3,28 +3,172 @@ package contracts
 import "context"
 
 type ProductDTO struct {
+	ID          string
+	Name        string
+	Description string
+	Category    string
+	SKU         string
+	GTIN        string
+	TaxClass    string
+	Status      string
+	// BasePriceNum/BasePriceDen and the other amounts are in Currency: the
+	// one asked for if the product is priced in it, else its base currency.
+	// Prices lists every currency the product is priced in, base first.
+	// PriceTiers lists the quantity breaks, in the base currency.
+	// Amounts are rounded to the currency's minor unit; the string fields
+	// hold them as decimals and the Num/Den pairs as fractions, which are
+	// zero when they do not fit.
+	BasePriceNum int64
+	BasePriceDen int64
+	BasePrice    string
+	Currency     string
+	Prices       []PriceDTO
+	PriceTiers   []PriceTierDTO
+	// The Discount* fields describe the highest-priority discount reducing
+	// the price, or else the next scheduled one; DiscountActive tells which. DiscountPct is an exact
+	// rational such as "25" or "1/3", empty for the fixed kinds. Discounts lists the whole schedule
+	// that has not yet ended.
+	DiscountID        string
+	DiscountPct       string
+	DiscountStart     string
+	DiscountEnd       string
+	DiscountActive    bool
+	DiscountAmountNum int64
+	DiscountAmountDen int64
+	DiscountAmount    string
+	Discounts         []DiscountDTO
+	EffectiveNum      int64
+	EffectiveDen      int64
+	EffectivePrice    string
+	// LowestPrice is the lowest effective price in Currency over the
+	// LowestPriceDays days before now; it is empty, and its Num/Den zero,
+	// while the product has no price history in Currency for the period or
+	// a price list applies.
+	LowestPriceNum  int64
+	LowestPriceDen  int64
+	LowestPrice     string
+	LowestPriceDays int32
+	CreatedAt       string
+	UpdatedAt       string
+	ArchivedAt      string
+	Version         int64
+	// TaxClass is the product's own class, empty when it takes its
+	// category's. Tax splits the effective price in the region the read
+	// asked for; it is nil without a region, or when the region has no rate
+	// for the class the product is taxed under.
+	Tax *TaxDTO
+	// PriceListID names the price list the product was priced from, empty
+	// when its own prices apply.
+	PriceListID string
+	// Variants lists the product's variants ordered by SKU.
+	Variants []VariantDTO
+}
+
+type PriceDTO struct {
+	Currency string
+	Num      int64
+	Den      int64
+	Amount   string
+}
+
+// PriceTierDTO charges its amount per unit for orders of MinQuantity to
+// MaxQuantity units; MaxQuantity is zero for the open-ended last tier.
+type PriceTierDTO struct {
+	MinQuantity int64
+	MaxQuantity int64
+	Currency    string
+	Num         int64
+	Den         int64
+	Amount      string
+}
+
+// VariantDTO is one variant of a product. Its price fields hold its own
+// price in the base currency, rounded like the others; they are empty and
+// zero when it sells at the product's base price.
+type VariantDTO struct {
+	ID         string
+	SKU        string
+	Attributes map[string]string
+	Status     string
+	Currency   string
+	Num        int64
+	Den        int64
+	Price      string
+}
+
+// TaxDTO splits a price at Rate, the region's rate for Class. Mode says
+// which of Net and Gross is the stored price; the other is derived and
+// rounded, and Tax is their difference.
+type TaxDTO struct {
+	Region   string
+	Class    string
+	Mode     string
+	RateNum  int64
+	RateDen  int64
+	NetNum   int64
+	NetDen   int64
+	Net      string
+	TaxNum   int64
+	TaxDen   int64
+	Tax      string
+	GrossNum int64
+	GrossDen int64
+	Gross    string
+}
+
+// DiscountDTO is one scheduled or running discount. Percent is set for
+// percentage discounts and AmountNum/AmountDen/AmountCur for the fixed
+// kinds. Active reports whether it contributes to the current price, which
+// a discount in its window may not do under its stacking policy.
+type DiscountDTO struct {
+	ID        string
+	Kind      string
+	Percent   string
+	AmountNum int64
+	AmountDen int64
+	AmountCur string
+	Amount    string
+	Start     string
+	End       string
+	Status    string
+	Priority  int64
+	Stacking  string
+	Active    bool
+}
+
+const (
+	DefaultPageSize = 20
+	MaxPageSize     = 100
+)
+
+// PageSize clamps a client-requested page size to [1, MaxPageSize].
+func PageSize(requested int32) int {
+	if requested <= 0 {
+		return DefaultPageSize
+	}
+	if requested > MaxPageSize {
+		return MaxPageSize
+	}
+	return int(requested)
+}
+
+// PriceListSelector picks the price lists a read is priced from: the list
+// with ID, or else every list for CustomerGroup. The zero value means the
+// products' own prices.
+type PriceListSelector struct {
 	ID            string
-	Name          string
-	Description   string
-	Category      string
-	Status        string
-	BasePriceNum  int64
-	BasePriceDen  int64
-	DiscountPct   string
-	DiscountStart string
-	DiscountEnd   string
-	EffectiveNum  int64
-	EffectiveDen  int64
-	CreatedAt     string
-	UpdatedAt     string
-	ArchivedAt    string
+	CustomerGroup string
 }
 
 type ListProductsFilter struct {
 	Category   string
 	OnlyActive bool
+	Currency   string
 	Limit      int32
 	PageToken  string
+	// Region, when set, adds the tax split to every item.
+	Region     string
+	PriceLists PriceListSelector
 }
 
 type ListProductsResult struct {
@@ -33,6 +177,18 @@ type ListProductsResult struct {
 }
 
 type ProductReadModel interface {
-	GetProduct(ctx context.Context, id string) (ProductDTO, error)
+	// GetProduct prices the product in currency where it can; an empty
+	// currency means the base currency. A region adds the tax split, and
+	// lists the price lists that may stand in for the product's prices.
+	GetProduct(ctx context.Context, id, currency, region string, lists PriceListSelector) (ProductDTO, error)
+	// GetProductBySKU is GetProduct for the product that uses sku, as its
+	// own SKU or a variant's.
+	GetProductBySKU(ctx context.Context, sku, currency, region string, lists PriceListSelector) (ProductDTO, error)
+	// GetProductByGTIN is GetProduct for the product with gtin, given in
+	// its 14-digit form.
+	GetProductByGTIN(ctx context.Context, gtin, currency, region string, lists PriceListSelector) (ProductDTO, error)
+	// BatchGetProducts is GetProduct for each of ids, in order. It fails if
+	// any of them is missing.
+	BatchGetProducts(ctx context.Context, ids []string, currency, region string, lists PriceListSelector) ([]ProductDTO, error)
 	ListProducts(ctx context.Context, f ListProductsFilter) (ListProductsResult, error)
 }
@@ -0,0 +1,20 @@
+package contracts
+
+import (
+	"context"
+
+	"product-catalog-service/internal/app/product/domain"
+)
+
+type TaxRepo interface {
+	// Rate returns region's rate for class, or domain.ErrTaxRateNotFound.
+	Rate(ctx context.Context, region domain.Region, class domain.TaxClass) (*domain.TaxRate, error)
+	// CategoryClass returns the class assigned to category, empty if none.
+	CategoryClass(ctx context.Context, category string) (domain.TaxClass, error)
+	// UpsertRateMut stores r, replacing the rate for the same region and
+	// class.
+	UpsertRateMut(r *domain.TaxRate) Mutation
+	// AssignCategoryMut assigns class to category; an empty class removes
+	// the assignment.
+	AssignCategoryMut(category string, class domain.TaxClass) Mutation
+}
@@ -1,22 +1,60 @@
 package domain
 
+// FieldChange is the net change of one field since the aggregate was loaded.
+type FieldChange struct {
+	Field string
+	Old   any
+	New   any
+}
+
 type ChangeTracker struct {
-	dirty map[string]struct{}
+	dirty   map[string]struct{}
+	changes map[string]*FieldChange
+	order   []string
 }
 
 func NewChangeTracker() *ChangeTracker {
-	return &ChangeTracker{dirty: map[string]struct{}{}}
+	return &ChangeTracker{dirty: map[string]struct{}{}, changes: map[string]*FieldChange{}}
 }
 
 func (ct *ChangeTracker) MarkDirty(field string) {
 	ct.dirty[field] = struct{}{}
 }
 
+// Track marks field dirty and records its values. Repeated changes keep the
+// first old value, so Change always reports the net effect.
+func (ct *ChangeTracker) Track(field string, old, new any) {
+	ct.MarkDirty(field)
+	if c, ok := ct.changes[field]; ok {
+		c.New = new
+		return
+	}
+	ct.changes[field] = &FieldChange{Field: field, Old: old, New: new}
+	ct.order = append(ct.order, field)
+}
+
 func (ct *ChangeTracker) Dirty(field string) bool {
 	_, ok := ct.dirty[field]
 	return ok
 }
 
+func (ct *ChangeTracker) Change(field string) (FieldChange, bool) {
+	c, ok := ct.changes[field]
+	if !ok {
+		return FieldChange{}, false
+	}
+	return *c, true
+}
+
+// Changes returns the tracked changes in the order fields were first touched.
+func (ct *ChangeTracker) Changes() []FieldChange {
+	out := make([]FieldChange, 0, len(ct.order))
+	for _, f := range ct.order {
+		out = append(out, *ct.changes[f])
+	}
+	return out
+}
+
 func (ct *ChangeTracker) Any() bool {
 	return len(ct.dirty) > 0
 }
@@ -25,4 +63,8 @@ func (ct *ChangeTracker) Clear() {
 	for k := range ct.dirty {
 		delete(ct.dirty, k)
 	}
+	for k := range ct.changes {
+		delete(ct.changes, k)
+	}
+	ct.order = nil
 }
@@ -5,34 +5,215 @@ import (
 	"time"
 )
 
+type DiscountStatus string
+
+const (
+	// DiscountScheduled has been announced but its window has not started
+	// as far as the product knows; the sweeper moves it on.
+	DiscountScheduled DiscountStatus = "scheduled"
+	DiscountActive    DiscountStatus = "active"
+	// DiscountExpired has ended, by its window closing or by being removed.
+	// It is kept so prices at times it covered can still be worked out.
+	DiscountExpired DiscountStatus = "expired"
+)
+
+// StackingPolicy says how a discount combines with the others in effect at
+// the same time; PricingCalculator evaluates it.
+type StackingPolicy string
+
+const (
+	// StackBestWins competes with the other discounts; the customer gets
+	// whichever of the best single best-wins discount and the stacked
+	// discounts is worth more.
+	StackBestWins StackingPolicy = "best_wins"
+	// StackAdditive discounts add their percentages and apply to the base.
+	StackAdditive StackingPolicy = "additive"
+	// StackMultiplicative discounts apply one after another, in priority
+	// order, to the price left by the additive ones.
+	StackMultiplicative StackingPolicy = "multiplicative"
+	// StackExclusive applies alone; the highest-priority exclusive discount
+	// in effect suppresses all others.
+	StackExclusive StackingPolicy = "exclusive"
+)
+
+// ParseStackingPolicy accepts the policy names above; empty means
+// StackBestWins.
+func ParseStackingPolicy(s string) (StackingPolicy, error) {
+	switch p := StackingPolicy(s); p {
+	case "":
+		return StackBestWins, nil
+	case StackBestWins, StackAdditive, StackMultiplicative, StackExclusive:
+		return p, nil
+	default:
+		return "", ErrInvalidStackingPolicy
+	}
+}
+
+// DiscountKind is what a discount takes off the price.
+type DiscountKind string
+
+const (
+	DiscountPercentage DiscountKind = "percentage"
+	// DiscountFixedAmount takes a fixed amount off the price.
+	DiscountFixedAmount DiscountKind = "fixed_amount"
+	// DiscountFixedPrice sells at a fixed final price.
+	DiscountFixedPrice DiscountKind = "fixed_price"
+)
+
+func ParseDiscountKind(s string) (DiscountKind, error) {
+	switch k := DiscountKind(s); k {
+	case "":
+		return DiscountPercentage, nil
+	case DiscountPercentage, DiscountFixedAmount, DiscountFixedPrice:
+		return k, nil
+	default:
+		return "", ErrInvalidDiscountKind
+	}
+}
+
 type Discount struct {
-	percent *big.Rat
-	start   time.Time
-	end     time.Time
+	id       string
+	kind     DiscountKind
+	percent  *big.Rat
+	amount   *Money
+	start    time.Time
+	end      time.Time
+	status   DiscountStatus
+	priority int64
+	stacking StackingPolicy
 }
 
-func NewDiscount(percent *big.Rat, start, end time.Time) (*Discount, error) {
+func NewDiscount(id string, percent *big.Rat, start, end time.Time) (*Discount, error) {
+	if id == "" {
+		return nil, ErrInvalidDiscountID
+	}
 	if percent == nil || percent.Sign() <= 0 {
 		return nil, ErrInvalidDiscountPercent
 	}
 	if percent.Cmp(big.NewRat(100, 1)) > 0 {
 		return nil, ErrInvalidDiscountPercent
 	}
+	return newDiscount(id, DiscountPercentage, new(big.Rat).Set(percent), nil, start, end)
+}
+
+// NewFixedAmountDiscount takes amount off the price, never going below zero.
+func NewFixedAmountDiscount(id string, amount *Money, start, end time.Time) (*Discount, error) {
+	if id == "" {
+		return nil, ErrInvalidDiscountID
+	}
+	if amount == nil || amount.Rat().Sign() <= 0 {
+		return nil, ErrInvalidDiscountAmount
+	}
+	return newDiscount(id, DiscountFixedAmount, nil, amount, start, end)
+}
+
+// NewFixedPriceDiscount sells at price. It never raises a price that is
+// already lower.
+func NewFixedPriceDiscount(id string, price *Money, start, end time.Time) (*Discount, error) {
+	if id == "" {
+		return nil, ErrInvalidDiscountID
+	}
+	if price == nil {
+		return nil, ErrInvalidDiscountAmount
+	}
+	return newDiscount(id, DiscountFixedPrice, nil, price, start, end)
+}
+
+func newDiscount(id string, kind DiscountKind, percent *big.Rat, amount *Money, start, end time.Time) (*Discount, error) {
 	if start.IsZero() || end.IsZero() || !end.After(start) {
 		return nil, ErrInvalidDiscountPeriod
 	}
-
 	return &Discount{
-		percent: new(big.Rat).Set(percent),
-		start:   start.UTC(),
-		end:     end.UTC(),
+		id:       id,
+		kind:     kind,
+		percent:  percent,
+		amount:   amount,
+		start:    start.UTC(),
+		end:      end.UTC(),
+		status:   DiscountScheduled,
+		stacking: StackBestWins,
 	}, nil
 }
 
+// HydrateDiscount rebuilds a stored discount; percent is set for percentage
+// discounts and amount for the fixed kinds.
+func HydrateDiscount(id string, kind DiscountKind, percent *big.Rat, amount *Money, start, end time.Time, status DiscountStatus, priority int64, stacking StackingPolicy) *Discount {
+	if percent != nil {
+		percent = new(big.Rat).Set(percent)
+	}
+	return &Discount{
+		id:       id,
+		kind:     kind,
+		percent:  percent,
+		amount:   amount,
+		start:    start.UTC(),
+		end:      end.UTC(),
+		status:   status,
+		priority: priority,
+		stacking: stacking,
+	}
+}
+
+// WithStacking returns a copy of d with the given priority and stacking
+// policy. Higher priorities are evaluated first.
+func (d *Discount) WithStacking(priority int64, stacking StackingPolicy) (*Discount, error) {
+	switch stacking {
+	case StackBestWins, StackAdditive, StackMultiplicative, StackExclusive:
+	default:
+		return nil, ErrInvalidStackingPolicy
+	}
+	c := *d
+	c.priority = priority
+	c.stacking = stacking
+	return &c, nil
+}
+
+func (d *Discount) ID() string {
+	return d.id
+}
+
+func (d *Discount) Kind() DiscountKind {
+	return d.kind
+}
+
+// Percent is nil unless the discount is a percentage.
 func (d *Discount) Percent() *big.Rat {
+	if d.percent == nil {
+		return nil
+	}
 	return new(big.Rat).Set(d.percent)
 }
 
+// Amount is the amount off or the final price for the fixed kinds, and nil
+// for a percentage.
+func (d *Discount) Amount() *Money {
+	return d.amount
+}
+
+// Reduction is what d takes off price; it is never more than price. A
+// fixed amount or price only applies to a price in its own currency.
+func (d *Discount) Reduction(price *Money) *Money {
+	var off *Money
+	switch d.kind {
+	case DiscountFixedAmount:
+		if d.amount.Currency() != price.Currency() {
+			return price.Mul(new(big.Rat))
+		}
+		off = d.amount
+	case DiscountFixedPrice:
+		if d.amount.Currency() != price.Currency() || d.amount.Rat().Cmp(price.Rat()) >= 0 {
+			return price.Mul(new(big.Rat))
+		}
+		off, _ = price.Sub(d.amount) // same currency, checked above
+	default:
+		off = price.Mul(new(big.Rat).Quo(d.percent, big.NewRat(100, 1)))
+	}
+	if off.Rat().Cmp(price.Rat()) > 0 {
+		return price
+	}
+	return off
+}
+
 func (d *Discount) Start() time.Time {
 	return d.start
 }
@@ -41,11 +222,44 @@ func (d *Discount) End() time.Time {
 	return d.end
 }
 
+func (d *Discount) Status() DiscountStatus {
+	return d.status
+}
+
+func (d *Discount) Priority() int64 {
+	return d.priority
+}
+
+func (d *Discount) Stacking() StackingPolicy {
+	return d.stacking
+}
+
+func (d *Discount) withStatus(s DiscountStatus) *Discount {
+	c := *d
+	c.status = s
+	return &c
+}
+
+// endedAt expires d, closing its window at t if it was still open then.
+func (d *Discount) endedAt(t time.Time) *Discount {
+	c := *d
+	if t.Before(c.end) {
+		c.end = t.UTC()
+	}
+	c.status = DiscountExpired
+	return &c
+}
+
 func (d *Discount) IsValidAt(now time.Time) bool {
 	t := now.UTC()
 	return (t.Equal(d.start) || t.After(d.start)) && t.Before(d.end)
 }
 
+// IsExpiredAt reports whether the window has closed by now.
+func (d *Discount) IsExpiredAt(now time.Time) bool {
+	return !now.UTC().Before(d.end)
+}
+
 func (d *Discount) Overlaps(other *Discount) bool {
 	if d == nil || other == nil {
 		return false
@@ -3,12 +3,48 @@ package domain
 import "errors"
 
 var (
-	ErrInvalidProductID       = errors.New("invalid product ID")
-	ErrInvalidProductName     = errors.New("invalid product name")
-	ErrInvalidCategory        = errors.New("invalid category")
-	ErrInvalidMoney           = errors.New("invalid money")
-	ErrInvalidDiscountPercent = errors.New("invalid discount percent")
-	ErrInvalidDiscountPeriod  = errors.New("invalid discount period")
-	ErrDiscountOverlaps       = errors.New("discount overlaps existing")
-	ErrProductNotActive       = errors.New("product not active")
+	ErrInvalidProductID        = errors.New("invalid product ID")
+	ErrInvalidProductName      = errors.New("invalid product name")
+	ErrInvalidCategory         = errors.New("invalid category")
+	ErrInvalidMoney            = errors.New("invalid money")
+	ErrMoneyOverflow           = errors.New("amount out of range")
+	ErrInvalidCurrency         = errors.New("invalid currency")
+	ErrInvalidRounding         = errors.New("invalid rounding")
+	ErrCurrencyMismatch        = errors.New("currency mismatch")
+	ErrBaseCurrencyPrice       = errors.New("price is in the base currency")
+	ErrPriceNotFound           = errors.New("price not found")
+	ErrInvalidExchangeRate     = errors.New("invalid exchange rate")
+	ErrExchangeRateNotFound    = errors.New("exchange rate not found")
+	ErrInvalidTaxClass         = errors.New("invalid tax class")
+	ErrInvalidRegion           = errors.New("invalid region")
+	ErrInvalidTaxMode          = errors.New("invalid tax mode")
+	ErrInvalidTaxRate          = errors.New("invalid tax rate")
+	ErrTaxRateNotFound         = errors.New("tax rate not found")
+	ErrInvalidPriceTier        = errors.New("invalid price tier")
+	ErrPriceTiersNotContiguous = errors.New("price tiers not contiguous")
+	ErrInvalidQuantity         = errors.New("invalid quantity")
+	ErrInvalidPriceListID      = errors.New("invalid price list ID")
+	ErrInvalidPriceListName    = errors.New("invalid price list name")
+	ErrInvalidPriceListPeriod  = errors.New("invalid price list period")
+	ErrInvalidCustomerGroup    = errors.New("invalid customer group")
+	ErrPriceListEntryNotFound  = errors.New("price list entry not found")
+	ErrInvalidVariantID        = errors.New("invalid variant ID")
+	ErrInvalidSKU              = errors.New("invalid SKU")
+	ErrInvalidAttributes       = errors.New("invalid variant attributes")
+	ErrInvalidVariantStatus    = errors.New("invalid variant status")
+	ErrVariantNotFound         = errors.New("variant not found")
+	ErrVariantExists           = errors.New("variant already exists")
+	ErrDuplicateSKU            = errors.New("SKU already in use")
+	ErrInvalidGTIN             = errors.New("invalid GTIN")
+	ErrDuplicateGTIN           = errors.New("GTIN already in use")
+	ErrInvalidDiscountID       = errors.New("invalid discount ID")
+	ErrInvalidDiscountPercent  = errors.New("invalid discount percent")
+	ErrInvalidDiscountKind     = errors.New("invalid discount kind")
+	ErrInvalidDiscountAmount   = errors.New("invalid discount amount")
+	ErrInvalidDiscountPeriod   = errors.New("invalid discount period")
+	ErrInvalidStackingPolicy   = errors.New("invalid stacking policy")
+	ErrDiscountOverlaps        = errors.New("discount overlaps existing")
+	ErrDiscountNotFound        = errors.New("discount not found")
+	ErrProductNotActive        = errors.New("product not active")
+	ErrRestoreWindowExpired    = errors.New("restore window expired")
 )
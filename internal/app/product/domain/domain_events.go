@@ -1,6 +1,9 @@
 package domain
 
-import "time"
+import (
+	"math/big"
+	"time"
+)
 
 type DomainEvent interface {
 	EventType() string
@@ -9,8 +12,16 @@ type DomainEvent interface {
 }
 
 type ProductCreatedEvent struct {
-	ProductID string
-	At        time.Time
+	ProductID   string
+	Name        string
+	Description string
+	Category    string
+	SKU         string
+	GTIN        GTIN
+	BasePrice   *big.Rat
+	Currency    Currency
+	Status      ProductStatus
+	At          time.Time
 }
 
 func (e ProductCreatedEvent) EventType() string     { return "product.created" }
@@ -19,6 +30,7 @@ func (e ProductCreatedEvent) OccurredAt() time.Time { return e.At }
 
 type ProductUpdatedEvent struct {
 	ProductID string
+	Changes   []FieldChange
 	At        time.Time
 }
 
@@ -44,8 +56,103 @@ func (e ProductDeactivatedEvent) EventType() string     { return "product.deacti
 func (e ProductDeactivatedEvent) AggregateID() string   { return e.ProductID }
 func (e ProductDeactivatedEvent) OccurredAt() time.Time { return e.At }
 
+// ProductPriceChangedEvent is emitted when the base price changes.
+type ProductPriceChangedEvent struct {
+	ProductID string
+	OldPrice  *Money
+	NewPrice  *Money
+	At        time.Time
+}
+
+func (e ProductPriceChangedEvent) EventType() string     { return "product.price_changed" }
+func (e ProductPriceChangedEvent) AggregateID() string   { return e.ProductID }
+func (e ProductPriceChangedEvent) OccurredAt() time.Time { return e.At }
+
+// ProductTaxClassChangedEvent is emitted when the product's own tax class
+// is assigned or cleared.
+type ProductTaxClassChangedEvent struct {
+	ProductID string
+	OldClass  TaxClass
+	NewClass  TaxClass
+	At        time.Time
+}
+
+func (e ProductTaxClassChangedEvent) EventType() string     { return "product.tax_class_changed" }
+func (e ProductTaxClassChangedEvent) AggregateID() string   { return e.ProductID }
+func (e ProductTaxClassChangedEvent) OccurredAt() time.Time { return e.At }
+
+// ProductPriceSetEvent is emitted when a price in a currency other than the
+// base currency is added or changed.
+type ProductPriceSetEvent struct {
+	ProductID string
+	Price     *Money
+	At        time.Time
+}
+
+func (e ProductPriceSetEvent) EventType() string     { return "product.price_set" }
+func (e ProductPriceSetEvent) AggregateID() string   { return e.ProductID }
+func (e ProductPriceSetEvent) OccurredAt() time.Time { return e.At }
+
+// ProductPriceTiersSetEvent is emitted when the product's quantity breaks
+// are replaced; Tiers is empty when they were removed.
+type ProductPriceTiersSetEvent struct {
+	ProductID string
+	Tiers     []*PriceTier
+	At        time.Time
+}
+
+func (e ProductPriceTiersSetEvent) EventType() string     { return "product.price_tiers_set" }
+func (e ProductPriceTiersSetEvent) AggregateID() string   { return e.ProductID }
+func (e ProductPriceTiersSetEvent) OccurredAt() time.Time { return e.At }
+
+type ProductVariantAddedEvent struct {
+	ProductID string
+	Variant   *Variant
+	At        time.Time
+}
+
+func (e ProductVariantAddedEvent) EventType() string     { return "product.variant_added" }
+func (e ProductVariantAddedEvent) AggregateID() string   { return e.ProductID }
+func (e ProductVariantAddedEvent) OccurredAt() time.Time { return e.At }
+
+// ProductVariantUpdatedEvent carries the variant as it now is.
+type ProductVariantUpdatedEvent struct {
+	ProductID string
+	Variant   *Variant
+	At        time.Time
+}
+
+func (e ProductVariantUpdatedEvent) EventType() string     { return "product.variant_updated" }
+func (e ProductVariantUpdatedEvent) AggregateID() string   { return e.ProductID }
+func (e ProductVariantUpdatedEvent) OccurredAt() time.Time { return e.At }
+
+type ProductVariantRemovedEvent struct {
+	ProductID string
+	VariantID string
+	SKU       string
+	At        time.Time
+}
+
+func (e ProductVariantRemovedEvent) EventType() string     { return "product.variant_removed" }
+func (e ProductVariantRemovedEvent) AggregateID() string   { return e.ProductID }
+func (e ProductVariantRemovedEvent) OccurredAt() time.Time { return e.At }
+
+type ProductPriceRemovedEvent struct {
+	ProductID string
+	Currency  Currency
+	At        time.Time
+}
+
+func (e ProductPriceRemovedEvent) EventType() string     { return "product.price_removed" }
+func (e ProductPriceRemovedEvent) AggregateID() string   { return e.ProductID }
+func (e ProductPriceRemovedEvent) OccurredAt() time.Time { return e.At }
+
+// DiscountAppliedEvent is emitted for a discount that is in effect as soon
+// as it is added; one with a future start emits DiscountScheduledEvent and
+// later DiscountStartedEvent instead.
 type DiscountAppliedEvent struct {
 	ProductID string
+	Discount  *Discount
 	At        time.Time
 }
 
@@ -53,8 +160,39 @@ func (e DiscountAppliedEvent) EventType() string     { return "discount.applied"
 func (e DiscountAppliedEvent) AggregateID() string   { return e.ProductID }
 func (e DiscountAppliedEvent) OccurredAt() time.Time { return e.At }
 
+type DiscountScheduledEvent struct {
+	ProductID string
+	Discount  *Discount
+	At        time.Time
+}
+
+func (e DiscountScheduledEvent) EventType() string     { return "discount.scheduled" }
+func (e DiscountScheduledEvent) AggregateID() string   { return e.ProductID }
+func (e DiscountScheduledEvent) OccurredAt() time.Time { return e.At }
+
+type DiscountStartedEvent struct {
+	ProductID string
+	Discount  *Discount
+	At        time.Time
+}
+
+func (e DiscountStartedEvent) EventType() string     { return "discount.started" }
+func (e DiscountStartedEvent) AggregateID() string   { return e.ProductID }
+func (e DiscountStartedEvent) OccurredAt() time.Time { return e.At }
+
+type DiscountExpiredEvent struct {
+	ProductID string
+	Discount  *Discount
+	At        time.Time
+}
+
+func (e DiscountExpiredEvent) EventType() string     { return "discount.expired" }
+func (e DiscountExpiredEvent) AggregateID() string   { return e.ProductID }
+func (e DiscountExpiredEvent) OccurredAt() time.Time { return e.At }
+
 type DiscountRemovedEvent struct {
 	ProductID string
+	Discount  *Discount
 	At        time.Time
 }
 
@@ -70,3 +208,13 @@ type ProductArchivedEvent struct {
 func (e ProductArchivedEvent) EventType() string     { return "product.archived" }
 func (e ProductArchivedEvent) AggregateID() string   { return e.ProductID }
 func (e ProductArchivedEvent) OccurredAt() time.Time { return e.At }
+
+type ProductRestoredEvent struct {
+	ProductID  string
+	ArchivedAt time.Time
+	At         time.Time
+}
+
+func (e ProductRestoredEvent) EventType() string     { return "product.restored" }
+func (e ProductRestoredEvent) AggregateID() string   { return e.ProductID }
+func (e ProductRestoredEvent) OccurredAt() time.Time { return e.At }
@@ -1,6 +1,7 @@
 package domain_test
 
 import (
+	"math"
 	"math/big"
 	"testing"
 	"time"
@@ -15,7 +16,7 @@ func TestNewProduct_CreatesInactiveAndEmitsCreatedEvent(t *testing.T) {
 		t.Fatalf("unexpected error: %v", err)
 	}
 
-	p, err := domain.NewProduct("p1", "name", "desc", "cat", price, now)
+	p, err := domain.NewProduct(domain.NewProductParams{ID: "p1", Name: "name", Description: "desc", Category: "cat", BasePrice: price}, now)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -37,7 +38,7 @@ func TestDiscount_IsValidAt_StartInclusive_EndExclusive(t *testing.T) {
 	start := time.Date(2026, 1, 12, 10, 0, 0, 0, time.UTC)
 	end := start.Add(2 * time.Hour)
 
-	d, err := domain.NewDiscount(big.NewRat(10, 1), start, end)
+	d, err := domain.NewDiscount("d1", big.NewRat(10, 1), start, end)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -57,12 +58,12 @@ func TestApplyDiscount_InactiveProduct_ReturnsErrProductNotActive(t *testing.T)
 		t.Fatalf("unexpected error: %v", err)
 	}
 
-	p, err := domain.NewProduct("p1", "name", "desc", "cat", price, now)
+	p, err := domain.NewProduct(domain.NewProductParams{ID: "p1", Name: "name", Description: "desc", Category: "cat", BasePrice: price}, now)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
 
-	d, err := domain.NewDiscount(big.NewRat(10, 1), now.Add(-time.Minute), now.Add(time.Hour))
+	d, err := domain.NewDiscount("d1", big.NewRat(10, 1), now.Add(-time.Minute), now.Add(time.Hour))
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -83,8 +84,819 @@ func TestMoney_MulAndSub(t *testing.T) {
 		t.Fatalf("expected 50, got %s", disc.Rat().String())
 	}
 
-	final := base.Sub(disc)
+	final, err := base.Sub(disc)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
 	if final.Rat().Cmp(big.NewRat(150, 1)) != 0 {
 		t.Fatalf("expected 150, got %s", final.Rat().String())
 	}
 }
+
+func TestRestore_WithinRetention_ClearsArchivedAndEmitsEvent(t *testing.T) {
+	now := time.Date(2026, 1, 12, 10, 0, 0, 0, time.UTC)
+	price, err := domain.NewMoneyFromFraction(100, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	archivedAt := now.Add(-24 * time.Hour)
+	p := domain.HydrateProduct(domain.HydrateProductParams{ID: "p1", Name: "name", Description: "desc", Category: "cat", BasePrice: price, Status: domain.ProductStatusInactive, ArchivedAt: &archivedAt, Version: 1})
+
+	if err := p.Restore(now, 7*24*time.Hour); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if p.ArchivedAt() != nil {
+		t.Fatalf("expected archivedAt cleared")
+	}
+	if !p.Changes().Dirty(domain.FieldArchivedAt) {
+		t.Fatalf("expected archived_at dirty")
+	}
+
+	ev := p.DomainEvents()
+	if len(ev) != 1 || ev[0].EventType() != "product.restored" {
+		t.Fatalf("expected product.restored event, got %v", ev)
+	}
+}
+
+func TestRestore_AfterRetention_ReturnsErrRestoreWindowExpired(t *testing.T) {
+	now := time.Date(2026, 1, 12, 10, 0, 0, 0, time.UTC)
+	price, err := domain.NewMoneyFromFraction(100, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	archivedAt := now.Add(-8 * 24 * time.Hour)
+	p := domain.HydrateProduct(domain.HydrateProductParams{ID: "p1", Name: "name", Description: "desc", Category: "cat", BasePrice: price, Status: domain.ProductStatusInactive, ArchivedAt: &archivedAt, Version: 1})
+
+	if err := p.Restore(now, 7*24*time.Hour); err != domain.ErrRestoreWindowExpired {
+		t.Fatalf("expected ErrRestoreWindowExpired, got %v", err)
+	}
+	if p.ArchivedAt() == nil {
+		t.Fatalf("expected product to stay archived")
+	}
+}
+
+func TestUpdateDetails_EventCarriesOldAndNewValues(t *testing.T) {
+	now := time.Date(2026, 1, 12, 10, 0, 0, 0, time.UTC)
+	price, err := domain.NewMoneyFromFraction(100, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	p := domain.HydrateProduct(domain.HydrateProductParams{ID: "p1", Name: "old", Description: "desc", Category: "cat", BasePrice: price, Status: domain.ProductStatusActive, Version: 1})
+
+	if err := p.UpdateDetails("new", "desc", "other", now); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	ev := p.DomainEvents()
+	if len(ev) != 1 {
+		t.Fatalf("expected 1 event, got %d", len(ev))
+	}
+	upd, ok := ev[0].(domain.ProductUpdatedEvent)
+	if !ok {
+		t.Fatalf("expected ProductUpdatedEvent, got %T", ev[0])
+	}
+	want := []domain.FieldChange{
+		{Field: domain.FieldName, Old: "old", New: "new"},
+		{Field: domain.FieldCategory, Old: "cat", New: "other"},
+	}
+	if len(upd.Changes) != len(want) {
+		t.Fatalf("expected %d changes, got %+v", len(want), upd.Changes)
+	}
+	for i, c := range want {
+		if upd.Changes[i] != c {
+			t.Fatalf("change %d: expected %+v, got %+v", i, c, upd.Changes[i])
+		}
+	}
+	if p.Changes().Dirty(domain.FieldDescription) {
+		t.Fatalf("unchanged description must not be dirty")
+	}
+}
+
+func TestApplyDiscount_FutureWindow_Schedules(t *testing.T) {
+	now := time.Date(2026, 1, 12, 10, 0, 0, 0, time.UTC)
+	price, err := domain.NewMoneyFromFraction(100, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	p := domain.HydrateProduct(domain.HydrateProductParams{ID: "p1", Name: "name", Description: "desc", Category: "cat", BasePrice: price, Status: domain.ProductStatusActive, Version: 1})
+
+	d1, _ := domain.NewDiscount("d1", big.NewRat(10, 1), now.Add(time.Hour), now.Add(2*time.Hour))
+	if err := p.ApplyDiscount(d1, now); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := p.Discounts(); len(got) != 1 || got[0].Status() != domain.DiscountScheduled {
+		t.Fatalf("expected one scheduled discount, got %v", got)
+	}
+	if len(p.DiscountsAt(now)) != 0 {
+		t.Fatalf("expected no discount in effect before the window")
+	}
+	if ev := p.DomainEvents(); len(ev) != 1 || ev[0].EventType() != "discount.scheduled" {
+		t.Fatalf("expected discount.scheduled, got %v", ev)
+	}
+
+	d2, _ := domain.NewDiscount("d2", big.NewRat(20, 1), now.Add(-time.Hour), now.Add(time.Hour))
+	if err := p.ApplyDiscount(d2, now); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := p.DiscountsAt(now); len(got) != 1 || got[0].ID() != "d2" || got[0].Status() != domain.DiscountActive {
+		t.Fatalf("expected d2 active now, got %v", got)
+	}
+}
+
+func TestApplyDiscount_OverlapsAllowedExceptTiedExclusives(t *testing.T) {
+	now := time.Date(2026, 1, 12, 10, 0, 0, 0, time.UTC)
+	price, err := domain.NewMoneyFromFraction(100, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	p := domain.HydrateProduct(domain.HydrateProductParams{ID: "p1", Name: "name", Description: "desc", Category: "cat", BasePrice: price, Status: domain.ProductStatusActive, Version: 1})
+
+	discount := func(id string, priority int64, stacking domain.StackingPolicy) *domain.Discount {
+		d, err := domain.NewDiscount(id, big.NewRat(10, 1), now, now.Add(time.Hour))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if d, err = d.WithStacking(priority, stacking); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return d
+	}
+
+	for _, d := range []*domain.Discount{
+		discount("category", 0, domain.StackBestWins),
+		discount("markdown", 5, domain.StackAdditive),
+		discount("clearance", 10, domain.StackExclusive),
+		discount("staff", 20, domain.StackExclusive),
+	} {
+		if err := p.ApplyDiscount(d, now); err != nil {
+			t.Fatalf("apply %s: %v", d.ID(), err)
+		}
+	}
+	if got := p.DiscountsAt(now); len(got) != 4 {
+		t.Fatalf("expected all four in effect, got %d", len(got))
+	}
+
+	if err := p.ApplyDiscount(discount("tied", 10, domain.StackExclusive), now); err != domain.ErrDiscountOverlaps {
+		t.Fatalf("expected ErrDiscountOverlaps, got %v", err)
+	}
+	if err := p.RemoveDiscount("", now); err != domain.ErrInvalidDiscountID {
+		t.Fatalf("expected ambiguous removal to fail, got %v", err)
+	}
+}
+
+func TestAdvanceDiscounts_StartsAndExpiresWithEvents(t *testing.T) {
+	now := time.Date(2026, 1, 12, 10, 0, 0, 0, time.UTC)
+	price, err := domain.NewMoneyFromFraction(100, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	p := domain.HydrateProduct(domain.HydrateProductParams{
+		ID:          "p1",
+		Name:        "name",
+		Description: "desc",
+		Category:    "cat",
+		BasePrice:   price,
+		Discounts: []*domain.Discount{
+			domain.HydrateDiscount("d1", domain.DiscountPercentage, big.NewRat(10, 1), nil, now.Add(-2*time.Hour), now.Add(-time.Hour), domain.DiscountActive, 0, domain.StackBestWins),
+			domain.HydrateDiscount("d2", domain.DiscountPercentage, big.NewRat(20, 1), nil, now.Add(-time.Minute), now.Add(time.Hour), domain.DiscountScheduled, 0, domain.StackBestWins),
+		},
+		Status:  domain.ProductStatusActive,
+		Version: 1,
+	})
+
+	if !p.AdvanceDiscounts(now) {
+		t.Fatalf("expected a change")
+	}
+	got := p.Discounts()
+	if len(got) != 2 || got[0].Status() != domain.DiscountExpired || got[1].Status() != domain.DiscountActive {
+		t.Fatalf("expected d1 expired and d2 active, got %v", got)
+	}
+	if ds := p.DiscountsAt(now.Add(-90 * time.Minute)); len(ds) != 1 || ds[0].ID() != "d1" {
+		t.Fatalf("expected d1 still in effect inside its window, got %v", ds)
+	}
+	ev := p.DomainEvents()
+	if len(ev) != 2 || ev[0].EventType() != "discount.expired" || ev[1].EventType() != "discount.started" {
+		t.Fatalf("expected discount.expired then discount.started, got %v", ev)
+	}
+	if !p.Changes().Dirty(domain.FieldDiscounts) {
+		t.Fatalf("expected discounts marked dirty")
+	}
+
+	if p.AdvanceDiscounts(now) {
+		t.Fatalf("expected no change on a second pass")
+	}
+}
+
+func TestRemoveDiscount_UnknownID_ReturnsErrDiscountNotFound(t *testing.T) {
+	now := time.Date(2026, 1, 12, 10, 0, 0, 0, time.UTC)
+	price, err := domain.NewMoneyFromFraction(100, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	p := domain.HydrateProduct(domain.HydrateProductParams{
+		ID:          "p1",
+		Name:        "name",
+		Description: "desc",
+		Category:    "cat",
+		BasePrice:   price,
+		Discounts: []*domain.Discount{
+			domain.HydrateDiscount("d1", domain.DiscountPercentage, big.NewRat(10, 1), nil, now.Add(time.Hour), now.Add(2*time.Hour), domain.DiscountScheduled, 0, domain.StackBestWins),
+		},
+		Status:  domain.ProductStatusActive,
+		Version: 1,
+	})
+
+	if err := p.RemoveDiscount("nope", now); err != domain.ErrDiscountNotFound {
+		t.Fatalf("expected ErrDiscountNotFound, got %v", err)
+	}
+	if err := p.RemoveDiscount("d1", now); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(p.Discounts()) != 0 {
+		t.Fatalf("expected schedule to be empty")
+	}
+}
+
+func TestRemoveDiscount_Started_EndsItAtNow(t *testing.T) {
+	now := time.Date(2026, 1, 12, 10, 0, 0, 0, time.UTC)
+	price, err := domain.NewMoneyFromFraction(100, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	p := domain.HydrateProduct(domain.HydrateProductParams{
+		ID:          "p1",
+		Name:        "name",
+		Description: "desc",
+		Category:    "cat",
+		BasePrice:   price,
+		Discounts: []*domain.Discount{
+			domain.HydrateDiscount("d1", domain.DiscountPercentage, big.NewRat(10, 1), nil, now.Add(-time.Hour), now.Add(time.Hour), domain.DiscountActive, 0, domain.StackBestWins),
+		},
+		Status:  domain.ProductStatusActive,
+		Version: 1,
+	})
+
+	if err := p.RemoveDiscount("d1", now); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	ds := p.Discounts()
+	if len(ds) != 1 || ds[0].Status() != domain.DiscountExpired || !ds[0].End().Equal(now) {
+		t.Fatalf("expected d1 expired at now, got %v", ds)
+	}
+	if got := p.DiscountsAt(now.Add(-time.Minute)); len(got) != 1 {
+		t.Fatalf("expected d1 still in effect before now, got %v", got)
+	}
+	if got := p.DiscountsAt(now); len(got) != 0 {
+		t.Fatalf("expected nothing in effect at now, got %v", got)
+	}
+	if err := p.RemoveDiscount("d1", now); err != domain.ErrDiscountNotFound {
+		t.Fatalf("expected ErrDiscountNotFound for an expired discount, got %v", err)
+	}
+}
+
+func TestDiscount_FixedKinds_NeverGoBelowZero(t *testing.T) {
+	start := time.Date(2026, 1, 12, 10, 0, 0, 0, time.UTC)
+	price, _ := domain.NewMoneyFromFraction(10, 1)
+	five, _ := domain.NewMoneyFromFraction(5, 1)
+	twenty, _ := domain.NewMoneyFromFraction(20, 1)
+
+	off, err := domain.NewFixedAmountDiscount("off", five, start, start.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := off.Reduction(price).Rat(); got.Cmp(big.NewRat(5, 1)) != 0 {
+		t.Fatalf("expected 5 off, got %s", got)
+	}
+	small, _ := domain.NewMoneyFromFraction(3, 1)
+	if got := off.Reduction(small).Rat(); got.Cmp(big.NewRat(3, 1)) != 0 {
+		t.Fatalf("expected reduction capped at the price, got %s", got)
+	}
+
+	at, err := domain.NewFixedPriceDiscount("at", five, start, start.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := at.Reduction(price).Rat(); got.Cmp(big.NewRat(5, 1)) != 0 {
+		t.Fatalf("expected 5 off to reach the fixed price, got %s", got)
+	}
+	if got := at.Reduction(small).Rat(); got.Sign() != 0 {
+		t.Fatalf("expected a fixed price above the price to take nothing off, got %s", got)
+	}
+
+	zero, _ := domain.NewMoneyFromFraction(0, 1)
+	if _, err := domain.NewFixedAmountDiscount("zero", zero, start, start.Add(time.Hour)); err != domain.ErrInvalidDiscountAmount {
+		t.Fatalf("expected ErrInvalidDiscountAmount, got %v", err)
+	}
+
+	p := domain.HydrateProduct(domain.HydrateProductParams{ID: "p1", Name: "name", Description: "desc", Category: "cat", BasePrice: price, Status: domain.ProductStatusActive, Version: 1})
+	tooMuch, _ := domain.NewFixedAmountDiscount("big", twenty, start, start.Add(time.Hour))
+	if err := p.ApplyDiscount(tooMuch, start); err != domain.ErrInvalidDiscountAmount {
+		t.Fatalf("expected amount above base price to be rejected, got %v", err)
+	}
+}
+
+func TestMoney_RefusesCrossCurrencyArithmetic(t *testing.T) {
+	eur, _ := domain.NewMoneyFromFraction(10, 1)
+	usd, err := domain.NewMoneyFromFractionIn(10, 1, "USD")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := eur.Sub(usd); err != domain.ErrCurrencyMismatch {
+		t.Fatalf("expected ErrCurrencyMismatch from Sub, got %v", err)
+	}
+	if _, err := eur.Add(usd); err != domain.ErrCurrencyMismatch {
+		t.Fatalf("expected ErrCurrencyMismatch from Add, got %v", err)
+	}
+	if got := usd.Mul(big.NewRat(1, 2)); got.Currency() != "USD" {
+		t.Fatalf("expected Mul to keep the currency, got %s", got.Currency())
+	}
+	if _, err := domain.ParseCurrency("xyz"); err != domain.ErrInvalidCurrency {
+		t.Fatalf("expected ErrInvalidCurrency, got %v", err)
+	}
+	if c, err := domain.ParseCurrency("gbp"); err != nil || c != "GBP" {
+		t.Fatalf("expected GBP, got %q, %v", c, err)
+	}
+
+	start := time.Date(2026, 1, 12, 10, 0, 0, 0, time.UTC)
+	off, _ := domain.NewFixedAmountDiscount("off", usd, start, start.Add(time.Hour))
+	if got := off.Reduction(eur); got.Rat().Sign() != 0 || got.Currency() != domain.DefaultCurrency {
+		t.Fatalf("expected a USD amount to take nothing off a EUR price, got %s %s", got.Rat(), got.Currency())
+	}
+}
+
+func TestSetPrice_AddsReplacesAndRemovesOtherCurrencies(t *testing.T) {
+	now := time.Date(2026, 1, 12, 10, 0, 0, 0, time.UTC)
+	base, _ := domain.NewMoneyFromFraction(100, 1)
+	p := domain.HydrateProduct(domain.HydrateProductParams{ID: "p1", Name: "name", Description: "desc", Category: "cat", BasePrice: base, Status: domain.ProductStatusActive, Version: 1})
+
+	usd, _ := domain.NewMoneyFromFractionIn(110, 1, "USD")
+	gbp, _ := domain.NewMoneyFromFractionIn(90, 1, "GBP")
+	if err := p.SetPrice(usd, now); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := p.SetPrice(gbp, now); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	usd2, _ := domain.NewMoneyFromFractionIn(120, 1, "USD")
+	if err := p.SetPrice(usd2, now); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	prices := p.Prices()
+	if len(prices) != 3 || prices[0] != base || prices[1].Currency() != "GBP" || prices[2].Rat().Cmp(big.NewRat(120, 1)) != 0 {
+		t.Fatalf("expected base, GBP, USD 120, got %v", prices)
+	}
+	if m, ok := p.PriceIn("USD"); !ok || m != usd2 {
+		t.Fatalf("expected the replaced USD price")
+	}
+
+	eur, _ := domain.NewMoneyFromFraction(1, 1)
+	if err := p.SetPrice(eur, now); err != domain.ErrBaseCurrencyPrice {
+		t.Fatalf("expected ErrBaseCurrencyPrice, got %v", err)
+	}
+	if err := p.RemovePrice(domain.DefaultCurrency, now); err != domain.ErrBaseCurrencyPrice {
+		t.Fatalf("expected ErrBaseCurrencyPrice, got %v", err)
+	}
+	if err := p.RemovePrice("GBP", now); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := p.RemovePrice("GBP", now); err != domain.ErrPriceNotFound {
+		t.Fatalf("expected ErrPriceNotFound, got %v", err)
+	}
+
+	c, ok := p.Changes().Change(domain.FieldPrices)
+	if !ok || len(c.Old.([]*domain.Money)) != 0 || len(c.New.([]*domain.Money)) != 1 {
+		t.Fatalf("expected net change from no other prices to one, got %+v", c)
+	}
+	if n := len(p.DomainEvents()); n != 4 {
+		t.Fatalf("expected 4 events, got %d", n)
+	}
+}
+
+func TestChangeBasePrice_TracksAndEmitsOldAndNew(t *testing.T) {
+	now := time.Date(2026, 1, 12, 10, 0, 0, 0, time.UTC)
+	base, _ := domain.NewMoneyFromFraction(100, 1)
+	p := domain.HydrateProduct(domain.HydrateProductParams{ID: "p1", Name: "name", Description: "desc", Category: "cat", BasePrice: base, Status: domain.ProductStatusActive, Version: 1})
+
+	same, _ := domain.NewMoneyFromFraction(200, 2)
+	if err := p.ChangeBasePrice(same, now); err != nil || p.Changes().Any() || len(p.DomainEvents()) != 0 {
+		t.Fatalf("expected the same price to change nothing, got err=%v", err)
+	}
+	usd, _ := domain.NewMoneyFromFractionIn(90, 1, "USD")
+	if err := p.ChangeBasePrice(usd, now); err != domain.ErrCurrencyMismatch {
+		t.Fatalf("expected ErrCurrencyMismatch, got %v", err)
+	}
+
+	lower, _ := domain.NewMoneyFromFraction(8999, 100)
+	if err := p.ChangeBasePrice(lower, now); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if p.BasePrice() != lower || !p.Changes().Dirty(domain.FieldBasePrice) {
+		t.Fatalf("expected base price 89.99 marked dirty, got %s", p.BasePrice().Rat())
+	}
+	evs := p.DomainEvents()
+	e, ok := evs[0].(domain.ProductPriceChangedEvent)
+	if len(evs) != 1 || !ok || e.OldPrice != base || e.NewPrice != lower || e.EventType() != "product.price_changed" {
+		t.Fatalf("expected one price_changed event from 100 to 89.99, got %v", evs)
+	}
+}
+
+func TestExchangeRate_ConvertsToMinorUnitOfTarget(t *testing.T) {
+	at := time.Date(2026, 1, 12, 10, 0, 0, 0, time.UTC)
+	rate, err := domain.NewExchangeRate("EUR", "USD", big.NewRat(10833, 10000), at)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	price, _ := domain.NewMoneyFromFraction(1999, 100)
+
+	// 19.99 * 1.0833 = 21.655167
+	usd, err := rate.Convert(price, domain.DefaultRounding)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if usd.Currency() != "USD" || usd.Rat().Cmp(big.NewRat(2166, 100)) != 0 {
+		t.Fatalf("expected 21.66 USD, got %s %s", usd.Rat().FloatString(6), usd.Currency())
+	}
+	if got, _ := rate.Convert(price, domain.Rounding{Mode: domain.RoundDown}); got.Rat().Cmp(big.NewRat(2165, 100)) != 0 {
+		t.Fatalf("expected 21.65 rounding down, got %s", got.Rat().FloatString(2))
+	}
+
+	yen, _ := domain.NewExchangeRate("EUR", "JPY", big.NewRat(16275, 100), at)
+	if got, _ := yen.Convert(price, domain.DefaultRounding); got.Rat().Cmp(big.NewRat(3253, 1)) != 0 {
+		t.Fatalf("expected 19.99 * 162.75 = 3253.3725 to round to 3253 JPY, got %s", got.Rat())
+	}
+
+	if _, err := rate.Convert(usd, domain.DefaultRounding); err != domain.ErrCurrencyMismatch {
+		t.Fatalf("expected ErrCurrencyMismatch, got %v", err)
+	}
+	if _, err := domain.NewExchangeRate("EUR", "EUR", big.NewRat(1, 1), at); err != domain.ErrInvalidExchangeRate {
+		t.Fatalf("expected ErrInvalidExchangeRate for a pair of one currency, got %v", err)
+	}
+	if _, err := domain.NewExchangeRate("EUR", "USD", new(big.Rat), at); err != domain.ErrInvalidExchangeRate {
+		t.Fatalf("expected ErrInvalidExchangeRate for a zero rate, got %v", err)
+	}
+
+	huge, _ := domain.NewMoneyFromFraction(math.MaxInt64, 1)
+	if _, err := rate.Convert(huge, domain.DefaultRounding); err != domain.ErrMoneyOverflow {
+		t.Fatalf("expected ErrMoneyOverflow, got %v", err)
+	}
+}
+
+func TestRounding_ModesAndFormat(t *testing.T) {
+	cases := []struct {
+		amount *big.Rat
+		mode   domain.RoundingMode
+		want   string
+	}{
+		{big.NewRat(5997, 300), domain.RoundHalfEven, "19.99"},
+		{big.NewRat(1999, 300), domain.RoundHalfEven, "6.66"},
+		{big.NewRat(1999, 300), domain.RoundHalfUp, "6.66"},
+		{big.NewRat(1999, 300), domain.RoundDown, "6.66"},
+		{big.NewRat(2000, 300), domain.RoundHalfUp, "6.67"},
+		{big.NewRat(2000, 300), domain.RoundDown, "6.66"},
+		{big.NewRat(1005, 1000), domain.RoundHalfEven, "1.00"},
+		{big.NewRat(1015, 1000), domain.RoundHalfEven, "1.02"},
+		{big.NewRat(1005, 1000), domain.RoundHalfUp, "1.01"},
+		{big.NewRat(1009, 1000), domain.RoundDown, "1.00"},
+		{big.NewRat(7, 1), domain.RoundHalfEven, "7.00"},
+	}
+	for _, c := range cases {
+		m, _ := domain.NewMoney(c.amount, "EUR")
+		if got := (domain.Rounding{Mode: c.mode}).Format(m); got != c.want {
+			t.Fatalf("%s %s: expected %s, got %s", c.amount.RatString(), c.mode, c.want, got)
+		}
+	}
+
+	yen, _ := domain.NewMoney(big.NewRat(3255, 2), "JPY")
+	if got := (domain.Rounding{Mode: domain.RoundHalfEven}).Format(yen); got != "1628" {
+		t.Fatalf("expected 1627.5 JPY to round half even to 1628, got %s", got)
+	}
+
+	if _, err := domain.ParseRounding("ceiling", ""); err != domain.ErrInvalidRounding {
+		t.Fatalf("expected ErrInvalidRounding for an unknown mode, got %v", err)
+	}
+	if _, err := domain.ParseRounding("", "1.99"); err != domain.ErrInvalidRounding {
+		t.Fatalf("expected ErrInvalidRounding for a charm of a whole unit or more, got %v", err)
+	}
+}
+
+func TestRounding_CharmLowersToEnding(t *testing.T) {
+	r, err := domain.ParseRounding("half_even", "0.99")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	cases := []struct {
+		amount *big.Rat
+		want   string
+	}{
+		{big.NewRat(18, 1), "17.99"},
+		{big.NewRat(1850, 100), "17.99"},
+		{big.NewRat(1799, 100), "17.99"},
+		{big.NewRat(17995, 1000), "17.99"},
+		{big.NewRat(1, 2), "0.50"},
+	}
+	for _, c := range cases {
+		m, _ := domain.NewMoney(c.amount, "EUR")
+		if got := r.Format(r.Charm(m)); got != c.want {
+			t.Fatalf("%s: expected %s, got %s", c.amount.FloatString(3), c.want, got)
+		}
+	}
+
+	yen, _ := domain.NewMoney(big.NewRat(1800, 1), "JPY")
+	if got := r.Format(r.Charm(yen)); got != "1800" {
+		t.Fatalf("expected no charm finer than a yen, got %s", got)
+	}
+}
+
+func TestMoney_RejectsAmountsThatDoNotFit(t *testing.T) {
+	tooBig := new(big.Rat).SetInt(new(big.Int).Lsh(big.NewInt(1), 64))
+	if _, err := domain.NewMoney(tooBig, "EUR"); err != domain.ErrMoneyOverflow {
+		t.Fatalf("expected ErrMoneyOverflow, got %v", err)
+	}
+	m, _ := domain.NewMoneyFromFraction(math.MaxInt64, 1)
+	if _, _, ok := m.Mul(big.NewRat(2, 1)).Fraction(); ok {
+		t.Fatal("expected a doubled maximum not to fit")
+	}
+}
+
+func TestSetTaxClass_TracksAndFallsBackToCategory(t *testing.T) {
+	now := time.Date(2026, 1, 12, 10, 0, 0, 0, time.UTC)
+	base, _ := domain.NewMoneyFromFraction(100, 1)
+	p := domain.HydrateProduct(domain.HydrateProductParams{ID: "p1", Name: "name", Description: "desc", Category: "cat", BasePrice: base, Status: domain.ProductStatusActive, Version: 1})
+
+	if got := domain.ResolveTaxClass(p.TaxClass(), ""); got != domain.DefaultTaxClass {
+		t.Fatalf("expected %q without any class, got %q", domain.DefaultTaxClass, got)
+	}
+	if err := p.SetTaxClass("Reduced!", now); err != domain.ErrInvalidTaxClass {
+		t.Fatalf("expected ErrInvalidTaxClass, got %v", err)
+	}
+	if err := p.SetTaxClass("reduced", now); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := domain.ResolveTaxClass(p.TaxClass(), "zero"); got != "reduced" || !p.Changes().Dirty(domain.FieldTaxClass) {
+		t.Fatalf("expected the product's own class to win and be dirty, got %q", got)
+	}
+	evs := p.DomainEvents()
+	e, ok := evs[0].(domain.ProductTaxClassChangedEvent)
+	if len(evs) != 1 || !ok || e.OldClass != "" || e.NewClass != "reduced" || e.EventType() != "product.tax_class_changed" {
+		t.Fatalf("expected one tax_class_changed event, got %v", evs)
+	}
+}
+
+func TestTaxRate_AppliesToNetOrGrossPrices(t *testing.T) {
+	rate, err := domain.NewTaxRate("DE", "standard", big.NewRat(19, 100))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := domain.NewTaxRate("Germany", "standard", big.NewRat(19, 100)); err != domain.ErrInvalidRegion {
+		t.Fatalf("expected ErrInvalidRegion, got %v", err)
+	}
+	if _, err := domain.NewTaxRate("DE", "standard", big.NewRat(-1, 100)); err != domain.ErrInvalidTaxRate {
+		t.Fatalf("expected ErrInvalidTaxRate, got %v", err)
+	}
+	price, _ := domain.NewMoneyFromFraction(1999, 100)
+
+	net, err := rate.Apply(price, domain.TaxModeNet)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// 19.99 * 0.19 = 3.7981 exactly, shown as 3.80 on a gross of 23.79.
+	if net.Tax.Rat().Cmp(big.NewRat(37981, 10000)) != 0 {
+		t.Fatalf("expected exact tax 3.7981, got %s", net.Tax.Rat().FloatString(4))
+	}
+	shown := net.Round(domain.DefaultRounding)
+	if shown.Net.Rat().Cmp(price.Rat()) != 0 || shown.Tax.Rat().Cmp(big.NewRat(380, 100)) != 0 || shown.Gross.Rat().Cmp(big.NewRat(2379, 100)) != 0 {
+		t.Fatalf("expected 19.99 + 3.80 = 23.79, got %s + %s = %s", shown.Net.Rat().FloatString(2), shown.Tax.Rat().FloatString(2), shown.Gross.Rat().FloatString(2))
+	}
+
+	// 19.99 / 1.19 = 16.798..., so 16.80 net and 3.19 tax.
+	gross, err := rate.Apply(price, domain.TaxModeGross)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	shown = gross.Round(domain.DefaultRounding)
+	if shown.Gross.Rat().Cmp(price.Rat()) != 0 || shown.Net.Rat().Cmp(big.NewRat(1680, 100)) != 0 || shown.Tax.Rat().Cmp(big.NewRat(319, 100)) != 0 {
+		t.Fatalf("expected 16.80 + 3.19 = 19.99, got %s + %s = %s", shown.Net.Rat().FloatString(2), shown.Tax.Rat().FloatString(2), shown.Gross.Rat().FloatString(2))
+	}
+}
+
+func TestSetPriceTiers_RequiresContiguousOpenEndedRanges(t *testing.T) {
+	now := time.Date(2026, 1, 12, 10, 0, 0, 0, time.UTC)
+	base, _ := domain.NewMoneyFromFraction(10, 1)
+	p := domain.HydrateProduct(domain.HydrateProductParams{ID: "p1", Name: "name", Description: "desc", Category: "cat", BasePrice: base, Status: domain.ProductStatusActive, Version: 1})
+	tier := func(min, max, price int64, currency domain.Currency) *domain.PriceTier {
+		t.Helper()
+		m, _ := domain.NewMoneyFromFractionIn(price, 1, currency)
+		pt, err := domain.NewPriceTier(min, max, m)
+		if err != nil {
+			t.Fatalf("tier %d-%d: %v", min, max, err)
+		}
+		return pt
+	}
+
+	if _, err := domain.NewPriceTier(10, 9, base); err != domain.ErrInvalidPriceTier {
+		t.Fatalf("expected ErrInvalidPriceTier for an empty range, got %v", err)
+	}
+	for name, c := range map[string]struct {
+		tiers []*domain.PriceTier
+		want  error
+	}{
+		"gap":             {[]*domain.PriceTier{tier(10, 49, 9, "EUR"), tier(51, 0, 8, "EUR")}, domain.ErrPriceTiersNotContiguous},
+		"overlap":         {[]*domain.PriceTier{tier(10, 49, 9, "EUR"), tier(40, 0, 8, "EUR")}, domain.ErrPriceTiersNotContiguous},
+		"closed last":     {[]*domain.PriceTier{tier(10, 49, 9, "EUR")}, domain.ErrPriceTiersNotContiguous},
+		"open middle":     {[]*domain.PriceTier{tier(10, 0, 9, "EUR"), tier(50, 0, 8, "EUR")}, domain.ErrPriceTiersNotContiguous},
+		"other currency":  {[]*domain.PriceTier{tier(10, 0, 9, "USD")}, domain.ErrCurrencyMismatch},
+		"unordered input": {[]*domain.PriceTier{tier(50, 0, 8, "EUR"), tier(10, 49, 9, "EUR")}, nil},
+	} {
+		if err := p.SetPriceTiers(c.tiers, now); err != c.want {
+			t.Fatalf("%s: expected %v, got %v", name, c.want, err)
+		}
+	}
+
+	for q, want := range map[int64]int64{1: 10, 9: 10, 10: 9, 49: 9, 50: 8, 1000: 8} {
+		if got, _ := p.UnitPrice(q); got.Rat().Cmp(big.NewRat(want, 1)) != 0 {
+			t.Fatalf("expected %d per unit for %d units, got %s", want, q, got.Rat().RatString())
+		}
+	}
+	if _, err := p.UnitPrice(0); err != domain.ErrInvalidQuantity {
+		t.Fatalf("expected ErrInvalidQuantity, got %v", err)
+	}
+	if got := p.PriceTiers(); len(got) != 2 || got[0].MinQuantity() != 10 || !p.Changes().Dirty(domain.FieldPriceTiers) {
+		t.Fatalf("expected two ordered, dirty tiers, got %v", got)
+	}
+	evs := p.DomainEvents()
+	if e, ok := evs[0].(domain.ProductPriceTiersSetEvent); len(evs) != 1 || !ok || len(e.Tiers) != 2 {
+		t.Fatalf("expected one price_tiers_set event, got %v", evs)
+	}
+}
+
+func TestSetPriceTiers_FromOneUnitReplacesBasePrice(t *testing.T) {
+	now := time.Date(2026, 1, 12, 10, 0, 0, 0, time.UTC)
+	base, _ := domain.NewMoneyFromFraction(12, 1)
+	p := domain.HydrateProduct(domain.HydrateProductParams{ID: "p1", Name: "name", Description: "desc", Category: "cat", BasePrice: base, Status: domain.ProductStatusActive, Version: 1})
+	var tiers []*domain.PriceTier
+	// 1-9 units at 10.00, 10-49 at 9.00, 50+ at 8.00
+	for _, c := range [][3]int64{{1, 9, 10}, {10, 49, 9}, {50, 0, 8}} {
+		m, _ := domain.NewMoneyFromFraction(c[2], 1)
+		pt, err := domain.NewPriceTier(c[0], c[1], m)
+		if err != nil {
+			t.Fatalf("tier %d-%d: %v", c[0], c[1], err)
+		}
+		tiers = append(tiers, pt)
+	}
+	if err := p.SetPriceTiers(tiers, now); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for q, want := range map[int64]int64{1: 10, 9: 10, 10: 9, 49: 9, 50: 8} {
+		if got, _ := p.UnitPrice(q); got.Rat().Cmp(big.NewRat(want, 1)) != 0 {
+			t.Fatalf("expected %d per unit for %d units, got %s", want, q, got.Rat().RatString())
+		}
+	}
+}
+
+func TestPriceList_ValidityAndEntries(t *testing.T) {
+	from := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+	if _, err := domain.NewPriceList("l1", "Wholesale", "Wholesale", 0, from, to); err != domain.ErrInvalidCustomerGroup {
+		t.Fatalf("expected ErrInvalidCustomerGroup, got %v", err)
+	}
+	if _, err := domain.NewPriceList("l1", "Wholesale", "wholesale", 0, to, from); err != domain.ErrInvalidPriceListPeriod {
+		t.Fatalf("expected ErrInvalidPriceListPeriod, got %v", err)
+	}
+	l, err := domain.NewPriceList("l1", "Wholesale", "wholesale", 1, from, to)
+	if err != nil {
+		t.Fatalf("new: %v", err)
+	}
+	if l.IsValidAt(from.Add(-time.Second)) || !l.IsValidAt(from) || l.IsValidAt(to) {
+		t.Fatalf("expected validity from %v up to but excluding %v", from, to)
+	}
+
+	eur, _ := domain.NewMoneyFromFraction(90, 1)
+	usd, _ := domain.NewMoneyFromFractionIn(100, 1, "USD")
+	for _, m := range []*domain.Money{eur, usd, eur} {
+		e, _ := domain.NewPriceListEntry("p1", m)
+		if err := l.SetEntry(e); err != nil {
+			t.Fatalf("set entry: %v", err)
+		}
+	}
+	if ms := l.EntriesFor("p1"); len(ms) != 2 || ms[0].Currency() != "EUR" || ms[1].Currency() != "USD" {
+		t.Fatalf("expected one EUR and one USD entry, got %v", ms)
+	}
+	if err := l.RemoveEntry("p1", "GBP"); err != domain.ErrPriceListEntryNotFound {
+		t.Fatalf("expected ErrPriceListEntryNotFound, got %v", err)
+	}
+	if err := l.RemoveEntry("p1", "USD"); err != nil || len(l.EntriesFor("p1")) != 1 {
+		t.Fatalf("expected the USD entry removed, got %v", err)
+	}
+	if !l.Changes().Dirty(domain.FieldEntries) {
+		t.Fatal("expected entries to be tracked as changed")
+	}
+}
+
+func TestVariants_SKUsAreUniqueWithinTheProduct(t *testing.T) {
+	now := time.Date(2026, 1, 12, 10, 0, 0, 0, time.UTC)
+	base, _ := domain.NewMoneyFromFraction(20, 1)
+	p := domain.HydrateProduct(domain.HydrateProductParams{ID: "p1", Name: "name", Description: "desc", Category: "cat", BasePrice: base, Status: domain.ProductStatusActive, Version: 1})
+
+	if _, err := domain.NewVariant("v1", "TEE RED", nil, nil, domain.VariantStatusActive); err != domain.ErrInvalidSKU {
+		t.Fatalf("expected ErrInvalidSKU, got %v", err)
+	}
+	if _, err := domain.NewVariant("v1", "TEE-RED", map[string]string{"Color": "red"}, nil, domain.VariantStatusActive); err != domain.ErrInvalidAttributes {
+		t.Fatalf("expected ErrInvalidAttributes, got %v", err)
+	}
+
+	red, _ := domain.NewVariant("v1", "TEE-RED", map[string]string{"color": "red"}, nil, domain.VariantStatusActive)
+	if err := p.AddVariant(red, now); err != nil {
+		t.Fatalf("add: %v", err)
+	}
+	usd, _ := domain.NewMoneyFromFractionIn(25, 1, "USD")
+	for name, c := range map[string]struct {
+		id, sku string
+		price   *domain.Money
+		want    error
+	}{
+		"same ID":        {"v1", "TEE-BLUE", nil, domain.ErrVariantExists},
+		"same SKU":       {"v2", "TEE-RED", nil, domain.ErrDuplicateSKU},
+		"other currency": {"v2", "TEE-BLUE", usd, domain.ErrCurrencyMismatch},
+	} {
+		v, _ := domain.NewVariant(c.id, c.sku, nil, c.price, domain.VariantStatusActive)
+		if err := p.AddVariant(v, now); err != c.want {
+			t.Fatalf("%s: expected %v, got %v", name, c.want, err)
+		}
+	}
+
+	price, _ := domain.NewMoneyFromFraction(22, 1)
+	redXL, _ := domain.NewVariant("v1", "TEE-RED-XL", map[string]string{"color": "red", "size": "XL"}, price, domain.VariantStatusInactive)
+	if err := p.UpdateVariant(redXL, now); err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	if v, _ := p.Variant("v1"); v.SKU() != "TEE-RED-XL" || v.Price() != price || v.Status() != domain.VariantStatusInactive {
+		t.Fatalf("expected the variant replaced, got %+v", v)
+	}
+	if err := p.RemoveVariant("v2", now); err != domain.ErrVariantNotFound {
+		t.Fatalf("expected ErrVariantNotFound, got %v", err)
+	}
+	if err := p.RemoveVariant("v1", now); err != nil || len(p.Variants()) != 0 {
+		t.Fatalf("expected the variant removed, got %v", err)
+	}
+
+	evs := p.DomainEvents()
+	if len(evs) != 3 {
+		t.Fatalf("expected added, updated and removed events, got %v", evs)
+	}
+	if e, ok := evs[2].(domain.ProductVariantRemovedEvent); !ok || e.SKU != "TEE-RED-XL" {
+		t.Fatalf("expected a variant_removed event with the SKU, got %v", evs[2])
+	}
+}
+
+func TestParseGTIN_ChecksDigitAndPadsTo14(t *testing.T) {
+	for code, want := range map[string]domain.GTIN{
+		"":               "",
+		"96385074":       "00000096385074",
+		"036000291452":   "00036000291452",
+		"4006381333931":  "04006381333931",
+		"10614141000415": "10614141000415",
+	} {
+		if got, err := domain.ParseGTIN(code); err != nil || got != want {
+			t.Fatalf("%q: expected %q, got %q (%v)", code, want, got, err)
+		}
+	}
+	for _, code := range []string{"4006381333932", "400638133393A", "40063813339", "0"} {
+		if _, err := domain.ParseGTIN(code); err != domain.ErrInvalidGTIN {
+			t.Fatalf("%q: expected ErrInvalidGTIN, got %v", code, err)
+		}
+	}
+}
+
+func TestSetIdentifiers_TracksAndKeepsSKUsApartFromVariants(t *testing.T) {
+	now := time.Date(2026, 1, 12, 10, 0, 0, 0, time.UTC)
+	base, _ := domain.NewMoneyFromFraction(20, 1)
+	red, _ := domain.NewVariant("v1", "TEE-RED", nil, nil, domain.VariantStatusActive)
+	p := domain.HydrateProduct(domain.HydrateProductParams{ID: "p1", Name: "name", Description: "desc", Category: "cat", BasePrice: base, Variants: []*domain.Variant{red}, Status: domain.ProductStatusActive, Version: 1})
+
+	if err := p.SetIdentifiers("TEE RED", "", now); err != domain.ErrInvalidSKU {
+		t.Fatalf("expected ErrInvalidSKU, got %v", err)
+	}
+	if err := p.SetIdentifiers("TEE", "4006381333932", now); err != domain.ErrInvalidGTIN {
+		t.Fatalf("expected ErrInvalidGTIN, got %v", err)
+	}
+	if err := p.SetIdentifiers("TEE-RED", "", now); err != domain.ErrDuplicateSKU {
+		t.Fatalf("expected ErrDuplicateSKU for a variant's SKU, got %v", err)
+	}
+	if err := p.SetIdentifiers("TEE", "4006381333931", now); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if p.SKU() != "TEE" || p.GTIN() != "04006381333931" || !p.Changes().Dirty(domain.FieldSKU) || !p.Changes().Dirty(domain.FieldGTIN) {
+		t.Fatalf("expected both identifiers set and dirty, got %q %q", p.SKU(), p.GTIN())
+	}
+	evs := p.DomainEvents()
+	if e, ok := evs[0].(domain.ProductUpdatedEvent); len(evs) != 1 || !ok || len(e.Changes) != 2 {
+		t.Fatalf("expected one product.updated event with both changes, got %v", evs)
+	}
+
+	tee, _ := domain.NewVariant("v2", "TEE", nil, nil, domain.VariantStatusActive)
+	if err := p.AddVariant(tee, now); err != domain.ErrDuplicateSKU {
+		t.Fatalf("expected ErrDuplicateSKU for the product's SKU, got %v", err)
+	}
+}
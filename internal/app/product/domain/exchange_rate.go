@@ -0,0 +1,43 @@
+package domain
+
+import (
+	"math/big"
+	"time"
+)
+
+// ExchangeRate converts amounts in From to To from its effective time on,
+// until a rate for the same pair with a later effective time takes over.
+type ExchangeRate struct {
+	from          Currency
+	to            Currency
+	rate          *big.Rat
+	effectiveFrom time.Time
+}
+
+func NewExchangeRate(from, to Currency, rate *big.Rat, effectiveFrom time.Time) (*ExchangeRate, error) {
+	if !from.valid() || !to.valid() {
+		return nil, ErrInvalidCurrency
+	}
+	if from == to || rate == nil || rate.Sign() <= 0 {
+		return nil, ErrInvalidExchangeRate
+	}
+	return &ExchangeRate{from: from, to: to, rate: new(big.Rat).Set(rate), effectiveFrom: effectiveFrom.UTC()}, nil
+}
+
+func (r *ExchangeRate) From() Currency           { return r.from }
+func (r *ExchangeRate) To() Currency             { return r.to }
+func (r *ExchangeRate) Rate() *big.Rat           { return new(big.Rat).Set(r.rate) }
+func (r *ExchangeRate) EffectiveFrom() time.Time { return r.effectiveFrom }
+
+// Convert multiplies m by the rate exactly and rounds the result to the
+// minor unit of To.
+func (r *ExchangeRate) Convert(m *Money, rounding Rounding) (*Money, error) {
+	if m.Currency() != r.from {
+		return nil, ErrCurrencyMismatch
+	}
+	out := rounding.Round(&Money{amount: new(big.Rat).Mul(m.amount, r.rate), currency: r.to})
+	if _, _, ok := out.Fraction(); !ok {
+		return nil, ErrMoneyOverflow
+	}
+	return out, nil
+}
@@ -4,7 +4,19 @@ const (
 	FieldName        = "name"
 	FieldDescription = "description"
 	FieldCategory    = "category"
+	FieldSKU         = "sku"
+	FieldGTIN        = "gtin"
+	FieldTaxClass    = "tax_class"
 	FieldStatus      = "status"
-	FieldDiscount    = "discount"
+	FieldBasePrice   = "base_price"
+	FieldPrices      = "prices"
+	FieldPriceTiers  = "price_tiers"
+	FieldVariants    = "variants"
+	FieldDiscounts   = "discounts"
 	FieldArchivedAt  = "archived_at"
+
+	// Price list fields; FieldName is shared.
+	FieldPriority = "priority"
+	FieldValidity = "validity"
+	FieldEntries  = "entries"
 )
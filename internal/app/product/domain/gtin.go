@@ -0,0 +1,39 @@
+package domain
+
+import "strings"
+
+const gtinLength = 14
+
+// GTIN is a Global Trade Item Number in its 14-digit form. EAN-8, UPC-A
+// and EAN-13 codes are the same numbers without the leading zeros.
+type GTIN string
+
+// ParseGTIN accepts an 8, 12, 13 or 14 digit code whose last digit is its
+// GS1 check digit and returns it padded to 14 digits. Empty means none.
+func ParseGTIN(code string) (GTIN, error) {
+	switch len(code) {
+	case 0:
+		return "", nil
+	case 8, 12, 13, gtinLength:
+	default:
+		return "", ErrInvalidGTIN
+	}
+	// Counting from the check digit, every second digit weighs 3; the
+	// weighted sum of a valid code is a multiple of 10.
+	sum := 0
+	for i := 0; i < len(code); i++ {
+		c := code[len(code)-1-i]
+		if c < '0' || c > '9' {
+			return "", ErrInvalidGTIN
+		}
+		d := int(c - '0')
+		if i%2 == 1 {
+			d *= 3
+		}
+		sum += d
+	}
+	if sum%10 != 0 {
+		return "", ErrInvalidGTIN
+	}
+	return GTIN(strings.Repeat("0", gtinLength-len(code)) + code), nil
+}
@@ -2,42 +2,116 @@ package domain
 
 import (
 	"math/big"
+	"strings"
 )
 
+// Currency is an ISO-4217 alphabetic code.
+type Currency string
+
+// DefaultCurrency is what prices stored before currencies existed are in.
+const DefaultCurrency Currency = "EUR"
+
+// currencies maps each supported currency to its number of minor-unit
+// digits.
+var currencies = map[Currency]int{
+	"EUR": 2, "USD": 2, "GBP": 2, "CHF": 2, "JPY": 0,
+	"SEK": 2, "NOK": 2, "DKK": 2, "PLN": 2, "CZK": 2,
+	"CAD": 2, "AUD": 2,
+}
+
+// ParseCurrency accepts a supported ISO-4217 code in any case; empty means
+// DefaultCurrency.
+func ParseCurrency(code string) (Currency, error) {
+	if code == "" {
+		return DefaultCurrency, nil
+	}
+	c := Currency(strings.ToUpper(code))
+	if !c.valid() {
+		return "", ErrInvalidCurrency
+	}
+	return c, nil
+}
+
+func (c Currency) valid() bool {
+	_, ok := currencies[c]
+	return ok
+}
+
+// MinorUnits is how many decimal digits amounts in c are shown and rounded
+// to: 2 for cents, 0 for JPY.
+func (c Currency) MinorUnits() int {
+	return currencies[c]
+}
+
+// Money is an exact amount in a currency. Arithmetic stays exact; amounts
+// are brought to the currency's minor unit only by a Rounding.
 type Money struct {
-	amount *big.Rat
+	amount   *big.Rat
+	currency Currency
 }
 
-func NewMoneyFromRat(r *big.Rat) (*Money, error) {
+func NewMoney(r *big.Rat, currency Currency) (*Money, error) {
 	if r == nil || r.Sign() < 0 {
 		return nil, ErrInvalidMoney
 	}
-	return &Money{amount: new(big.Rat).Set(r)}, nil
+	if !currency.valid() {
+		return nil, ErrInvalidCurrency
+	}
+	m := &Money{amount: new(big.Rat).Set(r), currency: currency}
+	if _, _, ok := m.Fraction(); !ok {
+		return nil, ErrMoneyOverflow
+	}
+	return m, nil
+}
+
+func NewMoneyFromRat(r *big.Rat) (*Money, error) {
+	return NewMoney(r, DefaultCurrency)
 }
 
 func NewMoneyFromFraction(numerator, denominator int64) (*Money, error) {
+	return NewMoneyFromFractionIn(numerator, denominator, DefaultCurrency)
+}
+
+func NewMoneyFromFractionIn(numerator, denominator int64, currency Currency) (*Money, error) {
 	if denominator == 0 || numerator < 0 {
 		return nil, ErrInvalidMoney
 	}
-	return NewMoneyFromRat(new(big.Rat).SetFrac64(numerator, denominator))
+	return NewMoney(new(big.Rat).SetFrac64(numerator, denominator), currency)
 }
 
 func (m *Money) Rat() *big.Rat {
 	return new(big.Rat).Set(m.amount)
 }
 
-func (m *Money) Numerator() int64 {
-	return m.amount.Num().Int64()
+func (m *Money) Currency() Currency {
+	return m.currency
+}
+
+// Fraction is the reduced fraction of m, with ok false when either part
+// does not fit in an int64.
+func (m *Money) Fraction() (num, den int64, ok bool) {
+	if !m.amount.Num().IsInt64() || !m.amount.Denom().IsInt64() {
+		return 0, 0, false
+	}
+	return m.amount.Num().Int64(), m.amount.Denom().Int64(), true
 }
 
-func (m *Money) Denominator() int64 {
-	return m.amount.Denom().Int64()
+// Add and Sub refuse to combine amounts in different currencies.
+func (m *Money) Add(other *Money) (*Money, error) {
+	if m.currency != other.currency {
+		return nil, ErrCurrencyMismatch
+	}
+	return &Money{amount: new(big.Rat).Add(m.amount, other.amount), currency: m.currency}, nil
 }
 
-func (m *Money) Sub(other *Money) *Money {
-	return &Money{amount: new(big.Rat).Sub(m.amount, other.amount)}
+func (m *Money) Sub(other *Money) (*Money, error) {
+	if m.currency != other.currency {
+		return nil, ErrCurrencyMismatch
+	}
+	return &Money{amount: new(big.Rat).Sub(m.amount, other.amount), currency: m.currency}, nil
 }
 
+// Mul scales m by a plain factor, keeping its currency.
 func (m *Money) Mul(r *big.Rat) *Money {
-	return &Money{amount: new(big.Rat).Mul(m.amount, r)}
+	return &Money{amount: new(big.Rat).Mul(m.amount, r), currency: m.currency}
 }
@@ -0,0 +1,31 @@
+package domain
+
+import "time"
+
+// PriceRecord is what a product cost in one currency from From on, until
+// the next record for the same product and currency. Base and Effective
+// are nil from the moment the product stopped being priced in Currency.
+type PriceRecord struct {
+	ProductID string
+	Currency  Currency
+	Base      *Money
+	Effective *Money
+	From      time.Time
+}
+
+// Priced reports whether the product had a price in the record's currency.
+func (r *PriceRecord) Priced() bool {
+	return r.Effective != nil
+}
+
+// SamePrice reports whether r and other charge the same, treating two
+// unpriced records as equal.
+func (r *PriceRecord) SamePrice(other *PriceRecord) bool {
+	if r.Priced() != other.Priced() {
+		return false
+	}
+	if !r.Priced() {
+		return true
+	}
+	return r.Base.Rat().Cmp(other.Base.Rat()) == 0 && r.Effective.Rat().Cmp(other.Effective.Rat()) == 0
+}
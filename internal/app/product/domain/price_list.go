@@ -0,0 +1,201 @@
+package domain
+
+import (
+	"regexp"
+	"sort"
+	"time"
+)
+
+// CustomerGroup names the customers a price list is for, such as
+// "wholesale" or "employee".
+type CustomerGroup string
+
+var customerGroupPattern = regexp.MustCompile(`^[a-z][a-z0-9_-]{0,31}$`)
+
+// ParseCustomerGroup accepts a lower-case name of up to 32 letters, digits,
+// '_' and '-'.
+func ParseCustomerGroup(name string) (CustomerGroup, error) {
+	if !customerGroupPattern.MatchString(name) {
+		return "", ErrInvalidCustomerGroup
+	}
+	return CustomerGroup(name), nil
+}
+
+// PriceListEntry is what a price list charges for a product in one
+// currency, in place of the product's own price there.
+type PriceListEntry struct {
+	productID string
+	price     *Money
+}
+
+func NewPriceListEntry(productID string, price *Money) (*PriceListEntry, error) {
+	if productID == "" {
+		return nil, ErrInvalidProductID
+	}
+	if price == nil {
+		return nil, ErrInvalidMoney
+	}
+	return &PriceListEntry{productID: productID, price: price}, nil
+}
+
+func (e *PriceListEntry) ProductID() string { return e.productID }
+func (e *PriceListEntry) Price() *Money     { return e.price }
+
+// PriceList overrides product prices for one customer group while it is
+// valid. Where several valid lists of the group price a product, the one
+// with the highest priority wins.
+type PriceList struct {
+	id        string
+	name      string
+	group     CustomerGroup
+	priority  int64
+	validFrom time.Time
+	validTo   time.Time
+	entries   []*PriceListEntry
+	version   int64
+
+	changes *ChangeTracker
+}
+
+// NewPriceList creates an empty list valid from validFrom until validTo;
+// either may be zero for no bound.
+func NewPriceList(id, name string, group CustomerGroup, priority int64, validFrom, validTo time.Time) (*PriceList, error) {
+	if id == "" {
+		return nil, ErrInvalidPriceListID
+	}
+	if _, err := ParseCustomerGroup(string(group)); err != nil {
+		return nil, err
+	}
+	l := &PriceList{id: id, group: group, changes: NewChangeTracker()}
+	if err := l.Update(name, priority, validFrom, validTo); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+func HydratePriceList(id, name string, group CustomerGroup, priority int64, validFrom, validTo time.Time, entries []*PriceListEntry, version int64) *PriceList {
+	return &PriceList{
+		id:        id,
+		name:      name,
+		group:     group,
+		priority:  priority,
+		validFrom: validFrom,
+		validTo:   validTo,
+		entries:   sortedEntries(entries),
+		version:   version,
+		changes:   NewChangeTracker(),
+	}
+}
+
+func (l *PriceList) ID() string                   { return l.id }
+func (l *PriceList) Name() string                 { return l.name }
+func (l *PriceList) CustomerGroup() CustomerGroup { return l.group }
+func (l *PriceList) Priority() int64              { return l.priority }
+func (l *PriceList) ValidFrom() time.Time         { return l.validFrom }
+func (l *PriceList) ValidTo() time.Time           { return l.validTo }
+func (l *PriceList) Version() int64               { return l.version }
+func (l *PriceList) Changes() *ChangeTracker      { return l.changes }
+
+// Entries returns the list's entries ordered by product and currency.
+func (l *PriceList) Entries() []*PriceListEntry {
+	out := make([]*PriceListEntry, len(l.entries))
+	copy(out, l.entries)
+	return out
+}
+
+// EntriesFor returns the list's prices for productID, ordered by currency.
+func (l *PriceList) EntriesFor(productID string) []*Money {
+	var out []*Money
+	for _, e := range l.entries {
+		if e.productID == productID {
+			out = append(out, e.price)
+		}
+	}
+	return out
+}
+
+// IsValidAt reports whether the list applies at t. Like discount windows,
+// validity is start-inclusive and end-exclusive.
+func (l *PriceList) IsValidAt(t time.Time) bool {
+	return (l.validFrom.IsZero() || !t.Before(l.validFrom)) && (l.validTo.IsZero() || t.Before(l.validTo))
+}
+
+// Update replaces the list's name, priority and validity.
+func (l *PriceList) Update(name string, priority int64, validFrom, validTo time.Time) error {
+	if name == "" {
+		return ErrInvalidPriceListName
+	}
+	if !validFrom.IsZero() && !validTo.IsZero() && !validTo.After(validFrom) {
+		return ErrInvalidPriceListPeriod
+	}
+	if !validFrom.IsZero() {
+		validFrom = validFrom.UTC()
+	}
+	if !validTo.IsZero() {
+		validTo = validTo.UTC()
+	}
+	if name != l.name {
+		l.changes.Track(FieldName, l.name, name)
+		l.name = name
+	}
+	if priority != l.priority {
+		l.changes.Track(FieldPriority, l.priority, priority)
+		l.priority = priority
+	}
+	if !validFrom.Equal(l.validFrom) || !validTo.Equal(l.validTo) {
+		l.changes.Track(FieldValidity, [2]time.Time{l.validFrom, l.validTo}, [2]time.Time{validFrom, validTo})
+		l.validFrom, l.validTo = validFrom, validTo
+	}
+	return nil
+}
+
+// SetEntry adds or replaces the list's price for a product in the entry's
+// currency.
+func (l *PriceList) SetEntry(entry *PriceListEntry) error {
+	if entry == nil {
+		return ErrInvalidMoney
+	}
+	old := l.Entries()
+	next := []*PriceListEntry{entry}
+	for _, e := range old {
+		if !e.sameKey(entry) {
+			next = append(next, e)
+		}
+	}
+	l.entries = sortedEntries(next)
+	l.changes.Track(FieldEntries, old, l.Entries())
+	return nil
+}
+
+// RemoveEntry drops the list's price for productID in currency.
+func (l *PriceList) RemoveEntry(productID string, currency Currency) error {
+	old := l.Entries()
+	var kept []*PriceListEntry
+	for _, e := range old {
+		if e.productID != productID || e.price.Currency() != currency {
+			kept = append(kept, e)
+		}
+	}
+	if len(kept) == len(old) {
+		return ErrPriceListEntryNotFound
+	}
+	l.entries = kept
+	l.changes.Track(FieldEntries, old, l.Entries())
+	return nil
+}
+
+func (e *PriceListEntry) sameKey(other *PriceListEntry) bool {
+	return e.productID == other.productID && e.price.Currency() == other.price.Currency()
+}
+
+func sortedEntries(es []*PriceListEntry) []*PriceListEntry {
+	out := make([]*PriceListEntry, len(es))
+	copy(out, es)
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].productID != out[j].productID {
+			return out[i].productID < out[j].productID
+		}
+		return out[i].price.Currency() < out[j].price.Currency()
+	})
+	return out
+}
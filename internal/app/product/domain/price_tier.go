@@ -0,0 +1,73 @@
+package domain
+
+// PriceTier is a quantity break: every unit of an order for between
+// MinQuantity and MaxQuantity units costs UnitPrice. MaxQuantity is zero
+// for the last, open-ended tier.
+type PriceTier struct {
+	minQuantity int64
+	maxQuantity int64
+	unitPrice   *Money
+}
+
+// NewPriceTier checks the tier on its own; how it fits with the others is
+// checked by Product.SetPriceTiers.
+func NewPriceTier(minQuantity, maxQuantity int64, unitPrice *Money) (*PriceTier, error) {
+	if minQuantity < 1 || (maxQuantity != 0 && maxQuantity < minQuantity) {
+		return nil, ErrInvalidPriceTier
+	}
+	if unitPrice == nil {
+		return nil, ErrInvalidMoney
+	}
+	return &PriceTier{minQuantity: minQuantity, maxQuantity: maxQuantity, unitPrice: unitPrice}, nil
+}
+
+func (t *PriceTier) MinQuantity() int64 { return t.minQuantity }
+func (t *PriceTier) MaxQuantity() int64 { return t.maxQuantity }
+func (t *PriceTier) UnitPrice() *Money  { return t.unitPrice }
+
+// Contains reports whether an order for quantity units falls in the tier.
+func (t *PriceTier) Contains(quantity int64) bool {
+	return quantity >= t.minQuantity && (t.maxQuantity == 0 || quantity <= t.maxQuantity)
+}
+
+func (t *PriceTier) equal(other *PriceTier) bool {
+	return t.minQuantity == other.minQuantity && t.maxQuantity == other.maxQuantity &&
+		t.unitPrice.Currency() == other.unitPrice.Currency() &&
+		t.unitPrice.Rat().Cmp(other.unitPrice.Rat()) == 0
+}
+
+// validPriceTiers orders tiers and checks that they run on from one
+// another without gaps or overlaps and end open. Quantities below the first
+// tier pay the base price; a first tier from one unit replaces it.
+func validPriceTiers(tiers []*PriceTier, base *Money) ([]*PriceTier, error) {
+	for _, t := range tiers {
+		if t == nil {
+			return nil, ErrInvalidPriceTier
+		}
+	}
+	out := sortedPriceTiers(tiers)
+	for i, t := range out {
+		if t.unitPrice.Currency() != base.Currency() {
+			return nil, ErrCurrencyMismatch
+		}
+		if i > 0 && t.minQuantity != out[i-1].maxQuantity+1 {
+			return nil, ErrPriceTiersNotContiguous
+		}
+		if last := i == len(out)-1; last != (t.maxQuantity == 0) {
+			return nil, ErrPriceTiersNotContiguous
+		}
+	}
+	return out, nil
+}
+
+func samePriceTiers(a, b []*PriceTier) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].equal(b[i]) {
+			return false
+		}
+	}
+	return true
+}
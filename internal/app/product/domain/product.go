@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"sort"
 	"time"
 )
 
@@ -16,41 +17,79 @@ type Product struct {
 	name        string
 	description string
 	category    string
+	sku         string
+	gtin        GTIN
+	taxClass    TaxClass
 	basePrice   *Money
-	discount    *Discount
+	prices      []*Money
+	priceTiers  []*PriceTier
+	variants    []*Variant
+	discounts   []*Discount
 	status      ProductStatus
 	archivedAt  *time.Time
+	version     int64
 
 	changes *ChangeTracker
 	events  []DomainEvent
 }
 
-func NewProduct(id, name, description, category string, basePrice *Money, now time.Time) (*Product, error) {
-	if id == "" {
+// NewProductParams describes a product to create.
+type NewProductParams struct {
+	ID          string
+	Name        string
+	Description string
+	Category    string
+	SKU         string
+	GTIN        GTIN
+	BasePrice   *Money
+}
+
+func NewProduct(params NewProductParams, now time.Time) (*Product, error) {
+	if params.ID == "" {
 		return nil, ErrInvalidProductID
 	}
-	if name == "" {
+	if params.Name == "" {
 		return nil, ErrInvalidProductName
 	}
-	if category == "" {
+	if params.Category == "" {
 		return nil, ErrInvalidCategory
 	}
-	if basePrice == nil {
+	if params.SKU != "" && !skuPattern.MatchString(params.SKU) {
+		return nil, ErrInvalidSKU
+	}
+	gtin, err := ParseGTIN(string(params.GTIN))
+	if err != nil {
+		return nil, err
+	}
+	if params.BasePrice == nil {
 		return nil, ErrInvalidMoney
 	}
 
 	t := now.UTC()
 
 	p := &Product{
-		id:          id,
-		name:        name,
-		description: description,
-		category:    category,
-		basePrice:   basePrice,
+		id:          params.ID,
+		name:        params.Name,
+		description: params.Description,
+		category:    params.Category,
+		sku:         params.SKU,
+		gtin:        gtin,
+		basePrice:   params.BasePrice,
 		status:      ProductStatusInactive,
 		changes:     NewChangeTracker(),
 	}
-	p.events = append(p.events, ProductCreatedEvent{ProductID: p.id, At: t})
+	p.events = append(p.events, ProductCreatedEvent{
+		ProductID:   p.id,
+		Name:        p.name,
+		Description: p.description,
+		Category:    p.category,
+		SKU:         p.sku,
+		GTIN:        p.gtin,
+		BasePrice:   p.basePrice.Rat(),
+		Currency:    p.basePrice.Currency(),
+		Status:      p.status,
+		At:          t,
+	})
 	p.changes.MarkDirty(FieldName)
 	p.changes.MarkDirty(FieldDescription)
 	p.changes.MarkDirty(FieldCategory)
@@ -58,22 +97,42 @@ func NewProduct(id, name, description, category string, basePrice *Money, now ti
 	return p, nil
 }
 
-func HydrateProduct(
-	id, name, description, category string,
-	basePrice *Money,
-	discount *Discount,
-	status ProductStatus,
-	archivedAt *time.Time,
-) *Product {
+// HydrateProductParams is a product as stored.
+type HydrateProductParams struct {
+	ID          string
+	Name        string
+	Description string
+	Category    string
+	SKU         string
+	GTIN        GTIN
+	TaxClass    TaxClass
+	BasePrice   *Money
+	Prices      []*Money
+	PriceTiers  []*PriceTier
+	Variants    []*Variant
+	Discounts   []*Discount
+	Status      ProductStatus
+	ArchivedAt  *time.Time
+	Version     int64
+}
+
+func HydrateProduct(params HydrateProductParams) *Product {
 	return &Product{
-		id:          id,
-		name:        name,
-		description: description,
-		category:    category,
-		basePrice:   basePrice,
-		discount:    discount,
-		status:      status,
-		archivedAt:  archivedAt,
+		id:          params.ID,
+		name:        params.Name,
+		description: params.Description,
+		category:    params.Category,
+		sku:         params.SKU,
+		gtin:        params.GTIN,
+		taxClass:    params.TaxClass,
+		basePrice:   params.BasePrice,
+		prices:      sortedPrices(params.Prices),
+		priceTiers:  sortedPriceTiers(params.PriceTiers),
+		variants:    sortedVariants(params.Variants),
+		discounts:   sortedDiscounts(params.Discounts),
+		status:      params.Status,
+		archivedAt:  params.ArchivedAt,
+		version:     params.Version,
 		changes:     NewChangeTracker(),
 	}
 }
@@ -82,12 +141,82 @@ func (p *Product) ID() string              { return p.id }
 func (p *Product) Name() string            { return p.name }
 func (p *Product) Description() string     { return p.description }
 func (p *Product) Category() string        { return p.category }
+func (p *Product) SKU() string             { return p.sku }
+func (p *Product) GTIN() GTIN              { return p.gtin }
+func (p *Product) TaxClass() TaxClass      { return p.taxClass }
 func (p *Product) BasePrice() *Money       { return p.basePrice }
-func (p *Product) Discount() *Discount     { return p.discount }
 func (p *Product) Status() ProductStatus   { return p.status }
 func (p *Product) ArchivedAt() *time.Time  { return p.archivedAt }
+func (p *Product) Version() int64          { return p.version }
 func (p *Product) Changes() *ChangeTracker { return p.changes }
 
+// Prices returns the base price first, then the others by currency.
+func (p *Product) Prices() []*Money {
+	return append([]*Money{p.basePrice}, p.prices...)
+}
+
+func (p *Product) PriceIn(currency Currency) (*Money, bool) {
+	for _, m := range p.Prices() {
+		if m.Currency() == currency {
+			return m, true
+		}
+	}
+	return nil, false
+}
+
+// PriceTiers are in the base currency, ordered by quantity.
+func (p *Product) PriceTiers() []*PriceTier {
+	out := make([]*PriceTier, len(p.priceTiers))
+	copy(out, p.priceTiers)
+	return out
+}
+
+// UnitPrice is the undiscounted base-currency price per unit at quantity.
+func (p *Product) UnitPrice(quantity int64) (*Money, error) {
+	if quantity < 1 {
+		return nil, ErrInvalidQuantity
+	}
+	for _, t := range p.priceTiers {
+		if t.Contains(quantity) {
+			return t.unitPrice, nil
+		}
+	}
+	return p.basePrice, nil
+}
+
+func (p *Product) Variants() []*Variant {
+	out := make([]*Variant, len(p.variants))
+	copy(out, p.variants)
+	return out
+}
+
+func (p *Product) Variant(id string) (*Variant, bool) {
+	for _, v := range p.variants {
+		if v.id == id {
+			return v, true
+		}
+	}
+	return nil, false
+}
+
+// Discounts returns the schedule by start, expired discounts included.
+func (p *Product) Discounts() []*Discount {
+	out := make([]*Discount, len(p.discounts))
+	copy(out, p.discounts)
+	return out
+}
+
+// DiscountsAt returns the discounts whose window contains t.
+func (p *Product) DiscountsAt(t time.Time) []*Discount {
+	var out []*Discount
+	for _, d := range p.discounts {
+		if d.IsValidAt(t) {
+			out = append(out, d)
+		}
+	}
+	return out
+}
+
 func (p *Product) DomainEvents() []DomainEvent {
 	out := make([]DomainEvent, len(p.events))
 	copy(out, p.events)
@@ -106,29 +235,72 @@ func (p *Product) UpdateDetails(name, description, category string, now time.Tim
 		return ErrInvalidCategory
 	}
 
-	changed := false
+	var changed []string
 
 	if p.name != name {
+		p.changes.Track(FieldName, p.name, name)
 		p.name = name
-		p.changes.MarkDirty(FieldName)
-		changed = true
+		changed = append(changed, FieldName)
 	}
 	if p.description != description {
+		p.changes.Track(FieldDescription, p.description, description)
 		p.description = description
-		p.changes.MarkDirty(FieldDescription)
-		changed = true
+		changed = append(changed, FieldDescription)
 	}
 	if p.category != category {
+		p.changes.Track(FieldCategory, p.category, category)
 		p.category = category
-		p.changes.MarkDirty(FieldCategory)
-		changed = true
+		changed = append(changed, FieldCategory)
 	}
 
-	if changed {
-		t := now.UTC()
-		p.events = append(p.events, ProductUpdatedEvent{ProductID: p.id, At: t})
+	if len(changed) > 0 {
+		ev := ProductUpdatedEvent{ProductID: p.id, At: now.UTC()}
+		for _, f := range changed {
+			c, _ := p.changes.Change(f)
+			ev.Changes = append(ev.Changes, c)
+		}
+		p.events = append(p.events, ev)
+	}
+
+	return nil
+}
+
+// SetIdentifiers does not check other products; that is the caller's job.
+func (p *Product) SetIdentifiers(sku string, gtin GTIN, now time.Time) error {
+	if sku != "" && !skuPattern.MatchString(sku) {
+		return ErrInvalidSKU
+	}
+	gtin, err := ParseGTIN(string(gtin))
+	if err != nil {
+		return err
+	}
+	for _, v := range p.variants {
+		if v.sku == sku {
+			return ErrDuplicateSKU
+		}
+	}
+
+	var changed []string
+
+	if p.sku != sku {
+		p.changes.Track(FieldSKU, p.sku, sku)
+		p.sku = sku
+		changed = append(changed, FieldSKU)
+	}
+	if p.gtin != gtin {
+		p.changes.Track(FieldGTIN, p.gtin, gtin)
+		p.gtin = gtin
+		changed = append(changed, FieldGTIN)
 	}
 
+	if len(changed) > 0 {
+		ev := ProductUpdatedEvent{ProductID: p.id, At: now.UTC()}
+		for _, f := range changed {
+			c, _ := p.changes.Change(f)
+			ev.Changes = append(ev.Changes, c)
+		}
+		p.events = append(p.events, ev)
+	}
 	return nil
 }
 
@@ -136,8 +308,8 @@ func (p *Product) Activate(now time.Time) error {
 	if p.status == ProductStatusActive {
 		return nil
 	}
+	p.changes.Track(FieldStatus, p.status, ProductStatusActive)
 	p.status = ProductStatusActive
-	p.changes.MarkDirty(FieldStatus)
 	t := now.UTC()
 	p.events = append(p.events, ProductActivatedEvent{ProductID: p.id, At: t})
 	return nil
@@ -147,13 +319,188 @@ func (p *Product) Deactivate(now time.Time) error {
 	if p.status == ProductStatusInactive {
 		return nil
 	}
+	p.changes.Track(FieldStatus, p.status, ProductStatusInactive)
 	p.status = ProductStatusInactive
-	p.changes.MarkDirty(FieldStatus)
 	t := now.UTC()
 	p.events = append(p.events, ProductDeactivatedEvent{ProductID: p.id, At: t})
 	return nil
 }
 
+// SetTaxClass with an empty class falls back to the category's.
+func (p *Product) SetTaxClass(class TaxClass, now time.Time) error {
+	if _, err := ParseTaxClass(string(class)); err != nil {
+		return err
+	}
+	if class == p.taxClass {
+		return nil
+	}
+	old := p.taxClass
+	p.taxClass = class
+	p.changes.Track(FieldTaxClass, old, class)
+	p.events = append(p.events, ProductTaxClassChangedEvent{ProductID: p.id, OldClass: old, NewClass: class, At: now.UTC()})
+	return nil
+}
+
+func (p *Product) ChangeBasePrice(price *Money, now time.Time) error {
+	if price == nil {
+		return ErrInvalidMoney
+	}
+	if price.Currency() != p.basePrice.Currency() {
+		return ErrCurrencyMismatch
+	}
+	if price.Rat().Cmp(p.basePrice.Rat()) == 0 {
+		return nil
+	}
+	old := p.basePrice
+	p.basePrice = price
+	p.changes.Track(FieldBasePrice, old, price)
+	p.events = append(p.events, ProductPriceChangedEvent{ProductID: p.id, OldPrice: old, NewPrice: price, At: now.UTC()})
+	return nil
+}
+
+// SetPrice sets a price in a currency other than the base one.
+func (p *Product) SetPrice(price *Money, now time.Time) error {
+	if price == nil {
+		return ErrInvalidMoney
+	}
+	if price.Currency() == p.basePrice.Currency() {
+		return ErrBaseCurrencyPrice
+	}
+	old := p.otherPrices()
+	next := []*Money{price}
+	for _, m := range old {
+		if m.Currency() != price.Currency() {
+			next = append(next, m)
+		}
+	}
+	p.prices = sortedPrices(next)
+	p.changes.Track(FieldPrices, old, p.otherPrices())
+	p.events = append(p.events, ProductPriceSetEvent{ProductID: p.id, Price: price, At: now.UTC()})
+	return nil
+}
+
+func (p *Product) RemovePrice(currency Currency, now time.Time) error {
+	if currency == p.basePrice.Currency() {
+		return ErrBaseCurrencyPrice
+	}
+	old := p.otherPrices()
+	var kept []*Money
+	for _, m := range old {
+		if m.Currency() != currency {
+			kept = append(kept, m)
+		}
+	}
+	if len(kept) == len(old) {
+		return ErrPriceNotFound
+	}
+	p.prices = kept
+	p.changes.Track(FieldPrices, old, p.otherPrices())
+	p.events = append(p.events, ProductPriceRemovedEvent{ProductID: p.id, Currency: currency, At: now.UTC()})
+	return nil
+}
+
+// SetPriceTiers requires contiguous base-currency tiers ending open.
+func (p *Product) SetPriceTiers(tiers []*PriceTier, now time.Time) error {
+	next, err := validPriceTiers(tiers, p.basePrice)
+	if err != nil {
+		return err
+	}
+	if samePriceTiers(next, p.priceTiers) {
+		return nil
+	}
+	old := p.PriceTiers()
+	p.priceTiers = next
+	p.changes.Track(FieldPriceTiers, old, p.PriceTiers())
+	p.events = append(p.events, ProductPriceTiersSetEvent{ProductID: p.id, Tiers: p.PriceTiers(), At: now.UTC()})
+	return nil
+}
+
+func (p *Product) AddVariant(v *Variant, now time.Time) error {
+	if v == nil {
+		return ErrInvalidVariantID
+	}
+	if _, ok := p.Variant(v.id); ok {
+		return ErrVariantExists
+	}
+	if err := p.checkVariant(v); err != nil {
+		return err
+	}
+	old := p.Variants()
+	p.variants = sortedVariants(append(old, v))
+	p.changes.Track(FieldVariants, old, p.Variants())
+	p.events = append(p.events, ProductVariantAddedEvent{ProductID: p.id, Variant: v, At: now.UTC()})
+	return nil
+}
+
+func (p *Product) UpdateVariant(v *Variant, now time.Time) error {
+	if v == nil {
+		return ErrInvalidVariantID
+	}
+	prev, ok := p.Variant(v.id)
+	if !ok {
+		return ErrVariantNotFound
+	}
+	if err := p.checkVariant(v); err != nil {
+		return err
+	}
+	if prev.equal(v) {
+		return nil
+	}
+	old := p.Variants()
+	next := []*Variant{v}
+	for _, o := range old {
+		if o.id != v.id {
+			next = append(next, o)
+		}
+	}
+	p.variants = sortedVariants(next)
+	p.changes.Track(FieldVariants, old, p.Variants())
+	p.events = append(p.events, ProductVariantUpdatedEvent{ProductID: p.id, Variant: v, At: now.UTC()})
+	return nil
+}
+
+func (p *Product) RemoveVariant(id string, now time.Time) error {
+	v, ok := p.Variant(id)
+	if !ok {
+		return ErrVariantNotFound
+	}
+	old := p.Variants()
+	var kept []*Variant
+	for _, o := range old {
+		if o.id != id {
+			kept = append(kept, o)
+		}
+	}
+	p.variants = kept
+	p.changes.Track(FieldVariants, old, p.Variants())
+	p.events = append(p.events, ProductVariantRemovedEvent{ProductID: p.id, VariantID: id, SKU: v.sku, At: now.UTC()})
+	return nil
+}
+
+func (p *Product) checkVariant(v *Variant) error {
+	if v.price != nil && v.price.Currency() != p.basePrice.Currency() {
+		return ErrCurrencyMismatch
+	}
+	if v.sku == p.sku {
+		return ErrDuplicateSKU
+	}
+	for _, o := range p.variants {
+		if o.id != v.id && o.sku == v.sku {
+			return ErrDuplicateSKU
+		}
+	}
+	return nil
+}
+
+func (p *Product) otherPrices() []*Money {
+	out := make([]*Money, len(p.prices))
+	copy(out, p.prices)
+	return out
+}
+
+// ApplyDiscount rejects discounts that have ended, amounts in a currency
+// the product lacks or above its price, and exclusive discounts sharing a
+// priority.
 func (p *Product) ApplyDiscount(discount *Discount, now time.Time) error {
 	if p.status != ProductStatusActive {
 		return ErrProductNotActive
@@ -161,40 +508,183 @@ func (p *Product) ApplyDiscount(discount *Discount, now time.Time) error {
 	if discount == nil {
 		return ErrInvalidDiscountPercent
 	}
-	if !discount.IsValidAt(now) {
+	if discount.IsExpiredAt(now) {
 		return ErrInvalidDiscountPeriod
 	}
-	if p.discount != nil && p.discount.Overlaps(discount) {
-		return ErrDiscountOverlaps
+	if discount.Kind() != DiscountPercentage {
+		price, ok := p.PriceIn(discount.Amount().Currency())
+		if !ok {
+			return ErrCurrencyMismatch
+		}
+		if discount.Amount().Rat().Cmp(price.Rat()) > 0 {
+			return ErrInvalidDiscountAmount
+		}
+	}
+	for _, d := range p.discounts {
+		if d.ID() == discount.ID() {
+			return ErrInvalidDiscountID
+		}
+		if !d.IsExpiredAt(now) && d.Overlaps(discount) && d.Stacking() == StackExclusive &&
+			discount.Stacking() == StackExclusive && d.Priority() == discount.Priority() {
+			return ErrDiscountOverlaps
+		}
 	}
 
-	p.discount = discount
-	p.changes.MarkDirty(FieldDiscount)
 	t := now.UTC()
-	p.events = append(p.events, DiscountAppliedEvent{ProductID: p.id, At: t})
+	old := p.Discounts()
+	if discount.IsValidAt(t) {
+		discount = discount.withStatus(DiscountActive)
+		p.events = append(p.events, DiscountAppliedEvent{ProductID: p.id, Discount: discount, At: t})
+	} else {
+		discount = discount.withStatus(DiscountScheduled)
+		p.events = append(p.events, DiscountScheduledEvent{ProductID: p.id, Discount: discount, At: t})
+	}
+	p.discounts = sortedDiscounts(append(old, discount))
+	p.changes.Track(FieldDiscounts, old, p.Discounts())
 	return nil
 }
 
-func (p *Product) RemoveDiscount(now time.Time) error {
-	if p.discount == nil {
+// RemoveDiscount ends the discount at now, or drops it if not yet started.
+// An empty id means the single discount in effect.
+func (p *Product) RemoveDiscount(id string, now time.Time) error {
+	var removed *Discount
+	if id == "" {
+		current := p.DiscountsAt(now)
+		if len(current) > 1 {
+			return ErrInvalidDiscountID
+		}
+		if len(current) == 1 {
+			removed = current[0]
+		}
+	} else {
+		for _, d := range p.discounts {
+			if d.ID() == id && d.Status() != DiscountExpired {
+				removed = d
+			}
+		}
+		if removed == nil {
+			return ErrDiscountNotFound
+		}
+	}
+	if removed == nil {
 		return nil
 	}
-	p.discount = nil
-	p.changes.MarkDirty(FieldDiscount)
-	t := now.UTC()
-	p.events = append(p.events, DiscountRemovedEvent{ProductID: p.id, At: t})
+
+	old := p.Discounts()
+	var kept []*Discount
+	for _, d := range old {
+		switch {
+		case d != removed:
+			kept = append(kept, d)
+		case d.Start().Before(now):
+			kept = append(kept, d.endedAt(now))
+		}
+	}
+	p.discounts = kept
+	p.changes.Track(FieldDiscounts, old, p.Discounts())
+	p.events = append(p.events, DiscountRemovedEvent{ProductID: p.id, Discount: removed, At: now.UTC()})
 	return nil
 }
 
+// AdvanceDiscounts starts and expires discounts as of now.
+func (p *Product) AdvanceDiscounts(now time.Time) bool {
+	t := now.UTC()
+	old := p.Discounts()
+	var (
+		next    []*Discount
+		changed bool
+	)
+	for _, d := range old {
+		switch {
+		case d.Status() == DiscountExpired:
+			next = append(next, d)
+		case d.IsExpiredAt(t):
+			d = d.withStatus(DiscountExpired)
+			p.events = append(p.events, DiscountExpiredEvent{ProductID: p.id, Discount: d, At: t})
+			next = append(next, d)
+			changed = true
+		case d.Status() == DiscountScheduled && d.IsValidAt(t):
+			d = d.withStatus(DiscountActive)
+			p.events = append(p.events, DiscountStartedEvent{ProductID: p.id, Discount: d, At: t})
+			next = append(next, d)
+			changed = true
+		default:
+			next = append(next, d)
+		}
+	}
+	if changed {
+		p.discounts = next
+		p.changes.Track(FieldDiscounts, old, p.Discounts())
+	}
+	return changed
+}
+
+// PruneDiscounts drops expired discounts that ended before before.
+func (p *Product) PruneDiscounts(before time.Time) bool {
+	old := p.Discounts()
+	var next []*Discount
+	for _, d := range old {
+		if d.Status() == DiscountExpired && d.End().Before(before) {
+			continue
+		}
+		next = append(next, d)
+	}
+	if len(next) == len(old) {
+		return false
+	}
+	p.discounts = next
+	p.changes.Track(FieldDiscounts, old, p.Discounts())
+	return true
+}
+
 func (p *Product) Archive(now time.Time) error {
 	if p.archivedAt != nil {
 		return nil
 	}
 	t := now.UTC()
+	p.changes.Track(FieldArchivedAt, (*time.Time)(nil), &t)
+	if p.status != ProductStatusInactive {
+		p.changes.Track(FieldStatus, p.status, ProductStatusInactive)
+	}
 	p.archivedAt = &t
 	p.status = ProductStatusInactive
-	p.changes.MarkDirty(FieldArchivedAt)
-	p.changes.MarkDirty(FieldStatus)
 	p.events = append(p.events, ProductArchivedEvent{ProductID: p.id, At: t})
 	return nil
 }
+
+// Restore fails once retention has passed; retention <= 0 means never.
+func (p *Product) Restore(now time.Time, retention time.Duration) error {
+	if p.archivedAt == nil {
+		return nil
+	}
+	t := now.UTC()
+	if retention > 0 && t.Sub(*p.archivedAt) > retention {
+		return ErrRestoreWindowExpired
+	}
+	archivedAt := *p.archivedAt
+	p.changes.Track(FieldArchivedAt, p.archivedAt, (*time.Time)(nil))
+	p.archivedAt = nil
+	p.events = append(p.events, ProductRestoredEvent{ProductID: p.id, ArchivedAt: archivedAt, At: t})
+	return nil
+}
+
+func sortedPrices(ms []*Money) []*Money {
+	out := make([]*Money, len(ms))
+	copy(out, ms)
+	sort.Slice(out, func(i, j int) bool { return out[i].Currency() < out[j].Currency() })
+	return out
+}
+
+func sortedPriceTiers(ts []*PriceTier) []*PriceTier {
+	out := make([]*PriceTier, len(ts))
+	copy(out, ts)
+	sort.Slice(out, func(i, j int) bool { return out[i].minQuantity < out[j].minQuantity })
+	return out
+}
+
+func sortedDiscounts(ds []*Discount) []*Discount {
+	out := make([]*Discount, len(ds))
+	copy(out, ds)
+	sort.SliceStable(out, func(i, j int) bool { return out[i].start.Before(out[j].start) })
+	return out
+}
@@ -0,0 +1,101 @@
+package domain
+
+import "math/big"
+
+type RoundingMode string
+
+const (
+	// RoundHalfEven rounds ties to the even digit, so repeated rounding
+	// does not drift in either direction.
+	RoundHalfEven RoundingMode = "half_even"
+	// RoundHalfUp rounds ties away from zero.
+	RoundHalfUp RoundingMode = "half_up"
+	// RoundDown truncates toward zero, never charging more than the exact
+	// amount.
+	RoundDown RoundingMode = "down"
+)
+
+// Rounding says how computed amounts are brought to their currency's minor
+// unit, and optionally moved to a charm price.
+type Rounding struct {
+	Mode RoundingMode
+	// CharmEnding, when set, is the ending such as 0.99 that Charm lowers
+	// prices to: 18.00 and 18.50 both become 17.99, and 17.99 stays.
+	CharmEnding *big.Rat
+}
+
+// DefaultRounding rounds half up to the minor unit with no charm pricing.
+var DefaultRounding = Rounding{Mode: RoundHalfUp}
+
+// ParseRounding reads a mode name and a charm ending written as a decimal
+// such as "0.99". An empty mode means that of DefaultRounding and an empty
+// charm means none.
+func ParseRounding(mode, charm string) (Rounding, error) {
+	r := DefaultRounding
+	if mode != "" {
+		r.Mode = RoundingMode(mode)
+	}
+	switch r.Mode {
+	case RoundHalfEven, RoundHalfUp, RoundDown:
+	default:
+		return Rounding{}, ErrInvalidRounding
+	}
+	if charm != "" {
+		c, ok := new(big.Rat).SetString(charm)
+		if !ok || c.Sign() < 0 || c.Cmp(big.NewRat(1, 1)) >= 0 {
+			return Rounding{}, ErrInvalidRounding
+		}
+		r.CharmEnding = c
+	}
+	return r, nil
+}
+
+// Round brings m to the minor unit of its currency.
+func (r Rounding) Round(m *Money) *Money {
+	return &Money{amount: r.roundTo(m.amount, m.currency.MinorUnits()), currency: m.currency}
+}
+
+// Charm rounds m and then lowers it to the nearest amount ending in
+// CharmEnding. It leaves the rounded amount as is when there is no ending,
+// the ending is finer than the currency's minor unit, or no such amount is
+// at or above zero.
+func (r Rounding) Charm(m *Money) *Money {
+	m = r.Round(m)
+	if r.CharmEnding == nil {
+		return m
+	}
+	units := m.currency.MinorUnits()
+	if r.roundTo(r.CharmEnding, units).Cmp(r.CharmEnding) != 0 {
+		return m
+	}
+	rest := new(big.Rat).Sub(m.amount, r.CharmEnding)
+	if rest.Sign() < 0 {
+		return m
+	}
+	whole := new(big.Int).Quo(rest.Num(), rest.Denom())
+	return &Money{amount: new(big.Rat).Add(new(big.Rat).SetInt(whole), r.CharmEnding), currency: m.currency}
+}
+
+// Format renders m rounded to its minor unit as a plain decimal with
+// exactly that many digits after the point, such as "17.99" or "1800".
+func (r Rounding) Format(m *Money) string {
+	units := m.currency.MinorUnits()
+	return r.roundTo(m.amount, units).FloatString(units)
+}
+
+func (r Rounding) roundTo(x *big.Rat, places int) *big.Rat {
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(places)), nil)
+	scaled := new(big.Rat).Mul(new(big.Rat).Abs(x), new(big.Rat).SetInt(scale))
+
+	q, rem := new(big.Int).QuoRem(scaled.Num(), scaled.Denom(), new(big.Int))
+	// Compare the remainder with half the denominator.
+	switch cmp := new(big.Int).Lsh(rem, 1).Cmp(scaled.Denom()); {
+	case r.Mode == RoundDown:
+	case cmp > 0, cmp == 0 && (r.Mode == RoundHalfUp || q.Bit(0) == 1):
+		q.Add(q, big.NewInt(1))
+	}
+	if x.Sign() < 0 {
+		q.Neg(q)
+	}
+	return new(big.Rat).SetFrac(q, scale)
+}
@@ -0,0 +1,42 @@
+package services
+
+import "product-catalog-service/internal/app/product/domain"
+
+// CurrencyConverter derives prices in currencies a product has no explicit
+// price in by converting its base price, rounding the result the same way
+// everywhere. Converted prices are derived, so they take the charm ending.
+type CurrencyConverter struct {
+	rounding domain.Rounding
+}
+
+func NewCurrencyConverter(rounding domain.Rounding) *CurrencyConverter {
+	return &CurrencyConverter{rounding: rounding}
+}
+
+func (c *CurrencyConverter) Rounding() domain.Rounding {
+	return c.rounding
+}
+
+// PriceIn returns what to charge in currency given a product's prices, base
+// first: its own price in currency if it has one, else the base price
+// converted with rate, which is then returned as the rate used. rate may be
+// nil; when it is needed and missing the result is
+// domain.ErrExchangeRateNotFound.
+func (c *CurrencyConverter) PriceIn(prices []*domain.Money, currency domain.Currency, rate *domain.ExchangeRate) (*domain.Money, *domain.ExchangeRate, error) {
+	for _, m := range prices {
+		if m.Currency() == currency {
+			return m, nil, nil
+		}
+	}
+	if rate == nil {
+		return nil, nil, domain.ErrExchangeRateNotFound
+	}
+	m, err := rate.Convert(prices[0], c.rounding)
+	if err != nil {
+		return nil, nil, err
+	}
+	if m.Currency() != currency {
+		return nil, nil, domain.ErrCurrencyMismatch
+	}
+	return c.rounding.Charm(m), rate, nil
+}
@@ -0,0 +1,107 @@
+package services
+
+import (
+	"sort"
+	"time"
+
+	"product-catalog-service/internal/app/product/domain"
+)
+
+// PriceChanges returns the records, if any, that follow latest for p at
+// each of the given times.
+func (pc *PricingCalculator) PriceChanges(p *domain.Product, latest map[domain.Currency]*domain.PriceRecord, at ...time.Time) ([]*domain.PriceRecord, error) {
+	times := append([]time.Time(nil), at...)
+	sort.Slice(times, func(i, j int) bool { return times[i].Before(times[j]) })
+
+	last := make(map[domain.Currency]*domain.PriceRecord, len(latest))
+	for c, r := range latest {
+		last[c] = r
+	}
+
+	var out []*domain.PriceRecord
+	add := func(r *domain.PriceRecord) {
+		prev := last[r.Currency]
+		if prev == nil && !r.Priced() || prev != nil && prev.SamePrice(r) {
+			return
+		}
+		if prev != nil && r.From.Before(prev.From) {
+			r.From = prev.From
+		}
+		out = append(out, r)
+		last[r.Currency] = r
+	}
+
+	for _, t := range times {
+		t = t.UTC()
+		priced := map[domain.Currency]bool{}
+		for _, price := range p.Prices() {
+			b, err := pc.Breakdown(price, p.Discounts(), t)
+			if err != nil {
+				return nil, err
+			}
+			if _, _, ok := b.Effective.Fraction(); !ok {
+				return nil, domain.ErrMoneyOverflow
+			}
+			priced[price.Currency()] = true
+			add(&domain.PriceRecord{ProductID: p.ID(), Currency: price.Currency(), Base: price, Effective: b.Effective, From: t})
+		}
+
+		var gone []domain.Currency
+		for c := range last {
+			if !priced[c] {
+				gone = append(gone, c)
+			}
+		}
+		sort.Slice(gone, func(i, j int) bool { return gone[i] < gone[j] })
+		for _, c := range gone {
+			add(&domain.PriceRecord{ProductID: p.ID(), Currency: c, From: t})
+		}
+	}
+	return out, nil
+}
+
+// LowestPrice returns the lowest breakdown in records over [from, to),
+// also evaluating discounts past the newest record.
+func (pc *PricingCalculator) LowestPrice(records []*domain.PriceRecord, discounts []*domain.Discount, from, to time.Time) (lowest PriceBreakdown, ok bool, err error) {
+	consider := func(b PriceBreakdown) {
+		if !ok || b.Effective.Rat().Cmp(lowest.Effective.Rat()) < 0 {
+			lowest, ok = b, true
+		}
+	}
+	for i, r := range records {
+		start, end, open := r.From, to, i == len(records)-1
+		if !open && records[i+1].From.Before(end) {
+			end = records[i+1].From
+		}
+		if start.Before(from) {
+			start = from
+		}
+		if !r.Priced() || !start.Before(end) {
+			continue
+		}
+		off, err := r.Base.Sub(r.Effective)
+		if err != nil {
+			return PriceBreakdown{}, false, err
+		}
+		consider(PriceBreakdown{Base: r.Base, DiscountAmount: off, Effective: r.Effective})
+		if !open {
+			continue
+		}
+		at := []time.Time{start}
+		for _, d := range discounts {
+			for _, t := range []time.Time{d.Start(), d.End()} {
+				if t.After(start) && t.Before(end) {
+					at = append(at, t)
+				}
+			}
+		}
+		for _, t := range at {
+			b, err := pc.Breakdown(r.Base, discounts, t)
+			if err != nil {
+				return PriceBreakdown{}, false, err
+			}
+			consider(b)
+		}
+	}
+	return lowest, ok, nil
+}
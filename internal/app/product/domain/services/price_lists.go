@@ -0,0 +1,47 @@
+package services
+
+import (
+	"sort"
+	"time"
+
+	"product-catalog-service/internal/app/product/domain"
+)
+
+// ListPrices returns the prices to charge a customer the lists apply to,
+// given the product's own prices, base first. Of the lists valid at at that
+// price the product, the one with the highest priority wins, ties going to
+// the lower ID; its entries stand in for the product's price in their
+// currencies. With no such list, prices are returned unchanged and the list
+// is nil.
+func (pc *PricingCalculator) ListPrices(productID string, prices []*domain.Money, lists []*domain.PriceList, at time.Time) ([]*domain.Money, *domain.PriceList) {
+	ordered := make([]*domain.PriceList, len(lists))
+	copy(ordered, lists)
+	sort.Slice(ordered, func(i, j int) bool {
+		if ordered[i].Priority() != ordered[j].Priority() {
+			return ordered[i].Priority() > ordered[j].Priority()
+		}
+		return ordered[i].ID() < ordered[j].ID()
+	})
+
+	for _, l := range ordered {
+		entries := l.EntriesFor(productID)
+		if len(entries) == 0 || !l.IsValidAt(at) {
+			continue
+		}
+		out := make([]*domain.Money, len(prices))
+		copy(out, prices)
+		for _, e := range entries {
+			replaced := false
+			for i, m := range out {
+				if m.Currency() == e.Currency() {
+					out[i], replaced = e, true
+				}
+			}
+			if !replaced {
+				out = append(out, e)
+			}
+		}
+		return out, l
+	}
+	return prices, nil
+}
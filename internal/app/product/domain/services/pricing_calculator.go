@@ -2,26 +2,159 @@ package services
 
 import (
 	"math/big"
+	"sort"
 	"time"
 
 	"product-catalog-service/internal/app/product/domain"
 )
 
+// AppliedDiscount is one discount that reduced the price, with its share.
+type AppliedDiscount struct {
+	Discount *domain.Discount
+	Amount   *domain.Money
+}
+
+// PriceBreakdown is how a price was arrived at. Applied lists the discounts
+// that reduced the price, in the order they were applied; DiscountAmount
+// is their sum and zero when none applied.
+type PriceBreakdown struct {
+	Base           *domain.Money
+	Applied        []AppliedDiscount
+	DiscountAmount *domain.Money
+	Effective      *domain.Money
+}
+
+// Round brings every amount of b to its currency's minor unit for display.
+// The effective price of a discounted product is derived, so it takes the
+// charm ending; a price no discount touched is shown as set. DiscountAmount
+// becomes base minus effective, which can differ by a minor unit from the
+// sum of the rounded Applied amounts.
+func (b PriceBreakdown) Round(r domain.Rounding) PriceBreakdown {
+	out := PriceBreakdown{Base: r.Round(b.Base), Effective: r.Round(b.Base)}
+	if b.DiscountAmount.Rat().Sign() > 0 {
+		out.Effective = r.Charm(b.Effective)
+	}
+	out.DiscountAmount, _ = out.Base.Sub(out.Effective) // same currency
+	for _, a := range b.Applied {
+		out.Applied = append(out.Applied, AppliedDiscount{Discount: a.Discount, Amount: r.Round(a.Amount)})
+	}
+	return out
+}
+
+// Times scales every amount of b to n units, as for an order line. It
+// fails with domain.ErrMoneyOverflow when the line is out of range.
+func (b PriceBreakdown) Times(n int64) (PriceBreakdown, error) {
+	k := big.NewRat(n, 1)
+	out := PriceBreakdown{Base: b.Base.Mul(k), DiscountAmount: b.DiscountAmount.Mul(k), Effective: b.Effective.Mul(k)}
+	for _, a := range b.Applied {
+		out.Applied = append(out.Applied, AppliedDiscount{Discount: a.Discount, Amount: a.Amount.Mul(k)})
+	}
+	if _, _, ok := out.Base.Fraction(); !ok {
+		return PriceBreakdown{}, domain.ErrMoneyOverflow
+	}
+	return out, nil
+}
+
+// PricingCalculator is the single place prices are computed; both the write
+// side and the read models go through it so they cannot disagree.
 type PricingCalculator struct{}
 
 func NewPricingCalculator() *PricingCalculator {
 	return &PricingCalculator{}
 }
 
-func (pc *PricingCalculator) EffectivePrice(p *domain.Product, now time.Time) *domain.Money {
-	base := p.BasePrice()
-	d := p.Discount()
-	if d == nil || !d.IsValidAt(now) {
-		return base
+// Breakdown prices base at the given time. An exclusive discount applies
+// alone; otherwise the additive and multiplicative stack competes with the
+// best best-wins discount. Fixed discounts in another currency are skipped.
+func (pc *PricingCalculator) Breakdown(base *domain.Money, discounts []*domain.Discount, at time.Time) (PriceBreakdown, error) {
+	var active []*domain.Discount
+	for _, d := range discounts {
+		if d.IsValidAt(at) {
+			active = append(active, d)
+		}
+	}
+	sort.SliceStable(active, func(i, j int) bool { return active[i].Priority() > active[j].Priority() })
+
+	var applied []AppliedDiscount
+	for _, d := range active {
+		if d.Stacking() == domain.StackExclusive {
+			applied = []AppliedDiscount{{Discount: d, Amount: d.Reduction(base)}}
+			break
+		}
+	}
+	if applied == nil {
+		var err error
+		if applied, err = pc.stack(base, active); err != nil {
+			return PriceBreakdown{}, err
+		}
+	}
+
+	amount := base.Mul(new(big.Rat))
+	for _, a := range applied {
+		var err error
+		if amount, err = amount.Add(a.Amount); err != nil {
+			return PriceBreakdown{}, err
+		}
+	}
+	effective, err := base.Sub(amount)
+	if err != nil {
+		return PriceBreakdown{}, err
+	}
+	return PriceBreakdown{Base: base, Applied: applied, DiscountAmount: amount, Effective: effective}, nil
+}
+
+func (pc *PricingCalculator) stack(base *domain.Money, active []*domain.Discount) ([]AppliedDiscount, error) {
+	var (
+		stacked   []AppliedDiscount
+		best      *AppliedDiscount
+		remaining = base
+		err       error
+	)
+	for _, d := range active {
+		if d.Stacking() == domain.StackAdditive {
+			amt := capAt(d.Reduction(base), remaining)
+			stacked = append(stacked, AppliedDiscount{Discount: d, Amount: amt})
+			if remaining, err = remaining.Sub(amt); err != nil {
+				return nil, err
+			}
+		}
+	}
+	for _, d := range active {
+		switch d.Stacking() {
+		case domain.StackMultiplicative:
+			amt := d.Reduction(remaining)
+			stacked = append(stacked, AppliedDiscount{Discount: d, Amount: amt})
+			if remaining, err = remaining.Sub(amt); err != nil {
+				return nil, err
+			}
+		case domain.StackBestWins:
+			if amt := d.Reduction(base); best == nil || amt.Rat().Cmp(best.Amount.Rat()) > 0 {
+				best = &AppliedDiscount{Discount: d, Amount: amt}
+			}
+		}
 	}
 
-	percent := d.Percent()
-	rate := new(big.Rat).Quo(percent, big.NewRat(100, 1))
-	discountAmount := base.Mul(rate)
-	return base.Sub(discountAmount)
+	stackedAmount, err := base.Sub(remaining)
+	if err != nil {
+		return nil, err
+	}
+	if best != nil && best.Amount.Rat().Cmp(stackedAmount.Rat()) > 0 {
+		return []AppliedDiscount{*best}, nil
+	}
+	return stacked, nil
+}
+
+func (pc *PricingCalculator) EffectivePrice(p *domain.Product, now time.Time) (*domain.Money, error) {
+	b, err := pc.Breakdown(p.BasePrice(), p.Discounts(), now)
+	if err != nil {
+		return nil, err
+	}
+	return b.Effective, nil
+}
+
+func capAt(m, limit *domain.Money) *domain.Money {
+	if m.Rat().Cmp(limit.Rat()) > 0 {
+		return limit
+	}
+	return m
 }
@@ -16,13 +16,16 @@ func TestPricingCalculator_NoDiscount_ReturnsBasePrice(t *testing.T) {
 	}
 	now := time.Date(2026, 1, 12, 10, 0, 0, 0, time.UTC)
 
-	p, err := domain.NewProduct("p1", "n", "d", "c", price, now)
+	p, err := domain.NewProduct(domain.NewProductParams{ID: "p1", Name: "n", Description: "d", Category: "c", BasePrice: price}, now)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
 
 	pc := services.NewPricingCalculator()
-	eff := pc.EffectivePrice(p, now)
+	eff, err := pc.EffectivePrice(p, now)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
 
 	if eff.Rat().Cmp(price.Rat()) != 0 {
 		t.Fatalf("expected base price, got %s", eff.Rat().String())
@@ -36,7 +39,7 @@ func TestPricingCalculator_ExpiredDiscount_Ignored(t *testing.T) {
 	}
 	now := time.Date(2026, 1, 12, 10, 0, 0, 0, time.UTC)
 
-	p, err := domain.NewProduct("p1", "n", "d", "c", price, now)
+	p, err := domain.NewProduct(domain.NewProductParams{ID: "p1", Name: "n", Description: "d", Category: "c", BasePrice: price}, now)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -45,6 +48,7 @@ func TestPricingCalculator_ExpiredDiscount_Ignored(t *testing.T) {
 	}
 
 	d, err := domain.NewDiscount(
+		"d1",
 		big.NewRat(50, 1),
 		now.Add(-2*time.Hour),
 		now.Add(-time.Hour),
@@ -57,9 +61,222 @@ func TestPricingCalculator_ExpiredDiscount_Ignored(t *testing.T) {
 	}
 
 	pc := services.NewPricingCalculator()
-	eff := pc.EffectivePrice(p, now)
+	eff, err := pc.EffectivePrice(p, now)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
 
 	if eff.Rat().Cmp(price.Rat()) != 0 {
 		t.Fatalf("expected base price, got %s", eff.Rat().String())
 	}
 }
+
+func TestPricingCalculator_Breakdown_StartInclusiveAndAddsUp(t *testing.T) {
+	base, err := domain.NewMoneyFromFraction(90, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	start := time.Date(2026, 1, 12, 10, 0, 0, 0, time.UTC)
+	d, err := domain.NewDiscount("d1", big.NewRat(100, 3), start, start.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	pc := services.NewPricingCalculator()
+
+	b, err := pc.Breakdown(base, []*domain.Discount{d}, start)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(b.Applied) != 1 || b.Applied[0].Discount != d {
+		t.Fatalf("expected discount to apply at its start")
+	}
+	if b.DiscountAmount.Rat().Cmp(big.NewRat(30, 1)) != 0 || b.Effective.Rat().Cmp(big.NewRat(60, 1)) != 0 {
+		t.Fatalf("expected 30 off and 60 effective, got %s and %s", b.DiscountAmount.Rat(), b.Effective.Rat())
+	}
+
+	b, err = pc.Breakdown(base, []*domain.Discount{d}, start.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(b.Applied) != 0 || b.DiscountAmount.Rat().Sign() != 0 || b.Effective.Rat().Cmp(base.Rat()) != 0 {
+		t.Fatalf("expected no discount at its end, got %+v", b)
+	}
+}
+
+func TestPricingCalculator_Breakdown_StackingPolicies(t *testing.T) {
+	base, err := domain.NewMoneyFromFraction(100, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	at := time.Date(2026, 1, 12, 10, 0, 0, 0, time.UTC)
+	discount := func(id string, pct, priority int64, stacking domain.StackingPolicy) *domain.Discount {
+		d, err := domain.NewDiscount(id, big.NewRat(pct, 1), at, at.Add(time.Hour))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if d, err = d.WithStacking(priority, stacking); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return d
+	}
+
+	cases := []struct {
+		name      string
+		discounts []*domain.Discount
+		effective *big.Rat
+		applied   []string
+	}{
+		{
+			name: "best wins picks the largest",
+			discounts: []*domain.Discount{
+				discount("a", 10, 5, domain.StackBestWins),
+				discount("b", 25, 0, domain.StackBestWins),
+			},
+			effective: big.NewRat(75, 1),
+			applied:   []string{"b"},
+		},
+		{
+			name: "additive sums percentages on the base",
+			discounts: []*domain.Discount{
+				discount("a", 10, 0, domain.StackAdditive),
+				discount("b", 20, 0, domain.StackAdditive),
+			},
+			effective: big.NewRat(70, 1),
+			applied:   []string{"a", "b"},
+		},
+		{
+			name: "multiplicative applies after additive in priority order",
+			discounts: []*domain.Discount{
+				discount("m", 50, 0, domain.StackMultiplicative),
+				discount("a", 20, 0, domain.StackAdditive),
+			},
+			effective: big.NewRat(40, 1),
+			applied:   []string{"a", "m"},
+		},
+		{
+			name: "stack beats a smaller best-wins discount",
+			discounts: []*domain.Discount{
+				discount("best", 25, 0, domain.StackBestWins),
+				discount("a", 20, 0, domain.StackAdditive),
+				discount("b", 10, 0, domain.StackAdditive),
+			},
+			effective: big.NewRat(70, 1),
+			applied:   []string{"a", "b"},
+		},
+		{
+			name: "highest-priority exclusive suppresses the rest",
+			discounts: []*domain.Discount{
+				discount("a", 50, 0, domain.StackAdditive),
+				discount("low", 30, 1, domain.StackExclusive),
+				discount("high", 5, 2, domain.StackExclusive),
+			},
+			effective: big.NewRat(95, 1),
+			applied:   []string{"high"},
+		},
+		{
+			name: "additive total is capped at the base",
+			discounts: []*domain.Discount{
+				discount("a", 80, 1, domain.StackAdditive),
+				discount("b", 80, 0, domain.StackAdditive),
+			},
+			effective: new(big.Rat),
+			applied:   []string{"a", "b"},
+		},
+	}
+
+	pc := services.NewPricingCalculator()
+	for _, tc := range cases {
+		b, err := pc.Breakdown(base, tc.discounts, at)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", tc.name, err)
+		}
+		if b.Effective.Rat().Cmp(tc.effective) != 0 {
+			t.Fatalf("%s: expected effective %s, got %s", tc.name, tc.effective, b.Effective.Rat())
+		}
+		if new(big.Rat).Add(b.Effective.Rat(), b.DiscountAmount.Rat()).Cmp(base.Rat()) != 0 {
+			t.Fatalf("%s: effective plus discount does not add up to base", tc.name)
+		}
+		var got []string
+		for _, a := range b.Applied {
+			got = append(got, a.Discount.ID())
+		}
+		if len(got) != len(tc.applied) {
+			t.Fatalf("%s: expected applied %v, got %v", tc.name, tc.applied, got)
+		}
+		for i := range got {
+			if got[i] != tc.applied[i] {
+				t.Fatalf("%s: expected applied %v, got %v", tc.name, tc.applied, got)
+			}
+		}
+	}
+}
+
+func TestPriceBreakdown_RoundCharmsOnlyDiscountedPrices(t *testing.T) {
+	at := time.Date(2026, 1, 12, 10, 0, 0, 0, time.UTC)
+	base, _ := domain.NewMoneyFromFraction(1999, 100)
+	d, err := domain.NewDiscount("d1", big.NewRat(100, 3), at.Add(-time.Hour), at.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	r, err := domain.ParseRounding("half_even", "0.99")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	pc := services.NewPricingCalculator()
+	exact, err := pc.Breakdown(base, []*domain.Discount{d}, at)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// A third off 19.99 is 13.326666..., shown as 13.33 and charmed to 12.99.
+	b := exact.Round(r)
+	if r.Format(b.Effective) != "12.99" || r.Format(b.DiscountAmount) != "7.00" || r.Format(b.Applied[0].Amount) != "6.66" {
+		t.Fatalf("expected 19.99 - 7.00 = 12.99 with 6.66 applied, got %s - %s = %s",
+			r.Format(b.Base), r.Format(b.DiscountAmount), r.Format(b.Effective))
+	}
+
+	even, _ := domain.NewMoneyFromFraction(18, 1)
+	plain, err := pc.Breakdown(even, []*domain.Discount{d}, at.Add(2*time.Hour))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := plain.Round(r); r.Format(got.Effective) != "18.00" || got.DiscountAmount.Rat().Sign() != 0 {
+		t.Fatalf("expected an undiscounted price to stay as set, got %s", r.Format(got.Effective))
+	}
+}
+
+func TestPricingCalculator_ListPrices_HighestValidPriorityWins(t *testing.T) {
+	now := time.Date(2026, 1, 12, 10, 0, 0, 0, time.UTC)
+	eur, _ := domain.NewMoneyFromFraction(100, 1)
+	usd, _ := domain.NewMoneyFromFractionIn(110, 1, "USD")
+	prices := []*domain.Money{eur, usd}
+	list := func(id string, priority int64, from, to time.Time, amount int64) *domain.PriceList {
+		t.Helper()
+		l, err := domain.NewPriceList(id, "List "+id, "wholesale", priority, from, to)
+		if err != nil {
+			t.Fatalf("list %s: %v", id, err)
+		}
+		price, _ := domain.NewMoneyFromFraction(amount, 1)
+		e, err := domain.NewPriceListEntry("p1", price)
+		if err != nil {
+			t.Fatalf("entry %s: %v", id, err)
+		}
+		if err := l.SetEntry(e); err != nil {
+			t.Fatalf("entry %s: %v", id, err)
+		}
+		return l
+	}
+	low := list("low", 1, time.Time{}, time.Time{}, 90)
+	high := list("high", 5, time.Time{}, time.Time{}, 80)
+	expired := list("expired", 9, now.Add(-48*time.Hour), now, 70)
+
+	pc := services.NewPricingCalculator()
+	got, used := pc.ListPrices("p1", prices, []*domain.PriceList{low, expired, high}, now)
+	if used != high || len(got) != 2 || got[0].Rat().Cmp(big.NewRat(80, 1)) != 0 || got[1] != usd {
+		t.Fatalf("expected the valid high-priority list to replace only the EUR price, got %v from %v", got, used)
+	}
+	if got, used := pc.ListPrices("p2", prices, []*domain.PriceList{low, high}, now); used != nil || got[0] != eur {
+		t.Fatalf("expected own prices for a product no list prices, got %v from %v", got, used)
+	}
+}
@@ -0,0 +1,138 @@
+package domain
+
+import (
+	"math/big"
+	"regexp"
+	"strings"
+)
+
+// TaxClass groups products taxed alike, such as "standard" or "reduced".
+// Each region sets its own rate per class.
+type TaxClass string
+
+// DefaultTaxClass applies to products with no class of their own and none
+// on their category.
+const DefaultTaxClass TaxClass = "standard"
+
+var taxClassPattern = regexp.MustCompile(`^[a-z][a-z0-9_-]{0,31}$`)
+
+// ParseTaxClass accepts a lower-case name of up to 32 letters, digits,
+// '_' and '-'. Empty means no class, which defers to the category's.
+func ParseTaxClass(name string) (TaxClass, error) {
+	if name != "" && !taxClassPattern.MatchString(name) {
+		return "", ErrInvalidTaxClass
+	}
+	return TaxClass(name), nil
+}
+
+// ResolveTaxClass picks a product's own class over its category's, and
+// DefaultTaxClass when neither is set.
+func ResolveTaxClass(own, category TaxClass) TaxClass {
+	switch {
+	case own != "":
+		return own
+	case category != "":
+		return category
+	}
+	return DefaultTaxClass
+}
+
+// Region is where a sale is taxed: an ISO-3166 country code such as "DE",
+// optionally followed by a subdivision as in "US-CA".
+type Region string
+
+var regionPattern = regexp.MustCompile(`^[A-Z]{2}(-[A-Z0-9]{1,3})?$`)
+
+// ParseRegion accepts a region code in any case.
+func ParseRegion(code string) (Region, error) {
+	r := Region(strings.ToUpper(code))
+	if !regionPattern.MatchString(string(r)) {
+		return "", ErrInvalidRegion
+	}
+	return r, nil
+}
+
+// TaxMode says whether stored prices are net, with tax added on top, or
+// gross, with tax included.
+type TaxMode string
+
+const (
+	TaxModeNet   TaxMode = "net"
+	TaxModeGross TaxMode = "gross"
+)
+
+// ParseTaxMode reads a mode name; empty means TaxModeNet.
+func ParseTaxMode(mode string) (TaxMode, error) {
+	switch m := TaxMode(mode); m {
+	case "":
+		return TaxModeNet, nil
+	case TaxModeNet, TaxModeGross:
+		return m, nil
+	}
+	return "", ErrInvalidTaxMode
+}
+
+// TaxRate is the share of the net price a region charges as tax on one
+// class, such as 19/100.
+type TaxRate struct {
+	region Region
+	class  TaxClass
+	rate   *big.Rat
+}
+
+func NewTaxRate(region Region, class TaxClass, rate *big.Rat) (*TaxRate, error) {
+	if !regionPattern.MatchString(string(region)) {
+		return nil, ErrInvalidRegion
+	}
+	if !taxClassPattern.MatchString(string(class)) {
+		return nil, ErrInvalidTaxClass
+	}
+	if rate == nil || rate.Sign() < 0 || rate.Cmp(big.NewRat(1, 1)) > 0 {
+		return nil, ErrInvalidTaxRate
+	}
+	return &TaxRate{region: region, class: class, rate: new(big.Rat).Set(rate)}, nil
+}
+
+func (r *TaxRate) Region() Region  { return r.region }
+func (r *TaxRate) Class() TaxClass { return r.class }
+func (r *TaxRate) Rate() *big.Rat  { return new(big.Rat).Set(r.rate) }
+
+// TaxedPrice is a price split into its net amount and the tax on it.
+type TaxedPrice struct {
+	Rate  *TaxRate
+	Net   *Money
+	Tax   *Money
+	Gross *Money
+}
+
+// Apply splits price, which is net or gross as mode says, exactly.
+func (r *TaxRate) Apply(price *Money, mode TaxMode) (TaxedPrice, error) {
+	factor := new(big.Rat).Add(big.NewRat(1, 1), r.rate)
+	t := TaxedPrice{Rate: r}
+	if mode == TaxModeGross {
+		t.Gross = price
+		t.Net = price.Mul(new(big.Rat).Inv(factor))
+	} else {
+		t.Net = price
+		t.Gross = price.Mul(factor)
+	}
+	for _, m := range []*Money{t.Net, t.Gross} {
+		if _, _, ok := m.Fraction(); !ok {
+			return TaxedPrice{}, ErrMoneyOverflow
+		}
+	}
+	tax, err := t.Gross.Sub(t.Net)
+	if err != nil {
+		return TaxedPrice{}, err
+	}
+	t.Tax = tax
+	return t, nil
+}
+
+// Round brings net and gross to the minor unit and makes Tax their
+// difference, so the three always add up as shown.
+func (t TaxedPrice) Round(r Rounding) TaxedPrice {
+	out := TaxedPrice{Rate: t.Rate, Net: r.Round(t.Net), Gross: r.Round(t.Gross)}
+	out.Tax, _ = out.Gross.Sub(out.Net)
+	return out
+}
@@ -0,0 +1,102 @@
+package domain
+
+import (
+	"regexp"
+	"sort"
+)
+
+type VariantStatus string
+
+const (
+	VariantStatusActive   VariantStatus = "active"
+	VariantStatusInactive VariantStatus = "inactive"
+)
+
+// ParseVariantStatus accepts "active" or "inactive"; empty means active.
+func ParseVariantStatus(s string) (VariantStatus, error) {
+	switch VariantStatus(s) {
+	case "", VariantStatusActive:
+		return VariantStatusActive, nil
+	case VariantStatusInactive:
+		return VariantStatusInactive, nil
+	}
+	return "", ErrInvalidVariantStatus
+}
+
+var (
+	skuPattern          = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]{0,63}$`)
+	attributeKeyPattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,31}$`)
+)
+
+const maxAttributeValueLen = 255
+
+// Variant is one sellable version of a product, such as a size or color,
+// with its own SKU. Without a price of its own it sells at the product's
+// base price.
+type Variant struct {
+	id         string
+	sku        string
+	attributes map[string]string
+	price      *Money
+	status     VariantStatus
+}
+
+// NewVariant checks the variant on its own; whether it fits the product is
+// checked by Product.AddVariant and Product.UpdateVariant. price may be nil.
+func NewVariant(id, sku string, attributes map[string]string, price *Money, status VariantStatus) (*Variant, error) {
+	if id == "" {
+		return nil, ErrInvalidVariantID
+	}
+	if !skuPattern.MatchString(sku) {
+		return nil, ErrInvalidSKU
+	}
+	attrs := make(map[string]string, len(attributes))
+	for k, v := range attributes {
+		if !attributeKeyPattern.MatchString(k) || v == "" || len(v) > maxAttributeValueLen {
+			return nil, ErrInvalidAttributes
+		}
+		attrs[k] = v
+	}
+	if status != VariantStatusActive && status != VariantStatusInactive {
+		return nil, ErrInvalidVariantStatus
+	}
+	return &Variant{id: id, sku: sku, attributes: attrs, price: price, status: status}, nil
+}
+
+func (v *Variant) ID() string            { return v.id }
+func (v *Variant) SKU() string           { return v.sku }
+func (v *Variant) Status() VariantStatus { return v.status }
+
+// Price returns the variant's own price, or nil when it sells at the
+// product's base price.
+func (v *Variant) Price() *Money { return v.price }
+
+func (v *Variant) Attributes() map[string]string {
+	out := make(map[string]string, len(v.attributes))
+	for k, val := range v.attributes {
+		out[k] = val
+	}
+	return out
+}
+
+func (v *Variant) equal(other *Variant) bool {
+	if v.id != other.id || v.sku != other.sku || v.status != other.status || len(v.attributes) != len(other.attributes) {
+		return false
+	}
+	for k, val := range v.attributes {
+		if o, ok := other.attributes[k]; !ok || o != val {
+			return false
+		}
+	}
+	if v.price == nil || other.price == nil {
+		return v.price == nil && other.price == nil
+	}
+	return v.price.Currency() == other.price.Currency() && v.price.Rat().Cmp(other.price.Rat()) == 0
+}
+
+func sortedVariants(vs []*Variant) []*Variant {
+	out := make([]*Variant, len(vs))
+	copy(out, vs)
+	sort.Slice(out, func(i, j int) bool { return out[i].sku < out[j].sku })
+	return out
+}
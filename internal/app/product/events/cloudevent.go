@@ -0,0 +1,34 @@
+package events
+
+import (
+	"encoding/json"
+
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/pkg/cloudevents"
+)
+
+// DefaultSource is the CloudEvents source used when none is configured.
+const DefaultSource = "/product-catalog-service"
+
+// CloudEvent wraps an outbox message in a CloudEvents envelope. The event
+// time is the domain event's occurred_at when the payload carries one, and
+// the outbox insert time otherwise.
+func CloudEvent(msg contracts.OutboxMessage, source string) cloudevents.Event {
+	if source == "" {
+		source = DefaultSource
+	}
+	t := msg.CreatedAt
+	var h header
+	if err := json.Unmarshal(msg.Payload, &h); err == nil && !h.OccurredAt.IsZero() {
+		t = h.OccurredAt
+	}
+	return cloudevents.Event{
+		ID:              msg.EventID,
+		Source:          source,
+		Type:            msg.EventType,
+		Subject:         msg.AggregateID,
+		Time:            t.UTC(),
+		DataContentType: cloudevents.ContentTypeJSON,
+		Data:            msg.Payload,
+	}
+}
@@ -0,0 +1,269 @@
+package events
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/google/uuid"
+
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/pkg/committer"
+)
+
+// SchemaVersion is written into every payload and bumped whenever a payload
+// changes in a way consumers cannot ignore.
+const SchemaVersion = 1
+
+type header struct {
+	SchemaVersion int       `json:"schema_version"`
+	EventID       string    `json:"event_id"`
+	EventType     string    `json:"event_type"`
+	ProductID     string    `json:"product_id"`
+	OccurredAt    time.Time `json:"occurred_at"`
+}
+
+type discount struct {
+	ID       string    `json:"discount_id"`
+	Kind     string    `json:"kind"`
+	Percent  string    `json:"percent,omitempty"`
+	Amount   string    `json:"amount,omitempty"`
+	Currency string    `json:"currency,omitempty"`
+	Start    time.Time `json:"start"`
+	End      time.Time `json:"end"`
+	Status   string    `json:"status"`
+	Priority int64     `json:"priority"`
+	Stacking string    `json:"stacking"`
+}
+
+type change struct {
+	Field string `json:"field"`
+	Old   any    `json:"old"`
+	New   any    `json:"new"`
+}
+
+type productCreated struct {
+	header
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Category    string `json:"category"`
+	SKU         string `json:"sku,omitempty"`
+	GTIN        string `json:"gtin,omitempty"`
+	BasePrice   string `json:"base_price"`
+	Currency    string `json:"currency"`
+	Status      string `json:"status"`
+}
+
+type price struct {
+	Currency string `json:"currency"`
+	Amount   string `json:"amount"`
+}
+
+type priceChanged struct {
+	header
+	OldPrice price `json:"old_price"`
+	NewPrice price `json:"new_price"`
+}
+
+type taxClassChanged struct {
+	header
+	OldClass string `json:"old_tax_class"`
+	NewClass string `json:"new_tax_class"`
+}
+
+type priceTier struct {
+	MinQuantity int64 `json:"min_quantity"`
+	MaxQuantity int64 `json:"max_quantity,omitempty"`
+	UnitPrice   price `json:"unit_price"`
+}
+
+type priceTiersSet struct {
+	header
+	Tiers []priceTier `json:"tiers"`
+}
+
+type variant struct {
+	ID         string            `json:"variant_id"`
+	SKU        string            `json:"sku"`
+	Attributes map[string]string `json:"attributes"`
+	Price      *price            `json:"price,omitempty"`
+	Status     string            `json:"status"`
+}
+
+type variantEvent struct {
+	header
+	Variant variant `json:"variant"`
+}
+
+type variantRemoved struct {
+	header
+	VariantID string `json:"variant_id"`
+	SKU       string `json:"sku"`
+}
+
+type priceSet struct {
+	header
+	Price price `json:"price"`
+}
+
+type priceRemoved struct {
+	header
+	Currency string `json:"currency"`
+}
+
+type productUpdated struct {
+	header
+	Changes []change `json:"changes"`
+}
+
+type discountEvent struct {
+	header
+	Discount *discount `json:"discount"`
+}
+
+type productArchived struct {
+	header
+	ArchivedAt time.Time `json:"archived_at"`
+}
+
+type productRestored struct {
+	header
+	ArchivedAt time.Time `json:"archived_at"`
+}
+
+// Marshal renders e as the JSON payload stored in the outbox. Rationals are
+// written as exact strings ("1799/100") so no precision is lost.
+func Marshal(eventID string, e domain.DomainEvent) ([]byte, error) {
+	h := header{
+		SchemaVersion: SchemaVersion,
+		EventID:       eventID,
+		EventType:     e.EventType(),
+		ProductID:     e.AggregateID(),
+		OccurredAt:    e.OccurredAt(),
+	}
+
+	var v any
+	switch e := e.(type) {
+	case domain.ProductCreatedEvent:
+		v = productCreated{header: h, Name: e.Name, Description: e.Description, Category: e.Category, SKU: e.SKU, GTIN: string(e.GTIN), BasePrice: ratString(e.BasePrice), Currency: string(e.Currency), Status: string(e.Status)}
+	case domain.ProductUpdatedEvent:
+		out := productUpdated{header: h, Changes: []change{}}
+		for _, c := range e.Changes {
+			out.Changes = append(out.Changes, change{Field: c.Field, Old: value(c.Old), New: value(c.New)})
+		}
+		v = out
+	case domain.ProductPriceChangedEvent:
+		v = priceChanged{header: h, OldPrice: priceOf(e.OldPrice), NewPrice: priceOf(e.NewPrice)}
+	case domain.ProductTaxClassChangedEvent:
+		v = taxClassChanged{header: h, OldClass: string(e.OldClass), NewClass: string(e.NewClass)}
+	case domain.ProductPriceSetEvent:
+		v = priceSet{header: h, Price: priceOf(e.Price)}
+	case domain.ProductPriceTiersSetEvent:
+		out := priceTiersSet{header: h, Tiers: []priceTier{}}
+		for _, t := range e.Tiers {
+			out.Tiers = append(out.Tiers, priceTier{MinQuantity: t.MinQuantity(), MaxQuantity: t.MaxQuantity(), UnitPrice: priceOf(t.UnitPrice())})
+		}
+		v = out
+	case domain.ProductVariantAddedEvent:
+		v = variantEvent{header: h, Variant: variantOf(e.Variant)}
+	case domain.ProductVariantUpdatedEvent:
+		v = variantEvent{header: h, Variant: variantOf(e.Variant)}
+	case domain.ProductVariantRemovedEvent:
+		v = variantRemoved{header: h, VariantID: e.VariantID, SKU: e.SKU}
+	case domain.ProductPriceRemovedEvent:
+		v = priceRemoved{header: h, Currency: string(e.Currency)}
+	case domain.DiscountAppliedEvent:
+		v = discountEvent{header: h, Discount: discountOf(e.Discount)}
+	case domain.DiscountScheduledEvent:
+		v = discountEvent{header: h, Discount: discountOf(e.Discount)}
+	case domain.DiscountStartedEvent:
+		v = discountEvent{header: h, Discount: discountOf(e.Discount)}
+	case domain.DiscountExpiredEvent:
+		v = discountEvent{header: h, Discount: discountOf(e.Discount)}
+	case domain.DiscountRemovedEvent:
+		v = discountEvent{header: h, Discount: discountOf(e.Discount)}
+	case domain.ProductArchivedEvent:
+		v = productArchived{header: h, ArchivedAt: e.At}
+	case domain.ProductRestoredEvent:
+		v = productRestored{header: h, ArchivedAt: e.ArchivedAt}
+	case domain.ProductActivatedEvent, domain.ProductDeactivatedEvent:
+		v = h
+	default:
+		return nil, fmt.Errorf("events: unsupported event %T", e)
+	}
+	return json.Marshal(v)
+}
+
+// AppendToPlan adds one outbox row per event to plan.
+func AppendToPlan(plan *committer.Plan, outbox contracts.OutboxRepo, evs []domain.DomainEvent) error {
+	for _, e := range evs {
+		id := uuid.NewString()
+		b, err := Marshal(id, e)
+		if err != nil {
+			return err
+		}
+		m := outbox.InsertMut(id, e.EventType(), e.AggregateID(), b)
+		if m == nil {
+			return errors.New("outbox mutation is nil")
+		}
+		plan.Add(m)
+	}
+	return nil
+}
+
+func value(v any) any {
+	switch v := v.(type) {
+	case *domain.Discount:
+		return discountOf(v)
+	case []*domain.Discount:
+		out := make([]*discount, 0, len(v))
+		for _, d := range v {
+			out = append(out, discountOf(d))
+		}
+		return out
+	case *time.Time:
+		if v == nil {
+			return nil
+		}
+		return *v
+	case domain.ProductStatus:
+		return string(v)
+	default:
+		return v
+	}
+}
+
+func discountOf(d *domain.Discount) *discount {
+	if d == nil {
+		return nil
+	}
+	out := &discount{ID: d.ID(), Kind: string(d.Kind()), Percent: ratString(d.Percent()), Start: d.Start(), End: d.End(), Status: string(d.Status()), Priority: d.Priority(), Stacking: string(d.Stacking())}
+	if a := d.Amount(); a != nil {
+		out.Amount = a.Rat().RatString()
+		out.Currency = string(a.Currency())
+	}
+	return out
+}
+
+func priceOf(m *domain.Money) price {
+	return price{Currency: string(m.Currency()), Amount: m.Rat().RatString()}
+}
+
+func variantOf(v *domain.Variant) variant {
+	out := variant{ID: v.ID(), SKU: v.SKU(), Attributes: v.Attributes(), Status: string(v.Status())}
+	if m := v.Price(); m != nil {
+		p := priceOf(m)
+		out.Price = &p
+	}
+	return out
+}
+
+func ratString(r *big.Rat) string {
+	if r == nil {
+		return ""
+	}
+	return r.RatString()
+}
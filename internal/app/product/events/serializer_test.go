@@ -0,0 +1,162 @@
+package events
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+	"time"
+
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/pkg/committer"
+)
+
+type fakeMut struct{}
+
+func (fakeMut) IsMutation() {}
+
+type outboxRow struct {
+	id, eventType, aggregateID string
+	payload                    []byte
+}
+
+type fakeOutboxRepo struct{ rows []outboxRow }
+
+func (o *fakeOutboxRepo) InsertMut(eventID, eventType, aggregateID string, payload []byte) contracts.Mutation {
+	o.rows = append(o.rows, outboxRow{eventID, eventType, aggregateID, payload})
+	return fakeMut{}
+}
+
+func TestMarshal_ProductUpdated_WritesHeaderAndChanges(t *testing.T) {
+	at := time.Date(2026, 1, 12, 10, 0, 0, 0, time.UTC)
+	b, err := Marshal("e1", domain.ProductUpdatedEvent{
+		ProductID: "p1",
+		Changes:   []domain.FieldChange{{Field: domain.FieldName, Old: "old", New: "new"}},
+		At:        at,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var got struct {
+		SchemaVersion int       `json:"schema_version"`
+		EventID       string    `json:"event_id"`
+		EventType     string    `json:"event_type"`
+		ProductID     string    `json:"product_id"`
+		OccurredAt    time.Time `json:"occurred_at"`
+		Changes       []struct {
+			Field string `json:"field"`
+			Old   string `json:"old"`
+			New   string `json:"new"`
+		} `json:"changes"`
+	}
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if got.SchemaVersion != SchemaVersion || got.EventID != "e1" || got.EventType != "product.updated" || got.ProductID != "p1" || !got.OccurredAt.Equal(at) {
+		t.Fatalf("unexpected header: %s", b)
+	}
+	if len(got.Changes) != 1 || got.Changes[0].Field != "name" || got.Changes[0].Old != "old" || got.Changes[0].New != "new" {
+		t.Fatalf("unexpected changes: %s", b)
+	}
+}
+
+func TestMarshal_DiscountApplied_WritesExactPercentAndWindow(t *testing.T) {
+	start := time.Date(2026, 1, 12, 10, 0, 0, 0, time.UTC)
+	d, err := domain.NewDiscount("d1", big.NewRat(1, 3), start, start.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("setup discount: %v", err)
+	}
+
+	b, err := Marshal("e1", domain.DiscountAppliedEvent{ProductID: "p1", Discount: d, At: start})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var got map[string]any
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	disc, ok := got["discount"].(map[string]any)
+	if !ok || disc["percent"] != "1/3" || disc["start"] != "2026-01-12T10:00:00Z" || disc["end"] != "2026-01-12T11:00:00Z" {
+		t.Fatalf("unexpected discount: %s", b)
+	}
+	if _, ok := got["previous"]; ok {
+		t.Fatalf("expected no previous discount: %s", b)
+	}
+}
+
+func TestMarshal_PriceSet_WritesCurrencyAndExactAmount(t *testing.T) {
+	at := time.Date(2026, 1, 12, 10, 0, 0, 0, time.UTC)
+	usd, err := domain.NewMoneyFromFractionIn(1799, 100, "USD")
+	if err != nil {
+		t.Fatalf("setup money: %v", err)
+	}
+
+	b, err := Marshal("e1", domain.ProductPriceSetEvent{ProductID: "p1", Price: usd, At: at})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var got map[string]any
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	price, ok := got["price"].(map[string]any)
+	if got["event_type"] != "product.price_set" || !ok || price["currency"] != "USD" || price["amount"] != "1799/100" {
+		t.Fatalf("unexpected payload: %s", b)
+	}
+}
+
+func TestMarshal_VariantAdded_OmitsPriceItDoesNotHave(t *testing.T) {
+	at := time.Date(2026, 1, 12, 10, 0, 0, 0, time.UTC)
+	v, err := domain.NewVariant("v1", "TEE-RED-M", map[string]string{"color": "red", "size": "M"}, nil, domain.VariantStatusActive)
+	if err != nil {
+		t.Fatalf("setup variant: %v", err)
+	}
+
+	b, err := Marshal("e1", domain.ProductVariantAddedEvent{ProductID: "p1", Variant: v, At: at})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var got map[string]any
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	variant, ok := got["variant"].(map[string]any)
+	if got["event_type"] != "product.variant_added" || !ok || variant["sku"] != "TEE-RED-M" || variant["status"] != "active" {
+		t.Fatalf("unexpected payload: %s", b)
+	}
+	if _, ok := variant["price"]; ok {
+		t.Fatalf("expected no price for a variant at the base price: %s", b)
+	}
+	if attrs, _ := variant["attributes"].(map[string]any); attrs["size"] != "M" {
+		t.Fatalf("expected attributes in the payload: %s", b)
+	}
+}
+
+func TestAppendToPlan_OneOutboxRowPerEvent(t *testing.T) {
+	at := time.Date(2026, 1, 12, 10, 0, 0, 0, time.UTC)
+	outbox := &fakeOutboxRepo{}
+	plan := committer.NewPlan()
+
+	err := AppendToPlan(plan, outbox, []domain.DomainEvent{
+		domain.ProductActivatedEvent{ProductID: "p1", At: at},
+		domain.ProductDeactivatedEvent{ProductID: "p1", At: at},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(plan.Mutations()) != 2 || len(outbox.rows) != 2 {
+		t.Fatalf("expected 2 rows, got %d mutations and %d rows", len(plan.Mutations()), len(outbox.rows))
+	}
+
+	var h header
+	if err := json.Unmarshal(outbox.rows[0].payload, &h); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if h.EventID != outbox.rows[0].id || outbox.rows[0].aggregateID != "p1" || outbox.rows[1].eventType != "product.deactivated" {
+		t.Fatalf("unexpected rows: %+v", outbox.rows)
+	}
+}
@@ -0,0 +1,96 @@
+package idempotency
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/pkg/clock"
+	"product-catalog-service/internal/pkg/committer"
+)
+
+const MaxKeyLength = 255
+
+var (
+	ErrInvalidKey = errors.New("invalid idempotency key")
+	ErrKeyReused  = errors.New("idempotency key reused with a different request")
+)
+
+// Guard makes mutating calls safe to retry. The key is recorded by the
+// committer returned from Committer in the same plan as the call's own
+// writes, so a call either commits together with its key or not at all.
+type Guard struct {
+	keys  contracts.IdempotencyRepo
+	clock clock.Clock
+}
+
+func NewGuard(keys contracts.IdempotencyRepo, clk clock.Clock) *Guard {
+	return &Guard{keys: keys, clock: clk}
+}
+
+// Do runs fn unless key was already used. request identifies the call and
+// response is what later replays get back; it must be known before fn runs
+// because it is written in the same commit. On a replay fn is skipped and
+// the stored response is returned.
+func (g *Guard) Do(ctx context.Context, key, operation string, request, response []byte, fn func(ctx context.Context) error) ([]byte, error) {
+	if len(key) > MaxKeyLength {
+		return nil, ErrInvalidKey
+	}
+
+	sum := sha256.Sum256(request)
+	hash := hex.EncodeToString(sum[:])
+
+	if resp, ok, err := g.replay(ctx, key, operation, hash); err != nil || ok {
+		return resp, err
+	}
+
+	rec := contracts.IdempotencyRecord{Key: key, Operation: operation, RequestHash: hash, Response: response, CreatedAt: g.clock.Now()}
+	err := fn(context.WithValue(ctx, recordKey{}, rec))
+	if err == nil {
+		return response, nil
+	}
+
+	// A concurrent call with the same key committed first.
+	if status.Code(err) == codes.AlreadyExists {
+		if resp, ok, rerr := g.replay(ctx, key, operation, hash); rerr != nil || ok {
+			return resp, rerr
+		}
+	}
+	return nil, err
+}
+
+func (g *Guard) replay(ctx context.Context, key, operation, hash string) ([]byte, bool, error) {
+	rec, err := g.keys.Get(ctx, key)
+	if err != nil || rec == nil {
+		return nil, false, err
+	}
+	if rec.Operation != operation || rec.RequestHash != hash {
+		return nil, false, ErrKeyReused
+	}
+	return rec.Response, true, nil
+}
+
+// Committer wraps next so that plans applied from inside Do also record the
+// idempotency key.
+func (g *Guard) Committer(next committer.Committer) committer.Committer {
+	return recordingCommitter{next: next, keys: g.keys}
+}
+
+type recordKey struct{}
+
+type recordingCommitter struct {
+	next committer.Committer
+	keys contracts.IdempotencyRepo
+}
+
+func (c recordingCommitter) Apply(ctx context.Context, plan *committer.Plan) error {
+	if rec, ok := ctx.Value(recordKey{}).(contracts.IdempotencyRecord); ok {
+		plan.Add(c.keys.InsertMut(rec))
+	}
+	return c.next.Apply(ctx, plan)
+}
@@ -0,0 +1,134 @@
+package idempotency
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"product-catalog-service/internal/app/product/repo/memrepo"
+	"product-catalog-service/internal/infra/memstore"
+	"product-catalog-service/internal/models/m_idempotency"
+	"product-catalog-service/internal/pkg/committer"
+)
+
+type fakeClock struct{ t time.Time }
+
+func (f fakeClock) Now() time.Time { return f.t }
+
+type env struct {
+	store *memstore.Store
+	guard *Guard
+	comm  committer.Committer
+}
+
+func newEnv() *env {
+	st := memstore.NewStore()
+	g := NewGuard(memrepo.NewIdempotencyRepo(st), fakeClock{t: time.Date(2026, 1, 12, 12, 0, 0, 0, time.UTC)})
+	return &env{store: st, guard: g, comm: g.Committer(memstore.NewCommitter(st))}
+}
+
+// write commits a plan through the guarded committer, like an interactor.
+func (e *env) write(ctx context.Context, id string) error {
+	plan := committer.NewPlan()
+	plan.Add(memstore.Insert("things", memstore.Key(id), memstore.Row{"id": id}))
+	return e.comm.Apply(ctx, plan)
+}
+
+func TestDo_ReplaySkipsFnAndReturnsStoredResponse(t *testing.T) {
+	e := newEnv()
+	ctx := context.Background()
+	calls := 0
+	fn := func(ctx context.Context) error {
+		calls++
+		return e.write(ctx, "a")
+	}
+
+	got, err := e.guard.Do(ctx, "k1", "Op", []byte("req"), []byte("first"), fn)
+	if err != nil {
+		t.Fatalf("first call: %v", err)
+	}
+	if string(got) != "first" {
+		t.Fatalf("expected first response, got %q", got)
+	}
+	if _, ok := e.store.Snapshot().Get(m_idempotency.Table, memstore.Key("k1")); !ok {
+		t.Fatalf("expected key to be committed with the plan")
+	}
+
+	got, err = e.guard.Do(ctx, "k1", "Op", []byte("req"), []byte("second"), fn)
+	if err != nil {
+		t.Fatalf("replay: %v", err)
+	}
+	if string(got) != "first" {
+		t.Fatalf("expected stored response on replay, got %q", got)
+	}
+	if calls != 1 {
+		t.Fatalf("expected fn to run once, ran %d times", calls)
+	}
+}
+
+func TestDo_DifferentPayload_ReturnsErrKeyReused(t *testing.T) {
+	e := newEnv()
+	ctx := context.Background()
+	fn := func(ctx context.Context) error { return e.write(ctx, "a") }
+
+	if _, err := e.guard.Do(ctx, "k1", "Op", []byte("req"), nil, fn); err != nil {
+		t.Fatalf("first call: %v", err)
+	}
+	if _, err := e.guard.Do(ctx, "k1", "Op", []byte("other"), nil, fn); !errors.Is(err, ErrKeyReused) {
+		t.Fatalf("expected ErrKeyReused for different payload, got %v", err)
+	}
+	if _, err := e.guard.Do(ctx, "k1", "OtherOp", []byte("req"), nil, fn); !errors.Is(err, ErrKeyReused) {
+		t.Fatalf("expected ErrKeyReused for different operation, got %v", err)
+	}
+}
+
+func TestDo_FailedCallDoesNotRecordKey(t *testing.T) {
+	e := newEnv()
+	ctx := context.Background()
+	boom := errors.New("boom")
+
+	if _, err := e.guard.Do(ctx, "k1", "Op", []byte("req"), nil, func(context.Context) error { return boom }); !errors.Is(err, boom) {
+		t.Fatalf("expected boom, got %v", err)
+	}
+	if _, err := e.guard.Do(ctx, "k1", "Op", []byte("req"), []byte("ok"), func(ctx context.Context) error { return e.write(ctx, "a") }); err != nil {
+		t.Fatalf("retry after failure: %v", err)
+	}
+}
+
+func TestDo_ConcurrentCallWithSameKey_ReplaysWinner(t *testing.T) {
+	e := newEnv()
+	ctx := context.Background()
+
+	got, err := e.guard.Do(ctx, "k1", "Op", []byte("req"), []byte("loser"), func(ctx context.Context) error {
+		// Another request with the same key commits between our lookup and
+		// our commit.
+		if _, err := e.guard.Do(context.Background(), "k1", "Op", []byte("req"), []byte("winner"), func(ctx context.Context) error {
+			return e.write(ctx, "winner")
+		}); err != nil {
+			t.Fatalf("winner: %v", err)
+		}
+		return e.write(ctx, "loser")
+	})
+	if err != nil {
+		t.Fatalf("expected replay after conflict, got %v", err)
+	}
+	if string(got) != "winner" {
+		t.Fatalf("expected winner response, got %q", got)
+	}
+	if _, ok := e.store.Snapshot().Get("things", memstore.Key("loser")); ok {
+		t.Fatalf("losing call must not commit its writes")
+	}
+}
+
+func TestDo_KeyTooLong_ReturnsErrInvalidKey(t *testing.T) {
+	e := newEnv()
+	key := make([]byte, MaxKeyLength+1)
+	for i := range key {
+		key[i] = 'k'
+	}
+	_, err := e.guard.Do(context.Background(), string(key), "Op", nil, nil, func(context.Context) error { return nil })
+	if !errors.Is(err, ErrInvalidKey) {
+		t.Fatalf("expected ErrInvalidKey, got %v", err)
+	}
+}
@@ -0,0 +1,46 @@
+// Package identifiers keeps SKUs and GTINs unique across products, which a
+// single product cannot check on its own.
+package identifiers
+
+import (
+	"context"
+
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/domain"
+)
+
+// CheckUnique fails with domain.ErrDuplicateSKU or domain.ErrDuplicateGTIN
+// when another product already uses p's SKU, one of its variants' SKUs or
+// its GTIN. It reads outside the commit, so it only reports the common case
+// early; the product_skus table and the unique index on gtin reject a
+// product that takes one in between.
+func CheckUnique(ctx context.Context, products contracts.ProductRepo, p *domain.Product) error {
+	skus := make([]string, 0, len(p.Variants())+1)
+	if p.SKU() != "" {
+		skus = append(skus, p.SKU())
+	}
+	for _, v := range p.Variants() {
+		skus = append(skus, v.SKU())
+	}
+	for _, sku := range skus {
+		owner, err := products.SKUOwner(ctx, sku)
+		if err != nil {
+			return err
+		}
+		if owner != "" && owner != p.ID() {
+			return domain.ErrDuplicateSKU
+		}
+	}
+
+	if p.GTIN() == "" {
+		return nil
+	}
+	owner, err := products.GTINOwner(ctx, p.GTIN())
+	if err != nil {
+		return err
+	}
+	if owner != "" && owner != p.ID() {
+		return domain.ErrDuplicateGTIN
+	}
+	return nil
+}
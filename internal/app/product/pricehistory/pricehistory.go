@@ -0,0 +1,49 @@
+// Package pricehistory keeps the price_history table in step with the
+// writes that change what a product costs.
+package pricehistory
+
+import (
+	"context"
+	"time"
+
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/app/product/domain/services"
+	"product-catalog-service/internal/pkg/committer"
+)
+
+var pricing = services.NewPricingCalculator()
+
+// AppendToPlan adds to plan the price records for p as of each of its
+// pending domain events. A discount that started or ended is recorded at
+// the edge of its window rather than when the sweeper got to it.
+func AppendToPlan(ctx context.Context, plan *committer.Plan, history contracts.PriceHistoryRepo, p *domain.Product) error {
+	evs := p.DomainEvents()
+	if len(evs) == 0 {
+		return nil
+	}
+	at := make([]time.Time, 0, len(evs))
+	for _, e := range evs {
+		switch e := e.(type) {
+		case domain.DiscountStartedEvent:
+			at = append(at, e.Discount.Start())
+		case domain.DiscountExpiredEvent:
+			at = append(at, e.Discount.End())
+		default:
+			at = append(at, e.OccurredAt())
+		}
+	}
+
+	latest, err := history.Latest(ctx, p.ID())
+	if err != nil {
+		return err
+	}
+	records, err := pricing.PriceChanges(p, latest, at...)
+	if err != nil {
+		return err
+	}
+	for _, r := range records {
+		plan.Add(history.RecordMut(r))
+	}
+	return nil
+}
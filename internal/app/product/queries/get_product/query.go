@@ -2,10 +2,16 @@ package get_product
 
 import (
 	"context"
+	"errors"
 
 	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/domain"
 )
 
+const MaxBatchSize = 100
+
+var ErrBatchTooLarge = errors.New("too many products in one read")
+
 type Query struct {
 	readModel contracts.ProductReadModel
 }
@@ -14,6 +20,43 @@ func New(readModel contracts.ProductReadModel) *Query {
 	return &Query{readModel: readModel}
 }
 
-func (q *Query) Execute(ctx context.Context, id string) (contracts.ProductDTO, error) {
-	return q.readModel.GetProduct(ctx, id)
+// Execute prices the product in currency where it can; empty means its
+// base currency. A region adds the tax split, and lists picks the price
+// list, if any, whose prices stand in for the product's own.
+func (q *Query) Execute(ctx context.Context, id, currency, region string, lists contracts.PriceListSelector) (contracts.ProductDTO, error) {
+	return q.readModel.GetProduct(ctx, id, currency, region, lists)
+}
+
+// ExecuteBySKU is Execute for the product that uses sku, as its own SKU or
+// a variant's, or when sku is empty for the product with gtin, in any form
+// domain.ParseGTIN accepts.
+func (q *Query) ExecuteBySKU(ctx context.Context, sku, gtin, currency, region string, lists contracts.PriceListSelector) (contracts.ProductDTO, error) {
+	if sku != "" {
+		return q.readModel.GetProductBySKU(ctx, sku, currency, region, lists)
+	}
+	g, err := domain.ParseGTIN(gtin)
+	if err != nil {
+		return contracts.ProductDTO{}, err
+	}
+	if g == "" {
+		return contracts.ProductDTO{}, domain.ErrInvalidSKU
+	}
+	return q.readModel.GetProductByGTIN(ctx, string(g), currency, region, lists)
+}
+
+// ExecuteBatch is Execute for each product, in request order, read at the
+// same instant. It fails if any product is missing.
+func (q *Query) ExecuteBatch(ctx context.Context, ids []string, currency, region string, lists contracts.PriceListSelector) ([]contracts.ProductDTO, error) {
+	if len(ids) == 0 {
+		return nil, domain.ErrInvalidProductID
+	}
+	if len(ids) > MaxBatchSize {
+		return nil, ErrBatchTooLarge
+	}
+	for _, id := range ids {
+		if id == "" {
+			return nil, domain.ErrInvalidProductID
+		}
+	}
+	return q.readModel.BatchGetProducts(ctx, ids, currency, region, lists)
 }
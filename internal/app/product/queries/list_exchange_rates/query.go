@@ -0,0 +1,22 @@
+package list_exchange_rates
+
+import (
+	"context"
+
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/domain"
+)
+
+type Query struct {
+	rates contracts.ExchangeRateRepo
+}
+
+func New(rates contracts.ExchangeRateRepo) *Query {
+	return &Query{rates: rates}
+}
+
+// Execute lists stored rates, newest first within a pair; an empty from or
+// to matches any currency.
+func (q *Query) Execute(ctx context.Context, from, to domain.Currency) ([]*domain.ExchangeRate, error) {
+	return q.rates.List(ctx, from, to)
+}
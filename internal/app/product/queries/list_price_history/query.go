@@ -0,0 +1,124 @@
+package list_price_history
+
+import (
+	"context"
+	"time"
+
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/app/product/domain/services"
+	"product-catalog-service/internal/pkg/pagetoken"
+)
+
+// Interval is a span during which a product cost the same in Currency. To
+// is when the next price took over, zero while the price still holds.
+// Amounts are rounded for display as the read model rounds them.
+type Interval struct {
+	Currency domain.Currency
+	From     time.Time
+	To       time.Time
+	services.PriceBreakdown
+	rounding domain.Rounding
+}
+
+// Decimal formats an amount of the interval as its prices were rounded.
+func (i Interval) Decimal(m *domain.Money) string {
+	return i.rounding.Format(m)
+}
+
+type Request struct {
+	ProductID string
+	Currency  domain.Currency
+	Since     time.Time
+	PageSize  int32
+	PageToken string
+}
+
+type Result struct {
+	Intervals     []Interval
+	NextPageToken string
+}
+
+// cursor is the last interval of a page, with the filter it was listed
+// under so a token cannot be replayed against another one.
+type cursor struct {
+	ProductID string          `json:"p"`
+	Currency  domain.Currency `json:"c"`
+	From      time.Time       `json:"f"`
+	Filter    domain.Currency `json:"fc,omitempty"`
+	Since     time.Time       `json:"s,omitempty"`
+}
+
+type Query struct {
+	history  contracts.PriceHistoryRepo
+	tokens   *pagetoken.Codec
+	rounding domain.Rounding
+}
+
+func New(history contracts.PriceHistoryRepo, tokens *pagetoken.Codec, rounding domain.Rounding) *Query {
+	return &Query{history: history, tokens: tokens, rounding: rounding}
+}
+
+// Execute lists a page of the product's price intervals in req.Currency, or
+// in every currency when it is empty, that had not ended by req.Since; a
+// zero Since lists them all. Spans in which the product was not priced in
+// a currency are left out.
+func (q *Query) Execute(ctx context.Context, req Request) (Result, error) {
+	if req.ProductID == "" {
+		return Result{}, domain.ErrInvalidProductID
+	}
+	f := contracts.PriceHistoryFilter{ProductID: req.ProductID, Currency: req.Currency, Since: req.Since}
+	if req.PageToken != "" {
+		var cur cursor
+		if err := q.tokens.Decode(req.PageToken, &cur); err != nil {
+			return Result{}, err
+		}
+		if cur.ProductID != req.ProductID || cur.Filter != req.Currency || !cur.Since.Equal(req.Since) {
+			return Result{}, pagetoken.ErrInvalid
+		}
+		f.AfterCurrency, f.AfterFrom = cur.Currency, cur.From
+	}
+	pageSize := contracts.PageSize(req.PageSize)
+	// One record past the batch tells when its last interval ended.
+	f.Limit = pageSize + 1
+
+	var out []Interval
+	for {
+		records, err := q.history.ListPage(ctx, f)
+		if err != nil {
+			return Result{}, err
+		}
+		done := len(records) < f.Limit
+		n := len(records)
+		if !done {
+			n--
+		}
+		for i, r := range records[:n] {
+			if !r.Priced() {
+				continue
+			}
+			iv := Interval{Currency: r.Currency, From: r.From, rounding: q.rounding}
+			if i+1 < len(records) && records[i+1].Currency == r.Currency {
+				iv.To = records[i+1].From
+			}
+			if len(out) == pageSize {
+				last := out[pageSize-1]
+				tok, err := q.tokens.Encode(cursor{ProductID: req.ProductID, Currency: last.Currency, From: last.From, Filter: req.Currency, Since: req.Since})
+				if err != nil {
+					return Result{}, err
+				}
+				return Result{Intervals: out, NextPageToken: tok}, nil
+			}
+			off, err := r.Base.Sub(r.Effective)
+			if err != nil {
+				return Result{}, err
+			}
+			iv.PriceBreakdown = services.PriceBreakdown{Base: r.Base, DiscountAmount: off, Effective: r.Effective}.Round(q.rounding)
+			out = append(out, iv)
+		}
+		if done {
+			return Result{Intervals: out}, nil
+		}
+		f.AfterCurrency, f.AfterFrom = records[n-1].Currency, records[n-1].From
+	}
+}
@@ -0,0 +1,147 @@
+package list_price_history
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/pkg/pagetoken"
+)
+
+type fakeMut struct{}
+
+func (fakeMut) IsMutation() {}
+
+// fakeHistoryRepo pages through recs, which are ordered as ListPage
+// returns them, and remembers the filters it was asked for.
+type fakeHistoryRepo struct {
+	recs    []*domain.PriceRecord
+	filters []contracts.PriceHistoryFilter
+}
+
+func (h *fakeHistoryRepo) List(ctx context.Context, productID string, currency domain.Currency) ([]*domain.PriceRecord, error) {
+	return nil, nil
+}
+func (h *fakeHistoryRepo) ListPage(ctx context.Context, f contracts.PriceHistoryFilter) ([]*domain.PriceRecord, error) {
+	h.filters = append(h.filters, f)
+	var out []*domain.PriceRecord
+	for _, r := range h.recs {
+		if r.ProductID != f.ProductID || f.Currency != "" && r.Currency != f.Currency {
+			continue
+		}
+		if !f.AfterFrom.IsZero() && (r.Currency < f.AfterCurrency || r.Currency == f.AfterCurrency && !r.From.After(f.AfterFrom)) {
+			continue
+		}
+		if len(out) == f.Limit {
+			break
+		}
+		out = append(out, r)
+	}
+	return out, nil
+}
+func (h *fakeHistoryRepo) Latest(ctx context.Context, productID string) (map[domain.Currency]*domain.PriceRecord, error) {
+	return nil, nil
+}
+func (h *fakeHistoryRepo) RecordMut(r *domain.PriceRecord) contracts.Mutation { return fakeMut{} }
+
+func record(t *testing.T, currency domain.Currency, from time.Time, base, effective int64) *domain.PriceRecord {
+	t.Helper()
+	r := &domain.PriceRecord{ProductID: "p1", Currency: currency, From: from}
+	if effective == 0 {
+		return r
+	}
+	var err error
+	if r.Base, err = domain.NewMoneyFromFractionIn(base, 1, currency); err != nil {
+		t.Fatalf("setup money: %v", err)
+	}
+	if r.Effective, err = domain.NewMoneyFromFractionIn(effective, 1, currency); err != nil {
+		t.Fatalf("setup money: %v", err)
+	}
+	return r
+}
+
+func newQuery(h *fakeHistoryRepo) *Query {
+	return New(h, pagetoken.NewCodec([]byte("k")), domain.DefaultRounding)
+}
+
+func TestListPriceHistory_ClosesIntervalsAndSkipsUnpriced(t *testing.T) {
+	t0 := time.Date(2026, 1, 12, 12, 0, 0, 0, time.UTC)
+	h := &fakeHistoryRepo{recs: []*domain.PriceRecord{
+		record(t, "EUR", t0, 10, 10),
+		record(t, "EUR", t0.Add(time.Hour), 10, 8),
+		record(t, "USD", t0, 12, 12),
+		record(t, "USD", t0.Add(2*time.Hour), 0, 0),
+	}}
+
+	res, err := newQuery(h).Execute(context.Background(), Request{ProductID: "p1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []struct {
+		currency domain.Currency
+		from, to time.Time
+		off      string
+	}{
+		{"EUR", t0, t0.Add(time.Hour), "0.00"},
+		{"EUR", t0.Add(time.Hour), time.Time{}, "2.00"},
+		{"USD", t0, t0.Add(2 * time.Hour), "0.00"},
+	}
+	if len(res.Intervals) != len(want) || res.NextPageToken != "" {
+		t.Fatalf("expected %d intervals on one page, got %+v", len(want), res)
+	}
+	for i, w := range want {
+		iv := res.Intervals[i]
+		if iv.Currency != w.currency || !iv.From.Equal(w.from) || !iv.To.Equal(w.to) || iv.Decimal(iv.DiscountAmount) != w.off {
+			t.Fatalf("interval %d: expected %s %s off from %s to %s, got %s %s off from %s to %s",
+				i, w.currency, w.off, w.from, w.to, iv.Currency, iv.Decimal(iv.DiscountAmount), iv.From, iv.To)
+		}
+	}
+}
+
+func TestListPriceHistory_PassesSinceToTheRepo(t *testing.T) {
+	since := time.Date(2026, 1, 12, 12, 0, 0, 0, time.UTC)
+	h := &fakeHistoryRepo{}
+
+	if _, err := newQuery(h).Execute(context.Background(), Request{ProductID: "p1", Currency: "EUR", Since: since}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(h.filters) != 1 || !h.filters[0].Since.Equal(since) || h.filters[0].Currency != "EUR" {
+		t.Fatalf("expected one read from since in EUR, got %+v", h.filters)
+	}
+}
+
+func TestListPriceHistory_PagesAndRejectsTokenOfAnotherFilter(t *testing.T) {
+	t0 := time.Date(2026, 1, 12, 12, 0, 0, 0, time.UTC)
+	h := &fakeHistoryRepo{recs: []*domain.PriceRecord{
+		record(t, "EUR", t0, 10, 10),
+		record(t, "EUR", t0.Add(time.Hour), 9, 9),
+	}}
+	q := newQuery(h)
+
+	first, err := q.Execute(context.Background(), Request{ProductID: "p1", PageSize: 1})
+	if err != nil || len(first.Intervals) != 1 || first.NextPageToken == "" || !first.Intervals[0].To.Equal(t0.Add(time.Hour)) {
+		t.Fatalf("expected the first interval, closed, and a token, got %+v, %v", first, err)
+	}
+	second, err := q.Execute(context.Background(), Request{ProductID: "p1", PageSize: 1, PageToken: first.NextPageToken})
+	if err != nil || len(second.Intervals) != 1 || second.NextPageToken != "" || !second.Intervals[0].From.Equal(t0.Add(time.Hour)) {
+		t.Fatalf("expected the last interval and no token, got %+v, %v", second, err)
+	}
+
+	if _, err := q.Execute(context.Background(), Request{ProductID: "p1", Currency: "EUR", PageToken: first.NextPageToken}); !errors.Is(err, pagetoken.ErrInvalid) {
+		t.Fatalf("expected ErrInvalid, got %v", err)
+	}
+}
+
+func TestListPriceHistory_EmptyProductID_ReturnsErrInvalidProductID(t *testing.T) {
+	h := &fakeHistoryRepo{}
+
+	if _, err := newQuery(h).Execute(context.Background(), Request{}); !errors.Is(err, domain.ErrInvalidProductID) {
+		t.Fatalf("expected ErrInvalidProductID, got %v", err)
+	}
+	if len(h.filters) != 0 {
+		t.Fatalf("expected no reads, got %d", len(h.filters))
+	}
+}
@@ -0,0 +1,191 @@
+package quote_price
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/app/product/domain/services"
+	"product-catalog-service/internal/pkg/clock"
+)
+
+const MaxBatchSize = 100
+
+var ErrBatchTooLarge = errors.New("too many products in one quote")
+
+type Quote struct {
+	ProductID string
+	At        time.Time
+	Quantity  int64
+	// PriceListID is the list the price came from, empty for the
+	// product's own.
+	PriceListID string
+	// Rate is the exchange rate the price was converted with, nil when the
+	// product has its own price in the quoted currency.
+	Rate *domain.ExchangeRate
+	// PriceBreakdown is for a single unit at the tier Quantity falls in.
+	services.PriceBreakdown
+	// Line is the rounded unit breakdown times Quantity.
+	Line services.PriceBreakdown
+	// Tax splits the line's effective price in the quoted region, nil when
+	// no region was asked for.
+	Tax      *domain.TaxedPrice
+	TaxMode  domain.TaxMode
+	rounding domain.Rounding
+}
+
+// Decimal formats an amount of the quote as its prices were rounded.
+func (q Quote) Decimal(m *domain.Money) string {
+	return q.rounding.Format(m)
+}
+
+// Query prices products at a point in time. It loads the aggregate rather
+// than the read model so the quote is computed from exactly the state the
+// write side validates against.
+type Query struct {
+	products contracts.ProductRepo
+	lists    contracts.PriceListRepo
+	rates    contracts.ExchangeRateRepo
+	taxes    contracts.TaxRepo
+	pricing  *services.PricingCalculator
+	conv     *services.CurrencyConverter
+	mode     domain.TaxMode
+	clock    clock.Clock
+}
+
+func New(products contracts.ProductRepo, lists contracts.PriceListRepo, rates contracts.ExchangeRateRepo, taxes contracts.TaxRepo, conv *services.CurrencyConverter, taxMode domain.TaxMode, clk clock.Clock) *Query {
+	return &Query{products: products, lists: lists, rates: rates, taxes: taxes, pricing: services.NewPricingCalculator(), conv: conv, mode: taxMode, clock: clk}
+}
+
+// Execute quotes one product at at; a zero at means now. Quotes are in
+// currency, converting the base price at the rate in effect at at when the
+// product has no price of its own there; an empty currency means the base
+// currency. A region adds the tax split at its rate for the product's tax
+// class, failing with domain.ErrTaxRateNotFound when it has none.
+//
+// Quantity picks the product's price tier and sizes the line; zero means a
+// single unit. Discounts apply to the tier's unit price. A product's own
+// price in currency is flat; tiers are in the base currency and convert
+// like the base price.
+//
+// A price list picked by lists and valid at at stands in for the product's
+// prices, tiers included, in the currencies it has entries in.
+func (q *Query) Execute(ctx context.Context, productID string, at time.Time, currency domain.Currency, region domain.Region, quantity int64, lists contracts.PriceListSelector) (Quote, error) {
+	qs, err := q.ExecuteBatch(ctx, []string{productID}, at, currency, region, quantity, lists)
+	if err != nil {
+		return Quote{}, err
+	}
+	return qs[0], nil
+}
+
+// ExecuteBatch quotes every product at the same instant and quantity, in
+// request order. Amounts are rounded for display as the read model rounds
+// them.
+func (q *Query) ExecuteBatch(ctx context.Context, productIDs []string, at time.Time, currency domain.Currency, region domain.Region, quantity int64, lists contracts.PriceListSelector) ([]Quote, error) {
+	if len(productIDs) == 0 {
+		return nil, domain.ErrInvalidProductID
+	}
+	if len(productIDs) > MaxBatchSize {
+		return nil, ErrBatchTooLarge
+	}
+	if quantity == 0 {
+		quantity = 1
+	}
+	if at.IsZero() {
+		at = q.clock.Now()
+	}
+	at = at.UTC()
+	for _, id := range productIDs {
+		if id == "" {
+			return nil, domain.ErrInvalidProductID
+		}
+	}
+	pl, err := q.lists.Select(ctx, lists, productIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]Quote, 0, len(productIDs))
+	for _, id := range productIDs {
+		p, err := q.products.GetByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		prices, list, err := q.prices(p, pl, quantity, at)
+		if err != nil {
+			return nil, err
+		}
+		price, rate, err := q.priceIn(ctx, prices, currency, at)
+		if err != nil {
+			return nil, err
+		}
+		b, err := q.pricing.Breakdown(price, p.Discounts(), at)
+		if err != nil {
+			return nil, err
+		}
+		r := q.conv.Rounding()
+		quote := Quote{ProductID: id, At: at, Quantity: quantity, Rate: rate, PriceBreakdown: b.Round(r), TaxMode: q.mode, rounding: r}
+		if list != nil {
+			quote.PriceListID = list.ID()
+		}
+		if quote.Line, err = quote.PriceBreakdown.Times(quantity); err != nil {
+			return nil, err
+		}
+		if region != "" {
+			if quote.Tax, err = q.tax(ctx, p, region, quote.Line.Effective); err != nil {
+				return nil, err
+			}
+		}
+		out = append(out, quote)
+	}
+	return out, nil
+}
+
+// tax splits effective, the price as quoted, at region's rate for the
+// class p is taxed under.
+func (q *Query) tax(ctx context.Context, p *domain.Product, region domain.Region, effective *domain.Money) (*domain.TaxedPrice, error) {
+	categoryClass, err := q.taxes.CategoryClass(ctx, p.Category())
+	if err != nil {
+		return nil, err
+	}
+	rate, err := q.taxes.Rate(ctx, region, domain.ResolveTaxClass(p.TaxClass(), categoryClass))
+	if err != nil {
+		return nil, err
+	}
+	t, err := rate.Apply(effective, q.mode)
+	if err != nil {
+		return nil, err
+	}
+	t = t.Round(q.conv.Rounding())
+	return &t, nil
+}
+
+// prices returns what p charges for a unit at quantity, base first, and
+// the list, if any, that stands in for its own prices at at.
+func (q *Query) prices(p *domain.Product, lists []*domain.PriceList, quantity int64, at time.Time) ([]*domain.Money, *domain.PriceList, error) {
+	unit, err := p.UnitPrice(quantity)
+	if err != nil {
+		return nil, nil, err
+	}
+	prices, list := q.pricing.ListPrices(p.ID(), append([]*domain.Money{unit}, p.Prices()[1:]...), lists, at)
+	return prices, list, nil
+}
+
+func (q *Query) priceIn(ctx context.Context, prices []*domain.Money, currency domain.Currency, at time.Time) (*domain.Money, *domain.ExchangeRate, error) {
+	base := prices[0]
+	if currency == "" || currency == base.Currency() {
+		return base, nil, nil
+	}
+	for _, m := range prices[1:] {
+		if m.Currency() == currency {
+			return m, nil, nil
+		}
+	}
+	rate, err := q.rates.RateAt(ctx, base.Currency(), currency, at)
+	if err != nil {
+		return nil, nil, err
+	}
+	return q.conv.PriceIn([]*domain.Money{base}, currency, rate)
+}
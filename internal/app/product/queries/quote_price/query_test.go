@@ -0,0 +1,323 @@
+package quote_price
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/app/product/domain/services"
+	"product-catalog-service/internal/app/product/repo"
+)
+
+type fakeClock struct{ t time.Time }
+
+func (f fakeClock) Now() time.Time { return f.t }
+
+type fakeMut struct{}
+
+func (fakeMut) IsMutation() {}
+
+type fakeProductRepo struct {
+	ps map[string]*domain.Product
+}
+
+func (r *fakeProductRepo) GetByID(ctx context.Context, id string) (*domain.Product, error) {
+	p, ok := r.ps[id]
+	if !ok {
+		return nil, repo.ErrProductNotFound
+	}
+	return p, nil
+}
+func (r *fakeProductRepo) SKUOwner(ctx context.Context, sku string) (string, error) {
+	return "", nil
+}
+func (r *fakeProductRepo) GTINOwner(ctx context.Context, gtin domain.GTIN) (string, error) {
+	return "", nil
+}
+func (r *fakeProductRepo) InsertMut(p *domain.Product) contracts.Mutation { return fakeMut{} }
+func (r *fakeProductRepo) UpdateMut(p *domain.Product) contracts.Mutation { return fakeMut{} }
+
+type fakeRates map[[2]domain.Currency]*domain.ExchangeRate
+
+func (r fakeRates) RateAt(ctx context.Context, from, to domain.Currency, at time.Time) (*domain.ExchangeRate, error) {
+	rate, ok := r[[2]domain.Currency{from, to}]
+	if !ok || rate.EffectiveFrom().After(at) {
+		return nil, domain.ErrExchangeRateNotFound
+	}
+	return rate, nil
+}
+func (r fakeRates) List(ctx context.Context, from, to domain.Currency) ([]*domain.ExchangeRate, error) {
+	return nil, nil
+}
+func (r fakeRates) UpsertMut(rate *domain.ExchangeRate) contracts.Mutation { return fakeMut{} }
+
+type fakeLists []*domain.PriceList
+
+func (l fakeLists) GetByID(ctx context.Context, id string) (*domain.PriceList, error) {
+	return nil, repo.ErrPriceListNotFound
+}
+func (l fakeLists) Select(ctx context.Context, sel contracts.PriceListSelector, productIDs []string) ([]*domain.PriceList, error) {
+	var out []*domain.PriceList
+	for _, pl := range l {
+		if pl.ID() == sel.ID || sel.ID == "" && sel.CustomerGroup != "" && string(pl.CustomerGroup()) == sel.CustomerGroup {
+			out = append(out, pl)
+		}
+	}
+	return out, nil
+}
+func (l fakeLists) InsertMut(pl *domain.PriceList) contracts.Mutation { return fakeMut{} }
+func (l fakeLists) UpdateMut(pl *domain.PriceList) contracts.Mutation { return fakeMut{} }
+
+type fakeTaxes struct {
+	rates      map[domain.TaxClass]*domain.TaxRate
+	categories map[string]domain.TaxClass
+}
+
+func (r fakeTaxes) Rate(ctx context.Context, region domain.Region, class domain.TaxClass) (*domain.TaxRate, error) {
+	rate, ok := r.rates[class]
+	if !ok || rate.Region() != region {
+		return nil, domain.ErrTaxRateNotFound
+	}
+	return rate, nil
+}
+func (r fakeTaxes) CategoryClass(ctx context.Context, category string) (domain.TaxClass, error) {
+	return r.categories[category], nil
+}
+func (r fakeTaxes) UpsertRateMut(rate *domain.TaxRate) contracts.Mutation { return fakeMut{} }
+func (r fakeTaxes) AssignCategoryMut(category string, class domain.TaxClass) contracts.Mutation {
+	return fakeMut{}
+}
+
+func newRepo(t *testing.T, start time.Time) *fakeProductRepo {
+	t.Helper()
+	price, err := domain.NewMoneyFromFraction(200, 1)
+	if err != nil {
+		t.Fatalf("setup money: %v", err)
+	}
+	d, err := domain.NewDiscount("d1", big.NewRat(25, 1), start, start.Add(24*time.Hour))
+	if err != nil {
+		t.Fatalf("setup discount: %v", err)
+	}
+	return &fakeProductRepo{ps: map[string]*domain.Product{
+		"p1": domain.HydrateProduct(domain.HydrateProductParams{ID: "p1", Name: "n", Category: "c", BasePrice: price, Discounts: []*domain.Discount{d}, Status: domain.ProductStatusActive, Version: 1}),
+		"p2": domain.HydrateProduct(domain.HydrateProductParams{ID: "p2", Name: "n", Category: "c", BasePrice: price, Status: domain.ProductStatusActive, Version: 1}),
+	}}
+}
+
+func TestQuotePrice_AtTimestamp(t *testing.T) {
+	start := time.Date(2026, 1, 12, 0, 0, 0, 0, time.UTC)
+	q := New(newRepo(t, start), fakeLists{}, fakeRates{}, fakeTaxes{}, services.NewCurrencyConverter(domain.DefaultRounding), domain.TaxModeNet, fakeClock{t: start.Add(-time.Hour)})
+
+	// Default "now" is before the discount window.
+	got, err := q.Execute(context.Background(), "p1", time.Time{}, "", "", 0, contracts.PriceListSelector{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got.Applied) != 0 || got.Effective.Rat().Cmp(big.NewRat(200, 1)) != 0 || !got.At.Equal(start.Add(-time.Hour)) {
+		t.Fatalf("expected undiscounted quote at clock time, got %+v", got)
+	}
+
+	got, err = q.Execute(context.Background(), "p1", start.Add(time.Hour), "", "", 0, contracts.PriceListSelector{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got.Applied) != 1 || got.DiscountAmount.Rat().Cmp(big.NewRat(50, 1)) != 0 || got.Effective.Rat().Cmp(big.NewRat(150, 1)) != 0 {
+		t.Fatalf("expected 25%% off inside the window, got %+v", got)
+	}
+}
+
+func TestQuotePrice_Batch_KeepsOrderAndFailsOnUnknown(t *testing.T) {
+	start := time.Date(2026, 1, 12, 0, 0, 0, 0, time.UTC)
+	q := New(newRepo(t, start), fakeLists{}, fakeRates{}, fakeTaxes{}, services.NewCurrencyConverter(domain.DefaultRounding), domain.TaxModeNet, fakeClock{t: start.Add(time.Hour)})
+
+	qs, err := q.ExecuteBatch(context.Background(), []string{"p2", "p1"}, time.Time{}, "", "", 0, contracts.PriceListSelector{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(qs) != 2 || qs[0].ProductID != "p2" || qs[1].ProductID != "p1" || len(qs[1].Applied) != 1 {
+		t.Fatalf("unexpected quotes %+v", qs)
+	}
+
+	if _, err := q.ExecuteBatch(context.Background(), []string{"p1", "nope"}, time.Time{}, "", "", 0, contracts.PriceListSelector{}); !errors.Is(err, repo.ErrProductNotFound) {
+		t.Fatalf("expected ErrProductNotFound, got %v", err)
+	}
+	if _, err := q.ExecuteBatch(context.Background(), make([]string, MaxBatchSize+1), time.Time{}, "", "", 0, contracts.PriceListSelector{}); !errors.Is(err, ErrBatchTooLarge) {
+		t.Fatalf("expected ErrBatchTooLarge, got %v", err)
+	}
+}
+
+func TestQuotePrice_ConvertsAndRecordsRate(t *testing.T) {
+	start := time.Date(2026, 1, 12, 0, 0, 0, 0, time.UTC)
+	rate, err := domain.NewExchangeRate("EUR", "USD", big.NewRat(10833, 10000), start)
+	if err != nil {
+		t.Fatalf("setup rate: %v", err)
+	}
+	rates := fakeRates{{"EUR", "USD"}: rate}
+	q := New(newRepo(t, start), fakeLists{}, rates, fakeTaxes{}, services.NewCurrencyConverter(domain.DefaultRounding), domain.TaxModeNet, fakeClock{t: start.Add(time.Hour)})
+
+	got, err := q.Execute(context.Background(), "p1", time.Time{}, "USD", "", 0, contracts.PriceListSelector{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// 200 EUR at 1.0833 is exactly 216.66 USD; 25% off is 54.165, so the
+	// discount is taken from the converted price and the result of 162.495
+	// rounded half up.
+	if got.Rate != rate || got.Base.Currency() != "USD" || got.Base.Rat().Cmp(big.NewRat(21666, 100)) != 0 {
+		t.Fatalf("expected converted base 216.66 USD with the rate recorded, got %+v", got)
+	}
+	if got.Effective.Rat().Cmp(big.NewRat(16250, 100)) != 0 || got.Decimal(got.DiscountAmount) != "54.16" {
+		t.Fatalf("expected 162.50 USD effective after 54.16 off, got %s", got.Decimal(got.Effective))
+	}
+
+	if _, err := q.Execute(context.Background(), "p1", time.Time{}, "GBP", "", 0, contracts.PriceListSelector{}); !errors.Is(err, domain.ErrExchangeRateNotFound) {
+		t.Fatalf("expected ErrExchangeRateNotFound, got %v", err)
+	}
+	if _, err := q.Execute(context.Background(), "p1", start.Add(-time.Hour), "USD", "", 0, contracts.PriceListSelector{}); !errors.Is(err, domain.ErrExchangeRateNotFound) {
+		t.Fatalf("expected no rate before it takes effect, got %v", err)
+	}
+}
+
+func TestQuotePrice_SplitsTaxByClassAndMode(t *testing.T) {
+	start := time.Date(2026, 1, 12, 0, 0, 0, 0, time.UTC)
+	standard, err := domain.NewTaxRate("DE", "standard", big.NewRat(19, 100))
+	if err != nil {
+		t.Fatalf("setup rate: %v", err)
+	}
+	reduced, err := domain.NewTaxRate("DE", "reduced", big.NewRat(7, 100))
+	if err != nil {
+		t.Fatalf("setup rate: %v", err)
+	}
+	taxes := fakeTaxes{
+		rates:      map[domain.TaxClass]*domain.TaxRate{"standard": standard, "reduced": reduced},
+		categories: map[string]domain.TaxClass{"c": "reduced"},
+	}
+	products := newRepo(t, start)
+	if err := products.ps["p2"].SetTaxClass("standard", start); err != nil {
+		t.Fatalf("set tax class: %v", err)
+	}
+	conv := services.NewCurrencyConverter(domain.DefaultRounding)
+	clk := fakeClock{t: start.Add(time.Hour)}
+
+	// p1 takes its category's reduced rate on the discounted 150; p2 its
+	// own standard rate on 200.
+	qs, err := New(products, fakeLists{}, fakeRates{}, taxes, conv, domain.TaxModeNet, clk).ExecuteBatch(context.Background(), []string{"p1", "p2"}, time.Time{}, "", "DE", 0, contracts.PriceListSelector{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if tx := qs[0].Tax; tx == nil || tx.Rate != reduced || qs[0].Decimal(tx.Net) != "150.00" || qs[0].Decimal(tx.Tax) != "10.50" || qs[0].Decimal(tx.Gross) != "160.50" {
+		t.Fatalf("expected 150.00 + 10.50 = 160.50 at 7%%, got %+v", tx)
+	}
+	if tx := qs[1].Tax; tx == nil || tx.Rate != standard || qs[1].Decimal(tx.Gross) != "238.00" {
+		t.Fatalf("expected 200.00 net to be 238.00 gross at 19%%, got %+v", tx)
+	}
+
+	// Stored as gross, 200 holds 31.93 of tax at 19%: 200/1.19 = 168.067...
+	got, err := New(products, fakeLists{}, fakeRates{}, taxes, conv, domain.TaxModeGross, clk).Execute(context.Background(), "p2", time.Time{}, "", "DE", 0, contracts.PriceListSelector{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if tx := got.Tax; got.Decimal(tx.Net) != "168.07" || got.Decimal(tx.Tax) != "31.93" || got.Decimal(tx.Gross) != "200.00" {
+		t.Fatalf("expected 168.07 + 31.93 = 200.00, got %+v", tx)
+	}
+
+	if _, err := New(products, fakeLists{}, fakeRates{}, taxes, conv, domain.TaxModeNet, clk).Execute(context.Background(), "p1", time.Time{}, "", "FR", 0, contracts.PriceListSelector{}); !errors.Is(err, domain.ErrTaxRateNotFound) {
+		t.Fatalf("expected ErrTaxRateNotFound, got %v", err)
+	}
+}
+
+func TestQuotePrice_PricesQuantityAtItsTier(t *testing.T) {
+	start := time.Date(2026, 1, 12, 0, 0, 0, 0, time.UTC)
+	products := newRepo(t, start)
+	var tiers []*domain.PriceTier
+	for _, spec := range []struct{ min, max, price int64 }{{10, 49, 180}, {50, 0, 160}} {
+		price, err := domain.NewMoneyFromFraction(spec.price, 1)
+		if err != nil {
+			t.Fatalf("setup money: %v", err)
+		}
+		tier, err := domain.NewPriceTier(spec.min, spec.max, price)
+		if err != nil {
+			t.Fatalf("setup tier: %v", err)
+		}
+		tiers = append(tiers, tier)
+	}
+	if err := products.ps["p1"].SetPriceTiers(tiers, start); err != nil {
+		t.Fatalf("set tiers: %v", err)
+	}
+	gbp, err := domain.NewMoneyFromFractionIn(170, 1, "GBP")
+	if err != nil {
+		t.Fatalf("setup money: %v", err)
+	}
+	if err := products.ps["p1"].SetPrice(gbp, start); err != nil {
+		t.Fatalf("set price: %v", err)
+	}
+	rate, err := domain.NewExchangeRate("EUR", "USD", big.NewRat(11, 10), start)
+	if err != nil {
+		t.Fatalf("setup rate: %v", err)
+	}
+	q := New(products, fakeLists{}, fakeRates{{"EUR", "USD"}: rate}, fakeTaxes{}, services.NewCurrencyConverter(domain.DefaultRounding), domain.TaxModeNet, fakeClock{t: start.Add(time.Hour)})
+
+	cases := []struct {
+		quantity        int64
+		currency        domain.Currency
+		unit, line, off string
+	}{
+		// Below the first tier the base price of 200 applies, 25% off.
+		{0, "", "150.00", "150.00", "50.00"},
+		{9, "", "150.00", "1350.00", "450.00"},
+		{10, "", "135.00", "1350.00", "450.00"},
+		{50, "", "120.00", "6000.00", "2000.00"},
+		// Tiers convert like the base price; 160 EUR is 176 USD.
+		{50, "USD", "132.00", "6600.00", "2200.00"},
+		// The product's own GBP price is flat.
+		{50, "GBP", "127.50", "6375.00", "2125.00"},
+	}
+	for _, c := range cases {
+		got, err := q.Execute(context.Background(), "p1", time.Time{}, c.currency, "", c.quantity, contracts.PriceListSelector{})
+		if err != nil {
+			t.Fatalf("quantity %d in %q: unexpected error: %v", c.quantity, c.currency, err)
+		}
+		if got.Decimal(got.Effective) != c.unit || got.Decimal(got.Line.Effective) != c.line || got.Decimal(got.Line.DiscountAmount) != c.off {
+			t.Fatalf("quantity %d in %q: expected %s each, %s for the line after %s off, got %s, %s after %s off",
+				c.quantity, c.currency, c.unit, c.line, c.off, got.Decimal(got.Effective), got.Decimal(got.Line.Effective), got.Decimal(got.Line.DiscountAmount))
+		}
+	}
+
+	if _, err := q.Execute(context.Background(), "p1", time.Time{}, "", "", -1, contracts.PriceListSelector{}); !errors.Is(err, domain.ErrInvalidQuantity) {
+		t.Fatalf("expected ErrInvalidQuantity, got %v", err)
+	}
+}
+
+func TestQuotePrice_PriceListStandsInWhileValid(t *testing.T) {
+	start := time.Date(2026, 1, 12, 0, 0, 0, 0, time.UTC)
+	listPrice, _ := domain.NewMoneyFromFraction(150, 1)
+	entry, err := domain.NewPriceListEntry("p1", listPrice)
+	if err != nil {
+		t.Fatalf("setup entry: %v", err)
+	}
+	lists := fakeLists{domain.HydratePriceList("pl1", "Wholesale", "wholesale", 1, time.Time{}, start.Add(2*time.Hour), []*domain.PriceListEntry{entry}, 1)}
+	q := New(newRepo(t, start), lists, fakeRates{}, fakeTaxes{}, services.NewCurrencyConverter(domain.DefaultRounding), domain.TaxModeNet, fakeClock{t: start.Add(3 * time.Hour)})
+	group := contracts.PriceListSelector{CustomerGroup: "wholesale"}
+
+	qs, err := q.ExecuteBatch(context.Background(), []string{"p1", "p2"}, start.Add(time.Hour), "", "", 10, group)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if qs[0].PriceListID != "pl1" || qs[0].Base.Rat().Cmp(big.NewRat(150, 1)) != 0 || len(qs[0].Applied) != 1 {
+		t.Fatalf("expected the list price with the discount on it, got %+v", qs[0])
+	}
+	if qs[1].PriceListID != "" || qs[1].Base.Rat().Cmp(big.NewRat(200, 1)) != 0 {
+		t.Fatalf("expected p2 at its own price, got %+v", qs[1])
+	}
+
+	got, err := q.Execute(context.Background(), "p1", time.Time{}, "", "", 0, group)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.PriceListID != "" || got.Base.Rat().Cmp(big.NewRat(200, 1)) != 0 {
+		t.Fatalf("expected the product's own price once the list ended, got %+v", got)
+	}
+}
@@ -0,0 +1,122 @@
+package relay
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/pkg/clock"
+)
+
+type Config struct {
+	BatchSize    int
+	PollInterval time.Duration
+	Lease        time.Duration
+	MaxAttempts  int64
+	BaseBackoff  time.Duration
+	MaxBackoff   time.Duration
+}
+
+func DefaultConfig() Config {
+	return Config{
+		BatchSize:    100,
+		PollInterval: time.Second,
+		Lease:        30 * time.Second,
+		MaxAttempts:  10,
+		BaseBackoff:  time.Second,
+		MaxBackoff:   5 * time.Minute,
+	}
+}
+
+type Relay struct {
+	store     contracts.OutboxStore
+	publisher contracts.Publisher
+	clock     clock.Clock
+	cfg       Config
+}
+
+func New(store contracts.OutboxStore, publisher contracts.Publisher, clk clock.Clock, cfg Config) *Relay {
+	def := DefaultConfig()
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = def.BatchSize
+	}
+	if cfg.PollInterval <= 0 {
+		cfg.PollInterval = def.PollInterval
+	}
+	if cfg.Lease <= 0 {
+		cfg.Lease = def.Lease
+	}
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = def.MaxAttempts
+	}
+	if cfg.BaseBackoff <= 0 {
+		cfg.BaseBackoff = def.BaseBackoff
+	}
+	if cfg.MaxBackoff < cfg.BaseBackoff {
+		cfg.MaxBackoff = cfg.BaseBackoff
+	}
+	return &Relay{store: store, publisher: publisher, clock: clk, cfg: cfg}
+}
+
+// Run polls the outbox until ctx is cancelled. A full batch is followed
+// immediately by another poll so that a backlog drains without waiting.
+func (r *Relay) Run(ctx context.Context) error {
+	for {
+		n, err := r.RunOnce(ctx)
+		if err != nil && ctx.Err() == nil {
+			log.Printf("outbox relay: %v", err)
+		}
+		if n >= r.cfg.BatchSize && err == nil {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(r.cfg.PollInterval):
+		}
+	}
+}
+
+// RunOnce claims a single batch and publishes it, returning the number of
+// messages claimed.
+func (r *Relay) RunOnce(ctx context.Context) (int, error) {
+	msgs, err := r.store.Claim(ctx, r.cfg.BatchSize, r.clock.Now().Add(r.cfg.Lease))
+	if err != nil {
+		return 0, err
+	}
+
+	for _, m := range msgs {
+		if err := r.deliver(ctx, m); err != nil {
+			return len(msgs), err
+		}
+	}
+	return len(msgs), nil
+}
+
+func (r *Relay) deliver(ctx context.Context, m contracts.OutboxMessage) error {
+	perr := r.publisher.Publish(ctx, m)
+	if perr == nil {
+		return r.store.MarkPublished(ctx, m.EventID)
+	}
+
+	attempts := m.Attempts + 1
+	var next *time.Time
+	if attempts < r.cfg.MaxAttempts {
+		t := r.clock.Now().Add(r.backoff(attempts))
+		next = &t
+	}
+	return r.store.MarkFailed(ctx, m.EventID, attempts, next, perr.Error())
+}
+
+func (r *Relay) backoff(attempts int64) time.Duration {
+	d := r.cfg.BaseBackoff
+	for i := int64(1); i < attempts; i++ {
+		d *= 2
+		if d >= r.cfg.MaxBackoff {
+			return r.cfg.MaxBackoff
+		}
+	}
+	return d
+}
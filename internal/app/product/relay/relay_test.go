@@ -0,0 +1,134 @@
+package relay
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/infra/publisher"
+)
+
+type fakeClock struct{ t time.Time }
+
+func (f fakeClock) Now() time.Time { return f.t }
+
+type failure struct {
+	attempts int64
+	next     *time.Time
+	err      string
+}
+
+type fakeStore struct {
+	pending   []contracts.OutboxMessage
+	published []string
+	failed    map[string]failure
+}
+
+func (s *fakeStore) Claim(ctx context.Context, limit int, leaseUntil time.Time) ([]contracts.OutboxMessage, error) {
+	n := len(s.pending)
+	if n > limit {
+		n = limit
+	}
+	out := s.pending[:n]
+	s.pending = s.pending[n:]
+	return out, nil
+}
+
+func (s *fakeStore) MarkPublished(ctx context.Context, eventID string) error {
+	s.published = append(s.published, eventID)
+	return nil
+}
+
+func (s *fakeStore) MarkFailed(ctx context.Context, eventID string, attempts int64, next *time.Time, lastErr string) error {
+	if s.failed == nil {
+		s.failed = map[string]failure{}
+	}
+	s.failed[eventID] = failure{attempts: attempts, next: next, err: lastErr}
+	return nil
+}
+
+type failingPublisher struct{}
+
+func (failingPublisher) Publish(ctx context.Context, msg contracts.OutboxMessage) error {
+	return errors.New("broker down")
+}
+
+func TestRunOnce_PublishesAndMarksPublished(t *testing.T) {
+	now := time.Date(2026, 1, 12, 12, 0, 0, 0, time.UTC)
+	st := &fakeStore{pending: []contracts.OutboxMessage{
+		{EventID: "e1", EventType: "product.created", AggregateID: "p1", Payload: []byte(`{}`)},
+		{EventID: "e2", EventType: "product.activated", AggregateID: "p1", Payload: []byte(`{}`)},
+	}}
+	pub := publisher.NewMemory()
+
+	r := New(st, pub, fakeClock{t: now}, Config{BatchSize: 10})
+
+	n, err := r.RunOnce(context.Background())
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	if n != 2 {
+		t.Fatalf("expected 2 claimed, got %d", n)
+	}
+	if got := len(pub.Messages()); got != 2 {
+		t.Fatalf("expected 2 published, got %d", got)
+	}
+	if len(st.published) != 2 || st.published[0] != "e1" || st.published[1] != "e2" {
+		t.Fatalf("expected e1,e2 marked published, got %v", st.published)
+	}
+}
+
+func TestRunOnce_FailureSchedulesRetryWithBackoff(t *testing.T) {
+	now := time.Date(2026, 1, 12, 12, 0, 0, 0, time.UTC)
+	st := &fakeStore{pending: []contracts.OutboxMessage{{EventID: "e1", Attempts: 2}}}
+
+	r := New(st, failingPublisher{}, fakeClock{t: now}, Config{BaseBackoff: time.Second, MaxBackoff: time.Minute, MaxAttempts: 5})
+
+	if _, err := r.RunOnce(context.Background()); err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+
+	f, ok := st.failed["e1"]
+	if !ok {
+		t.Fatalf("expected e1 marked failed")
+	}
+	if f.attempts != 3 {
+		t.Fatalf("expected 3 attempts, got %d", f.attempts)
+	}
+	if f.next == nil || !f.next.Equal(now.Add(4*time.Second)) {
+		t.Fatalf("expected retry at +4s, got %v", f.next)
+	}
+	if f.err != "broker down" {
+		t.Fatalf("expected last error recorded, got %q", f.err)
+	}
+}
+
+func TestRunOnce_FailureGivesUpAfterMaxAttempts(t *testing.T) {
+	now := time.Date(2026, 1, 12, 12, 0, 0, 0, time.UTC)
+	st := &fakeStore{pending: []contracts.OutboxMessage{{EventID: "e1", Attempts: 4}}}
+
+	r := New(st, failingPublisher{}, fakeClock{t: now}, Config{MaxAttempts: 5})
+
+	if _, err := r.RunOnce(context.Background()); err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	if f := st.failed["e1"]; f.attempts != 5 || f.next != nil {
+		t.Fatalf("expected terminal failure after 5 attempts, got %+v", f)
+	}
+}
+
+func TestBackoff_CappedAtMax(t *testing.T) {
+	r := New(&fakeStore{}, publisher.NewMemory(), fakeClock{}, Config{BaseBackoff: time.Second, MaxBackoff: 10 * time.Second})
+
+	if got := r.backoff(1); got != time.Second {
+		t.Fatalf("expected 1s, got %v", got)
+	}
+	if got := r.backoff(4); got != 8*time.Second {
+		t.Fatalf("expected 8s, got %v", got)
+	}
+	if got := r.backoff(20); got != 10*time.Second {
+		t.Fatalf("expected 10s cap, got %v", got)
+	}
+}
@@ -0,0 +1,69 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"cloud.google.com/go/spanner"
+
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/domain"
+)
+
+type DiscountSchedule struct {
+	client *spanner.Client
+}
+
+func NewDiscountSchedule(client *spanner.Client) *DiscountSchedule {
+	return &DiscountSchedule{client: client}
+}
+
+// DueProducts also returns products whose legacy discount columns hold a
+// discount that has ended, so those get cleared as well.
+func (s *DiscountSchedule) DueProducts(ctx context.Context, now, pruneBefore time.Time, limit int) ([]string, error) {
+	due := spanner.NewStatement(`
+		SELECT product_id FROM product_discounts
+		WHERE (status = @scheduled AND start_date <= @now) OR (status != @expired AND end_date <= @now)
+		   OR (status = @expired AND end_date < @prune_before)
+		LIMIT @limit
+	`)
+	due.Params["scheduled"] = string(domain.DiscountScheduled)
+	due.Params["expired"] = string(domain.DiscountExpired)
+	due.Params["now"] = now
+	due.Params["prune_before"] = pruneBefore
+	due.Params["limit"] = int64(limit)
+
+	legacy := spanner.NewStatement(`
+		SELECT product_id FROM products
+		WHERE discount_end_date <= @now
+		LIMIT @limit
+	`)
+	legacy.Params["now"] = now
+	legacy.Params["limit"] = int64(limit)
+
+	tx := s.client.ReadOnlyTransaction()
+	defer tx.Close()
+
+	var out []string
+	seen := map[string]bool{}
+	for _, st := range []spanner.Statement{due, legacy} {
+		iter := tx.Query(ctx, st)
+		err := iter.Do(func(row *spanner.Row) error {
+			var id string
+			if err := row.Columns(&id); err != nil {
+				return err
+			}
+			if !seen[id] && len(out) < limit {
+				seen[id] = true
+				out = append(out, id)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+var _ contracts.DiscountSchedule = (*DiscountSchedule)(nil)
@@ -0,0 +1,177 @@
+package repo
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/api/iterator"
+
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/models/m_discount"
+)
+
+// legacyDiscountID identifies a discount still held in the discount_*
+// columns of products, written before discounts moved to their own table.
+// It is moved over the next time the product's discounts are written.
+const legacyDiscountID = "legacy"
+
+// readDiscounts loads the discount schedules of the given products. A
+// non-zero since leaves out the expired discounts that ended by then.
+func readDiscounts(ctx context.Context, tx *spanner.ReadOnlyTransaction, productIDs []string, since time.Time) (map[string][]*domain.Discount, error) {
+	out := make(map[string][]*domain.Discount, len(productIDs))
+	if len(productIDs) == 0 {
+		return out, nil
+	}
+
+	query := `
+		SELECT product_id, discount_id, kind, percent, amount_numerator, amount_denominator, amount_currency,
+		       start_date, end_date, status, priority, stacking
+		FROM product_discounts
+		WHERE product_id IN UNNEST(@ids)
+	`
+	params := map[string]interface{}{"ids": productIDs}
+	if !since.IsZero() {
+		query += " AND (end_date > @since OR status != @expired)"
+		params["since"] = since
+		params["expired"] = string(domain.DiscountExpired)
+	}
+	query += " ORDER BY product_id, start_date"
+
+	st := spanner.NewStatement(query)
+	st.Params = params
+
+	iter := tx.Query(ctx, st)
+	defer iter.Stop()
+
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			return out, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			productID, discountID, status string
+			kind, stacking, amountCur     spanner.NullString
+			percent                       spanner.NullNumeric
+			amountNum, amountDen          spanner.NullInt64
+			start, end                    time.Time
+			priority                      spanner.NullInt64
+		)
+		if err := row.Columns(&productID, &discountID, &kind, &percent, &amountNum, &amountDen, &amountCur, &start, &end, &status, &priority, &stacking); err != nil {
+			return nil, err
+		}
+		d, err := hydrateDiscount(discountID, kind, percent, amountNum, amountDen, amountCur, start, end, status, priority, stacking)
+		if err != nil {
+			return nil, err
+		}
+		out[productID] = append(out[productID], d)
+	}
+}
+
+// hydrateDiscount reads rows written before discounts had a kind as
+// percentages, rows without a stacking policy as best-wins and amounts
+// without a currency as DefaultCurrency.
+func hydrateDiscount(id string, kind spanner.NullString, percent spanner.NullNumeric, amountNum, amountDen spanner.NullInt64, amountCur spanner.NullString, start, end time.Time, status string, priority spanner.NullInt64, stacking spanner.NullString) (*domain.Discount, error) {
+	k, err := domain.ParseDiscountKind(kind.StringVal)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		pct    *big.Rat
+		amount *domain.Money
+	)
+	if k == domain.DiscountPercentage {
+		pct = &percent.Numeric
+	} else if amount, err = domain.NewMoneyFromFractionIn(amountNum.Int64, amountDen.Int64, currencyOf(amountCur)); err != nil {
+		return nil, err
+	}
+	p, err := domain.ParseStackingPolicy(stacking.StringVal)
+	if err != nil {
+		return nil, err
+	}
+	return domain.HydrateDiscount(id, k, pct, amount, start, end, domain.DiscountStatus(status), priority.Int64, p), nil
+}
+
+func legacyDiscount(percent spanner.NullNumeric, start, end spanner.NullTime) *domain.Discount {
+	if !percent.Valid || !start.Valid || !end.Valid {
+		return nil
+	}
+	return domain.HydrateDiscount(legacyDiscountID, domain.DiscountPercentage, &percent.Numeric, nil, start.Time, end.Time, domain.DiscountActive, 0, domain.StackBestWins)
+}
+
+func discountRow(productID string, d *domain.Discount, now time.Time) (map[string]interface{}, error) {
+	row := map[string]interface{}{
+		m_discount.ProductID:  productID,
+		m_discount.DiscountID: d.ID(),
+		m_discount.Kind:       string(d.Kind()),
+		m_discount.Percent:    spanner.NullNumeric{},
+		m_discount.AmountNum:  spanner.NullInt64{},
+		m_discount.AmountDen:  spanner.NullInt64{},
+		m_discount.AmountCur:  spanner.NullString{},
+		m_discount.StartDate:  d.Start(),
+		m_discount.EndDate:    d.End(),
+		m_discount.Status:     string(d.Status()),
+		m_discount.Priority:   d.Priority(),
+		m_discount.Stacking:   string(d.Stacking()),
+		m_discount.CreatedAt:  now,
+	}
+	if pct := d.Percent(); pct != nil {
+		row[m_discount.Percent] = spanner.NullNumeric{Numeric: *pct, Valid: true}
+	}
+	if a := d.Amount(); a != nil {
+		num, den, err := fractionOf(a)
+		if err != nil {
+			return nil, err
+		}
+		row[m_discount.AmountNum] = num
+		row[m_discount.AmountDen] = den
+		row[m_discount.AmountCur] = string(a.Currency())
+	}
+	return row, nil
+}
+
+// discountMuts diffs two discount schedules into child-row mutations.
+func discountMuts(model m_discount.Model, productID string, old, new []*domain.Discount, now time.Time) ([]*spanner.Mutation, error) {
+	stored := map[string]*domain.Discount{}
+	for _, d := range old {
+		if d.ID() != legacyDiscountID {
+			stored[d.ID()] = d
+		}
+	}
+
+	var muts []*spanner.Mutation
+	for _, d := range new {
+		prev, ok := stored[d.ID()]
+		delete(stored, d.ID())
+		if !ok {
+			row, err := discountRow(productID, d, now)
+			if err != nil {
+				return nil, err
+			}
+			if d.ID() == legacyDiscountID {
+				// It may have been moved over already, now expired.
+				muts = append(muts, model.InsertOrUpdateMut(row))
+			} else {
+				muts = append(muts, model.InsertMut(row))
+			}
+			continue
+		}
+		if prev.Status() != d.Status() || !prev.End().Equal(d.End()) {
+			muts = append(muts, model.UpdateMut(map[string]interface{}{
+				m_discount.ProductID:  productID,
+				m_discount.DiscountID: d.ID(),
+				m_discount.EndDate:    d.End(),
+				m_discount.Status:     string(d.Status()),
+			}))
+		}
+	}
+	for id := range stored {
+		muts = append(muts, model.DeleteMut(productID, id))
+	}
+	return muts, nil
+}
@@ -0,0 +1,145 @@
+package repo
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/api/iterator"
+
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/infra/spannerx"
+	"product-catalog-service/internal/models/m_exchange_rate"
+	"product-catalog-service/internal/pkg/clock"
+)
+
+type ExchangeRateRepo struct {
+	client *spanner.Client
+	model  m_exchange_rate.Model
+	clock  clock.Clock
+}
+
+func NewExchangeRateRepo(client *spanner.Client, clk clock.Clock) *ExchangeRateRepo {
+	return &ExchangeRateRepo{client: client, model: m_exchange_rate.Model{}, clock: clk}
+}
+
+func (r *ExchangeRateRepo) RateAt(ctx context.Context, from, to domain.Currency, at time.Time) (*domain.ExchangeRate, error) {
+	tx := r.client.Single()
+	defer tx.Close()
+
+	rates, err := readRatesTo(ctx, tx, to, at)
+	if err != nil {
+		return nil, err
+	}
+	rate, ok := rates[from]
+	if !ok {
+		return nil, domain.ErrExchangeRateNotFound
+	}
+	return rate, nil
+}
+
+func (r *ExchangeRateRepo) List(ctx context.Context, from, to domain.Currency) ([]*domain.ExchangeRate, error) {
+	query := `
+		SELECT from_currency, to_currency, rate_numerator, rate_denominator, effective_from
+		FROM exchange_rates
+		WHERE TRUE
+	`
+	params := map[string]interface{}{}
+	if from != "" {
+		query += " AND from_currency = @from"
+		params["from"] = string(from)
+	}
+	if to != "" {
+		query += " AND to_currency = @to"
+		params["to"] = string(to)
+	}
+	query += " ORDER BY from_currency, to_currency, effective_from DESC"
+
+	st := spanner.NewStatement(query)
+	st.Params = params
+
+	iter := r.client.Single().Query(ctx, st)
+	defer iter.Stop()
+
+	var out []*domain.ExchangeRate
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			return out, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		var (
+			f, t     string
+			num, den int64
+			eff      time.Time
+		)
+		if err := row.Columns(&f, &t, &num, &den, &eff); err != nil {
+			return nil, err
+		}
+		rate, err := domain.NewExchangeRate(domain.Currency(f), domain.Currency(t), big.NewRat(num, den), eff)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, rate)
+	}
+}
+
+func (r *ExchangeRateRepo) UpsertMut(rate *domain.ExchangeRate) contracts.Mutation {
+	return spannerx.Wrap(r.model.InsertOrUpdateMut(map[string]interface{}{
+		m_exchange_rate.ToCurrency:    string(rate.To()),
+		m_exchange_rate.FromCurrency:  string(rate.From()),
+		m_exchange_rate.EffectiveFrom: rate.EffectiveFrom(),
+		m_exchange_rate.RateNum:       rate.Rate().Num().Int64(),
+		m_exchange_rate.RateDen:       rate.Rate().Denom().Int64(),
+		m_exchange_rate.CreatedAt:     r.clock.Now(),
+	}))
+}
+
+// readRatesTo loads, for every currency with a rate into to, the rate in
+// effect at t.
+func readRatesTo(ctx context.Context, tx *spanner.ReadOnlyTransaction, to domain.Currency, at time.Time) (map[domain.Currency]*domain.ExchangeRate, error) {
+	st := spanner.NewStatement(`
+		SELECT from_currency, rate_numerator, rate_denominator, effective_from
+		FROM exchange_rates
+		WHERE to_currency = @to AND effective_from <= @at
+		ORDER BY from_currency, effective_from DESC
+	`)
+	st.Params["to"] = string(to)
+	st.Params["at"] = at
+
+	iter := tx.Query(ctx, st)
+	defer iter.Stop()
+
+	out := map[domain.Currency]*domain.ExchangeRate{}
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			return out, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		var (
+			from     string
+			num, den int64
+			eff      time.Time
+		)
+		if err := row.Columns(&from, &num, &den, &eff); err != nil {
+			return nil, err
+		}
+		if _, ok := out[domain.Currency(from)]; ok {
+			continue
+		}
+		rate, err := domain.NewExchangeRate(domain.Currency(from), to, big.NewRat(num, den), eff)
+		if err != nil {
+			return nil, err
+		}
+		out[rate.From()] = rate
+	}
+}
+
+var _ contracts.ExchangeRateRepo = (*ExchangeRateRepo)(nil)
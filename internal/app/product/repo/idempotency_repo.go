@@ -0,0 +1,51 @@
+package repo
+
+import (
+	"context"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/grpc/codes"
+
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/infra/spannerx"
+	"product-catalog-service/internal/models/m_idempotency"
+)
+
+type IdempotencyRepo struct {
+	client *spanner.Client
+	model  m_idempotency.Model
+}
+
+func NewIdempotencyRepo(client *spanner.Client) *IdempotencyRepo {
+	return &IdempotencyRepo{client: client, model: m_idempotency.Model{}}
+}
+
+func (r *IdempotencyRepo) Get(ctx context.Context, key string) (*contracts.IdempotencyRecord, error) {
+	row, err := r.client.Single().ReadRow(ctx, m_idempotency.Table, spanner.Key{key}, []string{
+		m_idempotency.Key, m_idempotency.Operation, m_idempotency.RequestHash, m_idempotency.Response, m_idempotency.CreatedAt,
+	})
+	if spanner.ErrCode(err) == codes.NotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var rec contracts.IdempotencyRecord
+	if err := row.Columns(&rec.Key, &rec.Operation, &rec.RequestHash, &rec.Response, &rec.CreatedAt); err != nil {
+		return nil, err
+	}
+	return &rec, nil
+}
+
+func (r *IdempotencyRepo) InsertMut(rec contracts.IdempotencyRecord) contracts.Mutation {
+	return spannerx.Wrap(r.model.InsertMut(map[string]interface{}{
+		m_idempotency.Key:         rec.Key,
+		m_idempotency.Operation:   rec.Operation,
+		m_idempotency.RequestHash: rec.RequestHash,
+		m_idempotency.Response:    rec.Response,
+		m_idempotency.CreatedAt:   rec.CreatedAt,
+	}))
+}
+
+var _ contracts.IdempotencyRepo = (*IdempotencyRepo)(nil)
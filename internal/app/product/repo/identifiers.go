@@ -0,0 +1,92 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
+
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/models/m_product_sku"
+)
+
+// readSKUOwner returns the ID of the product that uses sku, as its own SKU
+// or a variant's, or "" when none does.
+func readSKUOwner(ctx context.Context, tx *spanner.ReadOnlyTransaction, sku string) (string, error) {
+	row, err := tx.ReadRow(ctx, m_product_sku.Table, spanner.Key{sku}, []string{m_product_sku.ProductID})
+	if spanner.ErrCode(err) == codes.NotFound {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	var id string
+	if err := row.Columns(&id); err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+func readGTINOwner(ctx context.Context, tx *spanner.ReadOnlyTransaction, gtin domain.GTIN) (string, error) {
+	st := spanner.NewStatement(`SELECT product_id FROM products WHERE gtin = @gtin`)
+	st.Params["gtin"] = string(gtin)
+
+	iter := tx.Query(ctx, st)
+	defer iter.Stop()
+
+	row, err := iter.Next()
+	if err == iterator.Done {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	var id string
+	if err := row.Columns(&id); err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+// skusOf maps every SKU a product uses to the variant that uses it, or to
+// "" for the product's own.
+func skusOf(sku string, variants []*domain.Variant) map[string]string {
+	out := make(map[string]string, len(variants)+1)
+	if sku != "" {
+		out[sku] = ""
+	}
+	for _, v := range variants {
+		out[v.SKU()] = v.ID()
+	}
+	return out
+}
+
+// skuMuts diffs two SKU sets into product_skus mutations. A SKU is inserted,
+// never upserted, so one another product already holds fails the commit with
+// AlreadyExists.
+func skuMuts(model m_product_sku.Model, productID string, old, new map[string]string, now time.Time) []*spanner.Mutation {
+	var muts []*spanner.Mutation
+	for sku, variantID := range new {
+		row := map[string]interface{}{
+			m_product_sku.SKU:       sku,
+			m_product_sku.ProductID: productID,
+			m_product_sku.VariantID: nullStringOf(variantID),
+		}
+		prev, ok := old[sku]
+		switch {
+		case !ok:
+			row[m_product_sku.CreatedAt] = now
+			muts = append(muts, model.InsertMut(row))
+		case prev != variantID:
+			muts = append(muts, model.UpdateMut(row))
+		}
+	}
+	for sku := range old {
+		if _, ok := new[sku]; !ok {
+			muts = append(muts, model.DeleteMut(sku))
+		}
+	}
+	return muts
+}
@@ -0,0 +1,39 @@
+package memrepo
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/infra/memstore"
+)
+
+type DiscountSchedule struct {
+	store *memstore.Store
+}
+
+func NewDiscountSchedule(store *memstore.Store) *DiscountSchedule {
+	return &DiscountSchedule{store: store}
+}
+
+func (s *DiscountSchedule) DueProducts(ctx context.Context, now, pruneBefore time.Time, limit int) ([]string, error) {
+	var out []string
+	for productID, ds := range discountsByProduct(s.store.Snapshot()) {
+		for _, d := range ds {
+			if (d.Status() != domain.DiscountExpired && d.IsExpiredAt(now)) || (d.Status() == domain.DiscountScheduled && d.IsValidAt(now)) ||
+				(d.Status() == domain.DiscountExpired && d.End().Before(pruneBefore)) {
+				out = append(out, productID)
+				break
+			}
+		}
+	}
+	sort.Strings(out)
+	if len(out) > limit {
+		out = out[:limit]
+	}
+	return out, nil
+}
+
+var _ contracts.DiscountSchedule = (*DiscountSchedule)(nil)
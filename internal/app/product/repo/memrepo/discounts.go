@@ -0,0 +1,87 @@
+package memrepo
+
+import (
+	"math/big"
+	"sort"
+	"time"
+
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/infra/memstore"
+	"product-catalog-service/internal/models/m_discount"
+)
+
+// discountsByProduct loads every stored discount schedule, keyed by product.
+func discountsByProduct(snap *memstore.Snapshot) map[string][]*domain.Discount {
+	out := map[string][]*domain.Discount{}
+	for _, row := range snap.Rows(m_discount.Table) {
+		productID := row[m_discount.ProductID].(string)
+		pct, _ := row[m_discount.Percent].(*big.Rat)
+		var amount *domain.Money
+		if num, ok := row[m_discount.AmountNum].(int64); ok {
+			amount, _ = domain.NewMoneyFromFractionIn(num, row[m_discount.AmountDen].(int64), currencyCol(row, m_discount.AmountCur))
+		}
+		out[productID] = append(out[productID], domain.HydrateDiscount(
+			row[m_discount.DiscountID].(string),
+			domain.DiscountKind(row[m_discount.Kind].(string)),
+			pct,
+			amount,
+			row[m_discount.StartDate].(time.Time),
+			row[m_discount.EndDate].(time.Time),
+			domain.DiscountStatus(row[m_discount.Status].(string)),
+			row[m_discount.Priority].(int64),
+			domain.StackingPolicy(row[m_discount.Stacking].(string)),
+		))
+	}
+	for _, ds := range out {
+		sort.SliceStable(ds, func(i, j int) bool { return ds[i].Start().Before(ds[j].Start()) })
+	}
+	return out
+}
+
+func discountMuts(productID string, old, new []*domain.Discount, now time.Time) []memstore.Mutation {
+	stored := map[string]*domain.Discount{}
+	for _, d := range old {
+		stored[d.ID()] = d
+	}
+
+	var muts []memstore.Mutation
+	for _, d := range new {
+		key := memstore.Key(productID, d.ID())
+		prev, ok := stored[d.ID()]
+		delete(stored, d.ID())
+		switch {
+		case !ok:
+			row := memstore.Row{
+				m_discount.ProductID:  productID,
+				m_discount.DiscountID: d.ID(),
+				m_discount.Kind:       string(d.Kind()),
+				m_discount.Percent:    d.Percent(),
+				m_discount.StartDate:  d.Start(),
+				m_discount.EndDate:    d.End(),
+				m_discount.Status:     string(d.Status()),
+				m_discount.Priority:   d.Priority(),
+				m_discount.Stacking:   string(d.Stacking()),
+				m_discount.CreatedAt:  now,
+			}
+			if a := d.Amount(); a != nil {
+				num, den, err := fractionOf(a)
+				if err != nil {
+					return []memstore.Mutation{memstore.Failed(err)}
+				}
+				row[m_discount.AmountNum] = num
+				row[m_discount.AmountDen] = den
+				row[m_discount.AmountCur] = string(a.Currency())
+			}
+			muts = append(muts, memstore.Insert(m_discount.Table, key, row))
+		case prev.Status() != d.Status() || !prev.End().Equal(d.End()):
+			muts = append(muts, memstore.Update(m_discount.Table, key, memstore.Row{
+				m_discount.EndDate: d.End(),
+				m_discount.Status:  string(d.Status()),
+			}))
+		}
+	}
+	for id := range stored {
+		muts = append(muts, memstore.Delete(m_discount.Table, memstore.Key(productID, id)))
+	}
+	return muts
+}
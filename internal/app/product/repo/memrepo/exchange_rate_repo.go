@@ -0,0 +1,96 @@
+package memrepo
+
+import (
+	"context"
+	"math/big"
+	"sort"
+	"time"
+
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/infra/memstore"
+	"product-catalog-service/internal/models/m_exchange_rate"
+	"product-catalog-service/internal/pkg/clock"
+)
+
+type ExchangeRateRepo struct {
+	store *memstore.Store
+	clock clock.Clock
+}
+
+func NewExchangeRateRepo(store *memstore.Store, clk clock.Clock) *ExchangeRateRepo {
+	return &ExchangeRateRepo{store: store, clock: clk}
+}
+
+func (r *ExchangeRateRepo) RateAt(ctx context.Context, from, to domain.Currency, at time.Time) (*domain.ExchangeRate, error) {
+	rate, ok := ratesTo(r.store.Snapshot(), to, at)[from]
+	if !ok {
+		return nil, domain.ErrExchangeRateNotFound
+	}
+	return rate, nil
+}
+
+func (r *ExchangeRateRepo) List(ctx context.Context, from, to domain.Currency) ([]*domain.ExchangeRate, error) {
+	var out []*domain.ExchangeRate
+	for _, rate := range allRates(r.store.Snapshot()) {
+		if (from == "" || rate.From() == from) && (to == "" || rate.To() == to) {
+			out = append(out, rate)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].From() != out[j].From() {
+			return out[i].From() < out[j].From()
+		}
+		if out[i].To() != out[j].To() {
+			return out[i].To() < out[j].To()
+		}
+		return out[i].EffectiveFrom().After(out[j].EffectiveFrom())
+	})
+	return out, nil
+}
+
+func (r *ExchangeRateRepo) UpsertMut(rate *domain.ExchangeRate) contracts.Mutation {
+	key := memstore.Key(string(rate.To()), string(rate.From()), rate.EffectiveFrom().Format(time.RFC3339Nano))
+	return memstore.InsertOrUpdate(m_exchange_rate.Table, key, memstore.Row{
+		m_exchange_rate.ToCurrency:    string(rate.To()),
+		m_exchange_rate.FromCurrency:  string(rate.From()),
+		m_exchange_rate.EffectiveFrom: rate.EffectiveFrom(),
+		m_exchange_rate.RateNum:       rate.Rate().Num().Int64(),
+		m_exchange_rate.RateDen:       rate.Rate().Denom().Int64(),
+		m_exchange_rate.CreatedAt:     r.clock.Now(),
+	})
+}
+
+// ratesTo returns, for every currency with a rate into to, the rate in
+// effect at t.
+func ratesTo(snap *memstore.Snapshot, to domain.Currency, at time.Time) map[domain.Currency]*domain.ExchangeRate {
+	out := map[domain.Currency]*domain.ExchangeRate{}
+	for _, rate := range allRates(snap) {
+		if rate.To() != to || rate.EffectiveFrom().After(at) {
+			continue
+		}
+		if cur, ok := out[rate.From()]; !ok || rate.EffectiveFrom().After(cur.EffectiveFrom()) {
+			out[rate.From()] = rate
+		}
+	}
+	return out
+}
+
+func allRates(snap *memstore.Snapshot) []*domain.ExchangeRate {
+	var out []*domain.ExchangeRate
+	for _, row := range snap.Rows(m_exchange_rate.Table) {
+		rate, err := domain.NewExchangeRate(
+			domain.Currency(row[m_exchange_rate.FromCurrency].(string)),
+			domain.Currency(row[m_exchange_rate.ToCurrency].(string)),
+			big.NewRat(row[m_exchange_rate.RateNum].(int64), row[m_exchange_rate.RateDen].(int64)),
+			row[m_exchange_rate.EffectiveFrom].(time.Time),
+		)
+		if err != nil {
+			continue
+		}
+		out = append(out, rate)
+	}
+	return out
+}
+
+var _ contracts.ExchangeRateRepo = (*ExchangeRateRepo)(nil)
@@ -0,0 +1,45 @@
+package memrepo
+
+import (
+	"context"
+	"time"
+
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/infra/memstore"
+	"product-catalog-service/internal/models/m_idempotency"
+)
+
+type IdempotencyRepo struct {
+	store *memstore.Store
+}
+
+func NewIdempotencyRepo(store *memstore.Store) *IdempotencyRepo {
+	return &IdempotencyRepo{store: store}
+}
+
+func (r *IdempotencyRepo) Get(ctx context.Context, key string) (*contracts.IdempotencyRecord, error) {
+	row, ok := r.store.Snapshot().Get(m_idempotency.Table, memstore.Key(key))
+	if !ok {
+		return nil, nil
+	}
+	resp, _ := row[m_idempotency.Response].([]byte)
+	return &contracts.IdempotencyRecord{
+		Key:         row[m_idempotency.Key].(string),
+		Operation:   row[m_idempotency.Operation].(string),
+		RequestHash: row[m_idempotency.RequestHash].(string),
+		Response:    append([]byte(nil), resp...),
+		CreatedAt:   row[m_idempotency.CreatedAt].(time.Time),
+	}, nil
+}
+
+func (r *IdempotencyRepo) InsertMut(rec contracts.IdempotencyRecord) contracts.Mutation {
+	return memstore.Insert(m_idempotency.Table, memstore.Key(rec.Key), memstore.Row{
+		m_idempotency.Key:         rec.Key,
+		m_idempotency.Operation:   rec.Operation,
+		m_idempotency.RequestHash: rec.RequestHash,
+		m_idempotency.Response:    append([]byte(nil), rec.Response...),
+		m_idempotency.CreatedAt:   rec.CreatedAt,
+	})
+}
+
+var _ contracts.IdempotencyRepo = (*IdempotencyRepo)(nil)
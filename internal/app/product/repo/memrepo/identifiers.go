@@ -0,0 +1,73 @@
+package memrepo
+
+import (
+	"time"
+
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/infra/memstore"
+	"product-catalog-service/internal/models/m_product"
+	"product-catalog-service/internal/models/m_product_sku"
+)
+
+// skuOwner returns the ID of the product that uses sku, as its own SKU or a
+// variant's, or "" when none does.
+func skuOwner(snap *memstore.Snapshot, sku string) string {
+	row, ok := snap.Get(m_product_sku.Table, memstore.Key(sku))
+	if !ok {
+		return ""
+	}
+	return row[m_product_sku.ProductID].(string)
+}
+
+func gtinOwner(snap *memstore.Snapshot, gtin domain.GTIN) string {
+	if gtin == "" {
+		return ""
+	}
+	for _, row := range snap.Rows(m_product.Table) {
+		if row[m_product.GTIN] == string(gtin) {
+			return row[m_product.ProductID].(string)
+		}
+	}
+	return ""
+}
+
+// skusOf maps every SKU a product uses to the variant that uses it, or to
+// "" for the product's own.
+func skusOf(sku string, variants []*domain.Variant) map[string]string {
+	out := make(map[string]string, len(variants)+1)
+	if sku != "" {
+		out[sku] = ""
+	}
+	for _, v := range variants {
+		out[v.SKU()] = v.ID()
+	}
+	return out
+}
+
+// skuMuts diffs two SKU sets into product_skus mutations. A SKU is inserted,
+// never upserted, so one another product already holds fails the commit.
+func skuMuts(productID string, old, new map[string]string, now time.Time) []memstore.Mutation {
+	var muts []memstore.Mutation
+	for sku, variantID := range new {
+		key := memstore.Key(sku)
+		row := memstore.Row{
+			m_product_sku.ProductID: productID,
+			m_product_sku.VariantID: nilIfEmpty(variantID),
+		}
+		prev, ok := old[sku]
+		switch {
+		case !ok:
+			row[m_product_sku.SKU] = sku
+			row[m_product_sku.CreatedAt] = now
+			muts = append(muts, memstore.Insert(m_product_sku.Table, key, row))
+		case prev != variantID:
+			muts = append(muts, memstore.Update(m_product_sku.Table, key, row))
+		}
+	}
+	for sku := range old {
+		if _, ok := new[sku]; !ok {
+			muts = append(muts, memstore.Delete(m_product_sku.Table, memstore.Key(sku)))
+		}
+	}
+	return muts
+}
@@ -0,0 +1,697 @@
+package memrepo
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/app/product/domain/services"
+	"product-catalog-service/internal/app/product/queries/list_price_history"
+	"product-catalog-service/internal/app/product/repo"
+	"product-catalog-service/internal/app/product/usecases/activate_product"
+	"product-catalog-service/internal/app/product/usecases/add_variant"
+	"product-catalog-service/internal/app/product/usecases/advance_discounts"
+	"product-catalog-service/internal/app/product/usecases/apply_discount"
+	"product-catalog-service/internal/app/product/usecases/change_base_price"
+	"product-catalog-service/internal/app/product/usecases/create_price_list"
+	"product-catalog-service/internal/app/product/usecases/create_product"
+	"product-catalog-service/internal/app/product/usecases/remove_price"
+	"product-catalog-service/internal/app/product/usecases/remove_price_list_entry"
+	"product-catalog-service/internal/app/product/usecases/remove_variant"
+	"product-catalog-service/internal/app/product/usecases/set_exchange_rate"
+	"product-catalog-service/internal/app/product/usecases/set_identifiers"
+	"product-catalog-service/internal/app/product/usecases/set_price"
+	"product-catalog-service/internal/app/product/usecases/set_price_list_entry"
+	"product-catalog-service/internal/app/product/usecases/set_price_tiers"
+	"product-catalog-service/internal/app/product/usecases/update_product"
+	"product-catalog-service/internal/app/product/usecases/update_variant"
+	"product-catalog-service/internal/infra/memstore"
+	"product-catalog-service/internal/pkg/committer"
+	"product-catalog-service/internal/pkg/pagetoken"
+)
+
+type fakeClock struct{ t time.Time }
+
+func (f *fakeClock) Now() time.Time { return f.t }
+
+type env struct {
+	clock    *fakeClock
+	store    *memstore.Store
+	products *ProductRepo
+	outbox   *OutboxRepo
+	history  *PriceHistoryRepo
+	reads    *ReadModel
+	comm     *memstore.Committer
+}
+
+func newEnv() *env {
+	clk := &fakeClock{t: time.Date(2026, 1, 12, 12, 0, 0, 0, time.UTC)}
+	st := memstore.NewStore()
+	return &env{
+		clock:    clk,
+		store:    st,
+		products: NewProductRepo(st, clk),
+		outbox:   NewOutboxRepo(clk),
+		history:  NewPriceHistoryRepo(st, clk),
+		reads:    NewReadModel(st, clk, pagetoken.NewCodec([]byte("test")), services.NewCurrencyConverter(domain.DefaultRounding), 30, domain.TaxModeNet),
+		comm:     memstore.NewCommitter(st),
+	}
+}
+
+func (e *env) create(t *testing.T, id, category string) {
+	t.Helper()
+	price, err := domain.NewMoneyFromFraction(200, 1)
+	if err != nil {
+		t.Fatalf("setup money: %v", err)
+	}
+	_, err = create_product.New(e.products, e.outbox, e.history, e.comm, e.clock).Execute(context.Background(), create_product.Request{
+		ID: id, Name: "Name " + id, Category: category, BasePrice: price,
+	})
+	if err != nil {
+		t.Fatalf("create %s: %v", id, err)
+	}
+}
+
+func TestProductFlow_CreateActivateDiscount(t *testing.T) {
+	e := newEnv()
+	ctx := context.Background()
+	e.create(t, "p1", "books")
+
+	if err := activate_product.New(e.products, e.outbox, e.comm, e.clock).Execute(ctx, activate_product.Request{ProductID: "p1"}); err != nil {
+		t.Fatalf("activate: %v", err)
+	}
+	err := apply_discount.New(e.products, e.outbox, e.history, e.comm, e.clock).Execute(ctx, apply_discount.Request{
+		ProductID:  "p1",
+		DiscountID: "d1",
+		Percent:    big.NewRat(25, 1),
+		Start:      e.clock.Now().Add(-time.Hour),
+		End:        e.clock.Now().Add(time.Hour),
+	})
+	if err != nil {
+		t.Fatalf("apply discount: %v", err)
+	}
+
+	p, err := e.products.GetByID(ctx, "p1")
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if p.Status() != domain.ProductStatusActive || len(p.Discounts()) != 1 || p.Version() != 3 {
+		t.Fatalf("unexpected product state: status=%s discounts=%d version=%d", p.Status(), len(p.Discounts()), p.Version())
+	}
+
+	dto, err := e.reads.GetProduct(ctx, "p1", "", "", contracts.PriceListSelector{})
+	if err != nil {
+		t.Fatalf("read: %v", err)
+	}
+	if dto.EffectiveNum != 150 || dto.EffectiveDen != 1 {
+		t.Fatalf("expected effective 150/1, got %d/%d", dto.EffectiveNum, dto.EffectiveDen)
+	}
+
+	if n := len(e.store.Snapshot().Rows("outbox_events")); n != 3 {
+		t.Fatalf("expected 3 outbox events, got %d", n)
+	}
+}
+
+func TestReadModel_DiscountAppliesOnlyWithinWindow(t *testing.T) {
+	e := newEnv()
+	ctx := context.Background()
+	e.create(t, "p1", "books")
+
+	if err := activate_product.New(e.products, e.outbox, e.comm, e.clock).Execute(ctx, activate_product.Request{ProductID: "p1"}); err != nil {
+		t.Fatalf("activate: %v", err)
+	}
+	start := e.clock.Now()
+	err := apply_discount.New(e.products, e.outbox, e.history, e.comm, e.clock).Execute(ctx, apply_discount.Request{
+		ProductID:  "p1",
+		DiscountID: "d1",
+		Percent:    big.NewRat(1, 3),
+		Start:      start,
+		End:        start.Add(time.Hour),
+	})
+	if err != nil {
+		t.Fatalf("apply discount: %v", err)
+	}
+
+	dto, err := e.reads.GetProduct(ctx, "p1", "", "", contracts.PriceListSelector{})
+	if err != nil {
+		t.Fatalf("read: %v", err)
+	}
+	// 1/3% of 200 is 2/3, shown rounded to the cent.
+	if !dto.DiscountActive || dto.DiscountPct != "1/3" || dto.DiscountAmount != "0.67" || dto.EffectivePrice != "199.33" ||
+		dto.EffectiveNum != 19933 || dto.EffectiveDen != 100 {
+		t.Fatalf("expected 1/3%% discount active at its start and rounded to the cent, got %+v", dto)
+	}
+
+	e.clock.t = start.Add(time.Hour)
+	dto, err = e.reads.GetProduct(ctx, "p1", "", "", contracts.PriceListSelector{})
+	if err != nil {
+		t.Fatalf("read: %v", err)
+	}
+	if dto.DiscountActive || dto.DiscountPct != "" || len(dto.Discounts) != 0 || dto.EffectiveNum != 200 || dto.EffectiveDen != 1 || dto.DiscountAmountNum != 0 {
+		t.Fatalf("expected ended discount to be neither applied nor reported, got %+v", dto)
+	}
+}
+
+func TestReadModel_PricesInRequestedCurrency(t *testing.T) {
+	e := newEnv()
+	ctx := context.Background()
+	e.create(t, "p1", "books")
+
+	usd, _ := domain.NewMoneyFromFractionIn(2199, 10, "USD")
+	if err := set_price.New(e.products, e.outbox, e.history, e.comm, e.clock).Execute(ctx, set_price.Request{ProductID: "p1", Price: usd}); err != nil {
+		t.Fatalf("set price: %v", err)
+	}
+
+	dto, err := e.reads.GetProduct(ctx, "p1", "USD", "", contracts.PriceListSelector{})
+	if err != nil {
+		t.Fatalf("read: %v", err)
+	}
+	if dto.Currency != "USD" || dto.BasePriceNum != 2199 || dto.BasePriceDen != 10 || dto.EffectiveNum != 2199 || len(dto.Prices) != 2 {
+		t.Fatalf("expected the USD price, got %+v", dto)
+	}
+
+	if _, err := e.reads.ListProducts(ctx, contracts.ListProductsFilter{Currency: "GBP"}); !errors.Is(err, domain.ErrExchangeRateNotFound) {
+		t.Fatalf("expected ErrExchangeRateNotFound without a GBP price or rate, got %v", err)
+	}
+	if _, err := e.reads.GetProduct(ctx, "p1", "GBP", "", contracts.PriceListSelector{}); !errors.Is(err, domain.ErrExchangeRateNotFound) {
+		t.Fatalf("expected ErrExchangeRateNotFound without a GBP price or rate, got %v", err)
+	}
+
+	if err := remove_price.New(e.products, e.outbox, e.history, e.comm, e.clock).Execute(ctx, remove_price.Request{ProductID: "p1", Currency: "USD"}); err != nil {
+		t.Fatalf("remove price: %v", err)
+	}
+	p, err := e.products.GetByID(ctx, "p1")
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if len(p.Prices()) != 1 || len(e.store.Snapshot().Rows("product_prices")) != 0 || p.Version() != 3 {
+		t.Fatalf("expected only the base price left at version 3, got %v at %d", p.Prices(), p.Version())
+	}
+}
+
+func TestProductRepo_ReplacesPriceTiers(t *testing.T) {
+	e := newEnv()
+	ctx := context.Background()
+	e.create(t, "p1", "books")
+	uc := set_price_tiers.New(e.products, e.outbox, e.comm, e.clock)
+
+	err := uc.Execute(ctx, set_price_tiers.Request{ProductID: "p1", Tiers: []set_price_tiers.Tier{
+		{MinQuantity: 10, MaxQuantity: 49, UnitPrice: big.NewRat(180, 1)},
+		{MinQuantity: 50, UnitPrice: big.NewRat(160, 1)},
+	}})
+	if err != nil {
+		t.Fatalf("set tiers: %v", err)
+	}
+	err = uc.Execute(ctx, set_price_tiers.Request{ProductID: "p1", Tiers: []set_price_tiers.Tier{
+		{MinQuantity: 10, UnitPrice: big.NewRat(175, 1)},
+	}})
+	if err != nil {
+		t.Fatalf("replace tiers: %v", err)
+	}
+
+	p, err := e.products.GetByID(ctx, "p1")
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if ts := p.PriceTiers(); len(ts) != 1 || ts[0].MaxQuantity() != 0 || ts[0].UnitPrice().Currency() != "EUR" || len(e.store.Snapshot().Rows("product_price_tiers")) != 1 {
+		t.Fatalf("expected a single open tier from 10 in EUR, got %v", ts)
+	}
+	dto, err := e.reads.GetProduct(ctx, "p1", "", "", contracts.PriceListSelector{})
+	if err != nil {
+		t.Fatalf("read: %v", err)
+	}
+	if len(dto.PriceTiers) != 1 || dto.PriceTiers[0].Amount != "175.00" || dto.PriceTiers[0].MinQuantity != 10 {
+		t.Fatalf("expected the 175.00 tier from 10 units, got %+v", dto.PriceTiers)
+	}
+}
+
+func TestReadModel_PricesForCustomerGroup(t *testing.T) {
+	e := newEnv()
+	ctx := context.Background()
+	e.create(t, "p1", "books")
+	lists := NewPriceListRepo(e.store, e.clock)
+	create := create_price_list.New(lists, e.comm)
+	set := set_price_list_entry.New(lists, e.products, e.comm)
+	now := e.clock.Now()
+
+	for _, l := range []struct {
+		id       string
+		priority int64
+		price    int64
+		to       time.Time
+	}{
+		{"low", 1, 180, time.Time{}},
+		{"high", 5, 170, time.Time{}},
+		{"expired", 9, 100, now},
+	} {
+		if _, err := create.Execute(ctx, create_price_list.Request{ID: l.id, Name: l.id, CustomerGroup: "wholesale", Priority: l.priority, ValidFrom: now.Add(-time.Hour), ValidTo: l.to}); err != nil {
+			t.Fatalf("create %s: %v", l.id, err)
+		}
+		price, _ := domain.NewMoneyFromFraction(l.price, 1)
+		if err := set.Execute(ctx, set_price_list_entry.Request{PriceListID: l.id, ProductID: "p1", Price: price}); err != nil {
+			t.Fatalf("set entry in %s: %v", l.id, err)
+		}
+	}
+
+	for sel, want := range map[contracts.PriceListSelector]struct{ list, price string }{
+		{}:                              {"", "200.00"},
+		{CustomerGroup: "wholesale"}:    {"high", "170.00"},
+		{CustomerGroup: "employee"}:     {"", "200.00"},
+		{ID: "low"}:                     {"low", "180.00"},
+		{ID: "expired"}:                 {"", "200.00"},
+		{ID: "low", CustomerGroup: "x"}: {"low", "180.00"},
+	} {
+		dto, err := e.reads.GetProduct(ctx, "p1", "", "", sel)
+		if err != nil {
+			t.Fatalf("read %+v: %v", sel, err)
+		}
+		if dto.PriceListID != want.list || dto.EffectivePrice != want.price {
+			t.Fatalf("%+v: expected %s from %q, got %s from %q", sel, want.price, want.list, dto.EffectivePrice, dto.PriceListID)
+		}
+	}
+
+	if err := remove_price_list_entry.New(lists, e.comm).Execute(ctx, remove_price_list_entry.Request{PriceListID: "high", ProductID: "p1", Currency: "EUR"}); err != nil {
+		t.Fatalf("remove entry: %v", err)
+	}
+	dto, err := e.reads.GetProduct(ctx, "p1", "", "", contracts.PriceListSelector{CustomerGroup: "wholesale"})
+	if err != nil || dto.PriceListID != "low" {
+		t.Fatalf("expected the low list once high no longer prices p1, got %q, %v", dto.PriceListID, err)
+	}
+	if _, err := e.reads.GetProduct(ctx, "p1", "", "", contracts.PriceListSelector{ID: "nope"}); !errors.Is(err, repo.ErrPriceListNotFound) {
+		t.Fatalf("expected ErrPriceListNotFound, got %v", err)
+	}
+	price, _ := domain.NewMoneyFromFraction(1, 1)
+	if err := set.Execute(ctx, set_price_list_entry.Request{PriceListID: "low", ProductID: "nope", Price: price}); !errors.Is(err, repo.ErrProductNotFound) {
+		t.Fatalf("expected ErrProductNotFound for an unknown product, got %v", err)
+	}
+}
+
+func TestProductRepo_StoresVariants(t *testing.T) {
+	e := newEnv()
+	ctx := context.Background()
+	e.create(t, "p1", "apparel")
+	add := add_variant.New(e.products, e.outbox, e.comm, e.clock)
+
+	for _, r := range []add_variant.Request{
+		{ProductID: "p1", VariantID: "v1", SKU: "TEE-RED-M", Attributes: map[string]string{"color": "red", "size": "M"}},
+		{ProductID: "p1", VariantID: "v2", SKU: "TEE-BLUE-M", Attributes: map[string]string{"color": "blue", "size": "M"}, Price: big.NewRat(210, 1)},
+	} {
+		if err := add.Execute(ctx, r); err != nil {
+			t.Fatalf("add %s: %v", r.SKU, err)
+		}
+	}
+	if err := add.Execute(ctx, add_variant.Request{ProductID: "p1", VariantID: "v3", SKU: "TEE-RED-M"}); !errors.Is(err, domain.ErrDuplicateSKU) {
+		t.Fatalf("expected ErrDuplicateSKU, got %v", err)
+	}
+	err := update_variant.New(e.products, e.outbox, e.comm, e.clock).Execute(ctx, update_variant.Request{
+		ProductID: "p1", VariantID: "v1", SKU: "TEE-RED-M", Attributes: map[string]string{"color": "red"}, Price: big.NewRat(199, 1), Status: "inactive",
+	})
+	if err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	if err := remove_variant.New(e.products, e.outbox, e.comm, e.clock).Execute(ctx, remove_variant.Request{ProductID: "p1", VariantID: "v2"}); err != nil {
+		t.Fatalf("remove: %v", err)
+	}
+
+	p, err := e.products.GetByID(ctx, "p1")
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	vs := p.Variants()
+	if len(vs) != 1 || vs[0].Status() != domain.VariantStatusInactive || len(vs[0].Attributes()) != 1 || vs[0].Price().Currency() != "EUR" {
+		t.Fatalf("expected the updated red variant only, got %v", vs)
+	}
+	dto, err := e.reads.GetProduct(ctx, "p1", "", "", contracts.PriceListSelector{})
+	if err != nil {
+		t.Fatalf("read: %v", err)
+	}
+	if len(dto.Variants) != 1 || dto.Variants[0].SKU != "TEE-RED-M" || dto.Variants[0].Price != "199.00" || dto.EffectivePrice != "200.00" {
+		t.Fatalf("expected the red variant at 199.00 beside the 200.00 product, got %+v", dto.Variants)
+	}
+}
+
+func TestIdentifiers_UniqueAcrossProductsAndFoundBySKUOrGTIN(t *testing.T) {
+	e := newEnv()
+	ctx := context.Background()
+	e.create(t, "p1", "apparel")
+	e.create(t, "p2", "apparel")
+	set := set_identifiers.New(e.products, e.outbox, e.comm, e.clock)
+
+	if err := set.Execute(ctx, set_identifiers.Request{ProductID: "p1", SKU: "TEE", GTIN: "04006381333931"}); err != nil {
+		t.Fatalf("set: %v", err)
+	}
+	if err := add_variant.New(e.products, e.outbox, e.comm, e.clock).Execute(ctx, add_variant.Request{ProductID: "p1", VariantID: "v1", SKU: "TEE-RED"}); err != nil {
+		t.Fatalf("add variant: %v", err)
+	}
+	for name, c := range map[string]struct {
+		req  set_identifiers.Request
+		want error
+	}{
+		"product SKU": {set_identifiers.Request{ProductID: "p2", SKU: "TEE"}, domain.ErrDuplicateSKU},
+		"variant SKU": {set_identifiers.Request{ProductID: "p2", SKU: "TEE-RED"}, domain.ErrDuplicateSKU},
+		"GTIN":        {set_identifiers.Request{ProductID: "p2", GTIN: "04006381333931"}, domain.ErrDuplicateGTIN},
+	} {
+		if err := set.Execute(ctx, c.req); !errors.Is(err, c.want) {
+			t.Fatalf("%s: expected %v, got %v", name, c.want, err)
+		}
+	}
+	if err := add_variant.New(e.products, e.outbox, e.comm, e.clock).Execute(ctx, add_variant.Request{ProductID: "p2", VariantID: "v2", SKU: "TEE"}); !errors.Is(err, domain.ErrDuplicateSKU) {
+		t.Fatalf("expected ErrDuplicateSKU for another product's SKU, got %v", err)
+	}
+
+	for _, sku := range []string{"TEE", "TEE-RED"} {
+		dto, err := e.reads.GetProductBySKU(ctx, sku, "", "", contracts.PriceListSelector{})
+		if err != nil || dto.ID != "p1" || dto.SKU != "TEE" || dto.GTIN != "04006381333931" {
+			t.Fatalf("%s: expected p1 with its identifiers, got %+v (%v)", sku, dto, err)
+		}
+	}
+	if dto, err := e.reads.GetProductByGTIN(ctx, "04006381333931", "", "", contracts.PriceListSelector{}); err != nil || dto.ID != "p1" {
+		t.Fatalf("expected p1 by GTIN, got %+v (%v)", dto, err)
+	}
+	if _, err := e.reads.GetProductBySKU(ctx, "NOPE", "", "", contracts.PriceListSelector{}); !errors.Is(err, repo.ErrProductNotFound) {
+		t.Fatalf("expected ErrProductNotFound, got %v", err)
+	}
+
+	dtos, err := e.reads.BatchGetProducts(ctx, []string{"p2", "p1"}, "", "", contracts.PriceListSelector{})
+	if err != nil || len(dtos) != 2 || dtos[0].ID != "p2" || dtos[1].ID != "p1" {
+		t.Fatalf("expected p2 and p1 in request order, got %+v (%v)", dtos, err)
+	}
+	if _, err := e.reads.BatchGetProducts(ctx, []string{"p1", "nope"}, "", "", contracts.PriceListSelector{}); !errors.Is(err, repo.ErrProductNotFound) {
+		t.Fatalf("expected ErrProductNotFound for a missing product, got %v", err)
+	}
+}
+
+func TestIdentifiers_CommitRejectsSKUTakenAfterCheck(t *testing.T) {
+	e := newEnv()
+	ctx := context.Background()
+	e.create(t, "p1", "apparel")
+	e.create(t, "p2", "apparel")
+	set := set_identifiers.New(e.products, e.outbox, e.comm, e.clock)
+
+	// p2 passes the uniqueness check before p1 takes the SKU.
+	p2, err := e.products.GetByID(ctx, "p2")
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if err := set.Execute(ctx, set_identifiers.Request{ProductID: "p1", SKU: "TEE"}); err != nil {
+		t.Fatalf("set: %v", err)
+	}
+	if err := p2.SetIdentifiers("TEE", "", e.clock.Now()); err != nil {
+		t.Fatalf("set identifiers: %v", err)
+	}
+	plan := committer.NewPlan()
+	plan.Add(e.products.UpdateMut(p2))
+	if err := e.comm.Apply(ctx, plan); !errors.Is(err, memstore.ErrAlreadyExists) {
+		t.Fatalf("expected ErrAlreadyExists, got %v", err)
+	}
+
+	// Once p1 gives the SKU up, p2 may take it as a variant SKU.
+	if err := set.Execute(ctx, set_identifiers.Request{ProductID: "p1", SKU: "TEE-2"}); err != nil {
+		t.Fatalf("set: %v", err)
+	}
+	if err := add_variant.New(e.products, e.outbox, e.comm, e.clock).Execute(ctx, add_variant.Request{ProductID: "p2", VariantID: "v1", SKU: "TEE"}); err != nil {
+		t.Fatalf("add variant: %v", err)
+	}
+	if owner, err := e.products.SKUOwner(ctx, "TEE"); err != nil || owner != "p2" {
+		t.Fatalf("expected p2 to own TEE, got %q (%v)", owner, err)
+	}
+}
+
+func TestReadModel_ConvertsBasePriceAtLatestRate(t *testing.T) {
+	e := newEnv()
+	ctx := context.Background()
+	e.create(t, "p1", "books")
+
+	rates := NewExchangeRateRepo(e.store, e.clock)
+	set := set_exchange_rate.New(rates, e.comm, e.clock)
+	now := e.clock.Now()
+	for _, r := range []set_exchange_rate.Request{
+		{From: "EUR", To: "USD", Rate: big.NewRat(11, 10), EffectiveFrom: now.Add(-48 * time.Hour)},
+		{From: "EUR", To: "USD", Rate: big.NewRat(12, 10), EffectiveFrom: now.Add(-time.Hour)},
+		{From: "EUR", To: "USD", Rate: big.NewRat(2, 1), EffectiveFrom: now.Add(time.Hour)},
+	} {
+		if err := set.Execute(ctx, r); err != nil {
+			t.Fatalf("set rate: %v", err)
+		}
+	}
+
+	dto, err := e.reads.GetProduct(ctx, "p1", "USD", "", contracts.PriceListSelector{})
+	if err != nil {
+		t.Fatalf("read: %v", err)
+	}
+	if dto.Currency != "USD" || dto.BasePriceNum != 240 || dto.BasePriceDen != 1 || len(dto.Prices) != 1 {
+		t.Fatalf("expected 200 EUR at 1.2 = 240 USD with only the stored price listed, got %+v", dto)
+	}
+
+	listed, err := rates.List(ctx, "EUR", "")
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if len(listed) != 3 || !listed[0].EffectiveFrom().Equal(now.Add(time.Hour)) {
+		t.Fatalf("expected three rates newest first, got %v", listed)
+	}
+}
+
+func TestPriceHistory_RecordsEveryEffectivePriceInterval(t *testing.T) {
+	e := newEnv()
+	ctx := context.Background()
+	created := e.clock.Now()
+	e.create(t, "p1", "books")
+
+	e.clock.t = created.Add(time.Hour)
+	if err := change_base_price.New(e.products, e.outbox, e.history, e.comm, e.clock).Execute(ctx, change_base_price.Request{ProductID: "p1", BasePrice: big.NewRat(180, 1)}); err != nil {
+		t.Fatalf("change base price: %v", err)
+	}
+	if err := activate_product.New(e.products, e.outbox, e.comm, e.clock).Execute(ctx, activate_product.Request{ProductID: "p1"}); err != nil {
+		t.Fatalf("activate: %v", err)
+	}
+	start := created.Add(3 * time.Hour)
+	err := apply_discount.New(e.products, e.outbox, e.history, e.comm, e.clock).Execute(ctx, apply_discount.Request{
+		ProductID: "p1", DiscountID: "d1", Percent: big.NewRat(10, 1), Start: start, End: start.Add(time.Hour),
+	})
+	if err != nil {
+		t.Fatalf("apply discount: %v", err)
+	}
+
+	// The sweeper runs late, but the discount is recorded at its window.
+	advance := advance_discounts.New(e.products, e.outbox, e.history, e.comm, e.clock)
+	for _, at := range []time.Time{start.Add(10 * time.Minute), start.Add(90 * time.Minute)} {
+		e.clock.t = at
+		if err := advance.Execute(ctx, advance_discounts.Request{ProductID: "p1"}); err != nil {
+			t.Fatalf("advance discounts: %v", err)
+		}
+	}
+
+	history := list_price_history.New(e.history, pagetoken.NewCodec([]byte("k")), domain.DefaultRounding)
+	res, err := history.Execute(ctx, list_price_history.Request{ProductID: "p1"})
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	ivs := res.Intervals
+	want := []struct {
+		from, to  time.Time
+		effective string
+	}{
+		{created, created.Add(time.Hour), "200.00"},
+		{created.Add(time.Hour), start, "180.00"},
+		{start, start.Add(time.Hour), "162.00"},
+		{start.Add(time.Hour), time.Time{}, "180.00"},
+	}
+	if len(ivs) != len(want) {
+		t.Fatalf("expected %d intervals, got %+v", len(want), ivs)
+	}
+	for i, w := range want {
+		iv := ivs[i]
+		if !iv.From.Equal(w.from) || !iv.To.Equal(w.to) || iv.Decimal(iv.Effective) != w.effective {
+			t.Fatalf("interval %d: expected %s from %s to %s, got %s from %s to %s",
+				i, w.effective, w.from, w.to, iv.Decimal(iv.Effective), iv.From, iv.To)
+		}
+	}
+
+	recent, err := history.Execute(ctx, list_price_history.Request{ProductID: "p1", Currency: "EUR", Since: start.Add(30 * time.Minute)})
+	if err != nil || len(recent.Intervals) != 2 || !recent.Intervals[0].From.Equal(start) {
+		t.Fatalf("expected the discounted interval in effect at since and the one after it, got %+v, %v", recent, err)
+	}
+
+	// Paging one interval at a time walks the same list
+	req := list_price_history.Request{ProductID: "p1", PageSize: 1}
+	var tokens []string
+	for i, w := range want {
+		page, err := history.Execute(ctx, req)
+		if err != nil || len(page.Intervals) != 1 || !page.Intervals[0].From.Equal(w.from) {
+			t.Fatalf("page %d: expected the interval from %s, got %+v, %v", i, w.from, page, err)
+		}
+		if (page.NextPageToken == "") != (i == len(want)-1) {
+			t.Fatalf("page %d: unexpected next page token %q", i, page.NextPageToken)
+		}
+		req.PageToken = page.NextPageToken
+		tokens = append(tokens, page.NextPageToken)
+	}
+	req.Currency, req.PageToken = "EUR", tokens[0]
+	if _, err := history.Execute(ctx, req); !errors.Is(err, pagetoken.ErrInvalid) {
+		t.Fatalf("expected a token from another filter to be rejected, got %v", err)
+	}
+}
+
+func TestReadModel_ReportsLowestPriceOverLookback(t *testing.T) {
+	e := newEnv()
+	ctx := context.Background()
+	created := e.clock.Now()
+	e.create(t, "p1", "books")
+
+	if err := activate_product.New(e.products, e.outbox, e.comm, e.clock).Execute(ctx, activate_product.Request{ProductID: "p1"}); err != nil {
+		t.Fatalf("activate: %v", err)
+	}
+	start := created.AddDate(0, 0, 1)
+	err := apply_discount.New(e.products, e.outbox, e.history, e.comm, e.clock).Execute(ctx, apply_discount.Request{
+		ProductID: "p1", DiscountID: "d1", Percent: big.NewRat(10, 1), Start: start, End: start.AddDate(0, 0, 1),
+	})
+	if err != nil {
+		t.Fatalf("apply discount: %v", err)
+	}
+
+	// The sweeper never ran, so only the schedule knows about the window.
+	e.clock.t = created.AddDate(0, 0, 3)
+	dto, err := e.reads.GetProduct(ctx, "p1", "", "", contracts.PriceListSelector{})
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if dto.LowestPrice != "180.00" || dto.LowestPriceNum != 180 || dto.LowestPriceDen != 1 || dto.LowestPriceDays != 30 {
+		t.Fatalf("expected lowest 180.00 over 30 days, got %q (%d/%d) over %d", dto.LowestPrice, dto.LowestPriceNum, dto.LowestPriceDen, dto.LowestPriceDays)
+	}
+
+	e.clock.t = start.AddDate(0, 0, 31)
+	res, err := e.reads.ListProducts(ctx, contracts.ListProductsFilter{})
+	if err != nil || len(res.Items) != 1 {
+		t.Fatalf("list: %+v, %v", res, err)
+	}
+	if got := res.Items[0].LowestPrice; got != "200.00" {
+		t.Fatalf("expected the discount to have left the window, got lowest %q", got)
+	}
+
+	rate := set_exchange_rate.Request{From: "EUR", To: "USD", Rate: big.NewRat(12, 10), EffectiveFrom: created}
+	if err := set_exchange_rate.New(NewExchangeRateRepo(e.store, e.clock), e.comm, e.clock).Execute(ctx, rate); err != nil {
+		t.Fatalf("set rate: %v", err)
+	}
+	if dto, err = e.reads.GetProduct(ctx, "p1", "USD", "", contracts.PriceListSelector{}); err != nil || dto.Currency != "USD" || dto.LowestPrice != "" {
+		t.Fatalf("expected no lowest price for a converted price, got %q in %s, %v", dto.LowestPrice, dto.Currency, err)
+	}
+}
+
+func TestProductRepo_StaleUpdate_ReturnsConcurrentModification(t *testing.T) {
+	e := newEnv()
+	ctx := context.Background()
+	e.create(t, "p1", "books")
+
+	stale, err := e.products.GetByID(ctx, "p1")
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+
+	if err := update_product.New(e.products, e.outbox, e.comm, e.clock).Execute(ctx, update_product.Request{ProductID: "p1", Name: "Other", Category: "books"}); err != nil {
+		t.Fatalf("update: %v", err)
+	}
+
+	if err := stale.UpdateDetails("Stale", "", "books", e.clock.Now()); err != nil {
+		t.Fatalf("update details: %v", err)
+	}
+	plan := committer.NewPlan()
+	plan.Add(e.products.UpdateMut(stale))
+	if err := e.comm.Apply(ctx, plan); !errors.Is(err, committer.ErrConcurrentModification) {
+		t.Fatalf("expected ErrConcurrentModification, got %v", err)
+	}
+}
+
+func TestReadModel_GetMissing_ReturnsNotFound(t *testing.T) {
+	e := newEnv()
+	if _, err := e.reads.GetProduct(context.Background(), "nope", "", "", contracts.PriceListSelector{}); !errors.Is(err, repo.ErrProductNotFound) {
+		t.Fatalf("expected ErrProductNotFound, got %v", err)
+	}
+}
+
+func TestReadModel_ListProducts_Paginates(t *testing.T) {
+	e := newEnv()
+	ctx := context.Background()
+	for _, id := range []string{"a", "b", "c", "d", "e"} {
+		e.create(t, id, "books")
+	}
+	e.create(t, "x", "games")
+
+	var got []string
+	token := ""
+	for {
+		res, err := e.reads.ListProducts(ctx, contracts.ListProductsFilter{Category: "books", Limit: 2, PageToken: token})
+		if err != nil {
+			t.Fatalf("list: %v", err)
+		}
+		for _, it := range res.Items {
+			got = append(got, it.ID)
+		}
+		if res.NextPageToken == "" {
+			break
+		}
+		token = res.NextPageToken
+	}
+
+	want := []string{"e", "d", "c", "b", "a"}
+	if len(got) != len(want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("expected %v, got %v", want, got)
+		}
+	}
+}
+
+func TestOutboxStore_ClaimLeasesRows(t *testing.T) {
+	e := newEnv()
+	ctx := context.Background()
+	e.create(t, "p1", "books")
+
+	s := NewOutboxStore(e.store, e.clock)
+	msgs, err := s.Claim(ctx, 10, e.clock.Now().Add(time.Minute))
+	if err != nil {
+		t.Fatalf("claim: %v", err)
+	}
+	if len(msgs) != 1 || msgs[0].EventType != "product.created" {
+		t.Fatalf("expected product.created claimed, got %v", msgs)
+	}
+
+	again, err := s.Claim(ctx, 10, e.clock.Now().Add(time.Minute))
+	if err != nil {
+		t.Fatalf("claim: %v", err)
+	}
+	if len(again) != 0 {
+		t.Fatalf("expected leased row not to be claimed twice, got %d", len(again))
+	}
+
+	if err := s.MarkPublished(ctx, msgs[0].EventID); err != nil {
+		t.Fatalf("mark published: %v", err)
+	}
+	e.clock.t = e.clock.t.Add(time.Hour)
+	if again, _ := s.Claim(ctx, 10, e.clock.Now().Add(time.Minute)); len(again) != 0 {
+		t.Fatalf("expected published row not to be claimed, got %d", len(again))
+	}
+}
+
+func TestPriceHistoryRepo_RecordMut_UnstorableAmountFailsCommit(t *testing.T) {
+	e := newEnv()
+	ctx := context.Background()
+
+	base, _ := domain.NewMoneyFromFraction(1, 3)
+	huge := base.Mul(big.NewRat(1<<62, 1)).Mul(big.NewRat(1<<62, 1))
+	plan := committer.NewPlan()
+	plan.Add(e.history.RecordMut(&domain.PriceRecord{ProductID: "p1", Currency: "EUR", Base: base, Effective: huge, From: e.clock.Now()}))
+	if err := e.comm.Apply(ctx, plan); !errors.Is(err, domain.ErrMoneyOverflow) {
+		t.Fatalf("expected ErrMoneyOverflow, got %v", err)
+	}
+
+	if recs, err := e.history.List(ctx, "p1", ""); err != nil || len(recs) != 0 {
+		t.Fatalf("expected nothing recorded, got %v (err %v)", recs, err)
+	}
+}
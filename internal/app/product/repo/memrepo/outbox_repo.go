@@ -0,0 +1,30 @@
+package memrepo
+
+import (
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/infra/memstore"
+	"product-catalog-service/internal/models/m_outbox"
+	"product-catalog-service/internal/pkg/clock"
+)
+
+type OutboxRepo struct {
+	clock clock.Clock
+}
+
+func NewOutboxRepo(clk clock.Clock) *OutboxRepo {
+	return &OutboxRepo{clock: clk}
+}
+
+func (r *OutboxRepo) InsertMut(eventID, eventType, aggregateID string, payload []byte) contracts.Mutation {
+	return memstore.Insert(m_outbox.Table, memstore.Key(eventID), memstore.Row{
+		m_outbox.EventID:     eventID,
+		m_outbox.EventType:   eventType,
+		m_outbox.AggregateID: aggregateID,
+		m_outbox.Payload:     append([]byte(nil), payload...),
+		m_outbox.Status:      m_outbox.StatusNew,
+		m_outbox.CreatedAt:   r.clock.Now(),
+		m_outbox.Attempts:    int64(0),
+	})
+}
+
+var _ contracts.OutboxRepo = (*OutboxRepo)(nil)
@@ -0,0 +1,109 @@
+package memrepo
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/infra/memstore"
+	"product-catalog-service/internal/models/m_outbox"
+	"product-catalog-service/internal/pkg/clock"
+)
+
+type OutboxStore struct {
+	store *memstore.Store
+	clock clock.Clock
+}
+
+func NewOutboxStore(store *memstore.Store, clk clock.Clock) *OutboxStore {
+	return &OutboxStore{store: store, clock: clk}
+}
+
+func (s *OutboxStore) Claim(ctx context.Context, limit int, leaseUntil time.Time) ([]contracts.OutboxMessage, error) {
+	var out []contracts.OutboxMessage
+
+	err := s.store.Atomically(func(snap *memstore.Snapshot) ([]memstore.Mutation, error) {
+		now := s.clock.Now()
+
+		var due []memstore.Row
+		for _, row := range snap.Rows(m_outbox.Table) {
+			if claimable(row, now) {
+				due = append(due, row)
+			}
+		}
+		sort.Slice(due, func(i, j int) bool {
+			return due[i][m_outbox.CreatedAt].(time.Time).Before(due[j][m_outbox.CreatedAt].(time.Time))
+		})
+		if len(due) > limit {
+			due = due[:limit]
+		}
+
+		muts := make([]memstore.Mutation, 0, len(due))
+		for _, row := range due {
+			attempts, _ := row[m_outbox.Attempts].(int64)
+			out = append(out, contracts.OutboxMessage{
+				EventID:     row[m_outbox.EventID].(string),
+				EventType:   row[m_outbox.EventType].(string),
+				AggregateID: row[m_outbox.AggregateID].(string),
+				Payload:     row[m_outbox.Payload].([]byte),
+				CreatedAt:   row[m_outbox.CreatedAt].(time.Time),
+				Attempts:    attempts,
+			})
+			muts = append(muts, memstore.Update(m_outbox.Table, memstore.Key(row[m_outbox.EventID]), memstore.Row{
+				m_outbox.Status:      m_outbox.StatusProcessing,
+				m_outbox.LockedUntil: leaseUntil,
+			}))
+		}
+		return muts, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func claimable(row memstore.Row, now time.Time) bool {
+	switch row[m_outbox.Status] {
+	case m_outbox.StatusNew:
+		return true
+	case m_outbox.StatusFailed:
+		t, ok := row[m_outbox.NextAttemptAt].(time.Time)
+		return ok && !t.After(now)
+	case m_outbox.StatusProcessing:
+		t, ok := row[m_outbox.LockedUntil].(time.Time)
+		return ok && !t.After(now)
+	}
+	return false
+}
+
+func (s *OutboxStore) MarkPublished(ctx context.Context, eventID string) error {
+	return s.update(eventID, memstore.Row{
+		m_outbox.Status:      m_outbox.StatusPublished,
+		m_outbox.ProcessedAt: s.clock.Now(),
+		m_outbox.LockedUntil: nil,
+		m_outbox.LastError:   nil,
+	})
+}
+
+func (s *OutboxStore) MarkFailed(ctx context.Context, eventID string, attempts int64, nextAttemptAt *time.Time, lastErr string) error {
+	row := memstore.Row{
+		m_outbox.Status:        m_outbox.StatusFailed,
+		m_outbox.Attempts:      attempts,
+		m_outbox.NextAttemptAt: nil,
+		m_outbox.LockedUntil:   nil,
+		m_outbox.LastError:     lastErr,
+	}
+	if nextAttemptAt != nil {
+		row[m_outbox.NextAttemptAt] = *nextAttemptAt
+	}
+	return s.update(eventID, row)
+}
+
+func (s *OutboxStore) update(eventID string, row memstore.Row) error {
+	return s.store.Atomically(func(*memstore.Snapshot) ([]memstore.Mutation, error) {
+		return []memstore.Mutation{memstore.Update(m_outbox.Table, memstore.Key(eventID), row)}, nil
+	})
+}
+
+var _ contracts.OutboxStore = (*OutboxStore)(nil)
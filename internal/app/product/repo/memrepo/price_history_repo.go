@@ -0,0 +1,137 @@
+package memrepo
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/infra/memstore"
+	"product-catalog-service/internal/models/m_price_history"
+	"product-catalog-service/internal/pkg/clock"
+)
+
+type PriceHistoryRepo struct {
+	store *memstore.Store
+	clock clock.Clock
+}
+
+func NewPriceHistoryRepo(store *memstore.Store, clk clock.Clock) *PriceHistoryRepo {
+	return &PriceHistoryRepo{store: store, clock: clk}
+}
+
+func (r *PriceHistoryRepo) List(ctx context.Context, productID string, currency domain.Currency) ([]*domain.PriceRecord, error) {
+	var out []*domain.PriceRecord
+	for _, rec := range historyByProduct(r.store.Snapshot())[productID] {
+		if currency == "" || rec.Currency == currency {
+			out = append(out, rec)
+		}
+	}
+	return out, nil
+}
+
+func (r *PriceHistoryRepo) ListPage(ctx context.Context, f contracts.PriceHistoryFilter) ([]*domain.PriceRecord, error) {
+	recs := historyByProduct(r.store.Snapshot())[f.ProductID]
+	// The record each currency was at by f.Since; the ones before it are
+	// left out.
+	starts := map[domain.Currency]time.Time{}
+	if !f.Since.IsZero() {
+		for _, rec := range recs {
+			if !rec.From.After(f.Since) {
+				starts[rec.Currency] = rec.From
+			}
+		}
+	}
+
+	var out []*domain.PriceRecord
+	for _, rec := range recs {
+		if f.Currency != "" && rec.Currency != f.Currency {
+			continue
+		}
+		if start, ok := starts[rec.Currency]; ok && rec.From.Before(start) {
+			continue
+		}
+		if !f.AfterFrom.IsZero() && (rec.Currency < f.AfterCurrency || rec.Currency == f.AfterCurrency && !rec.From.After(f.AfterFrom)) {
+			continue
+		}
+		if len(out) == f.Limit {
+			break
+		}
+		out = append(out, rec)
+	}
+	return out, nil
+}
+
+func (r *PriceHistoryRepo) Latest(ctx context.Context, productID string) (map[domain.Currency]*domain.PriceRecord, error) {
+	out := map[domain.Currency]*domain.PriceRecord{}
+	for _, rec := range historyByProduct(r.store.Snapshot())[productID] {
+		out[rec.Currency] = rec
+	}
+	return out, nil
+}
+
+// historyByProduct returns the history of every product, ordered by
+// currency then oldest first.
+func historyByProduct(snap *memstore.Snapshot) map[string][]*domain.PriceRecord {
+	out := map[string][]*domain.PriceRecord{}
+	for _, row := range snap.Rows(m_price_history.Table) {
+		rec := &domain.PriceRecord{
+			ProductID: row[m_price_history.ProductID].(string),
+			Currency:  domain.Currency(row[m_price_history.Currency].(string)),
+			From:      row[m_price_history.ValidFrom].(time.Time),
+		}
+		if effNum, ok := row[m_price_history.EffectiveNum].(int64); ok {
+			base, err := domain.NewMoneyFromFractionIn(row[m_price_history.BaseNum].(int64), row[m_price_history.BaseDen].(int64), rec.Currency)
+			if err != nil {
+				continue
+			}
+			eff, err := domain.NewMoneyFromFractionIn(effNum, row[m_price_history.EffectiveDen].(int64), rec.Currency)
+			if err != nil {
+				continue
+			}
+			rec.Base, rec.Effective = base, eff
+		}
+		out[rec.ProductID] = append(out[rec.ProductID], rec)
+	}
+	for _, recs := range out {
+		sort.Slice(recs, func(i, j int) bool {
+			if recs[i].Currency != recs[j].Currency {
+				return recs[i].Currency < recs[j].Currency
+			}
+			return recs[i].From.Before(recs[j].From)
+		})
+	}
+	return out
+}
+
+func (r *PriceHistoryRepo) RecordMut(rec *domain.PriceRecord) contracts.Mutation {
+	row := memstore.Row{
+		m_price_history.ProductID:    rec.ProductID,
+		m_price_history.Currency:     string(rec.Currency),
+		m_price_history.ValidFrom:    rec.From,
+		m_price_history.BaseNum:      nil,
+		m_price_history.BaseDen:      nil,
+		m_price_history.EffectiveNum: nil,
+		m_price_history.EffectiveDen: nil,
+		m_price_history.RecordedAt:   r.clock.Now(),
+	}
+	if rec.Priced() {
+		baseNum, baseDen, err := fractionOf(rec.Base)
+		if err != nil {
+			return memstore.Failed(err)
+		}
+		effNum, effDen, err := fractionOf(rec.Effective)
+		if err != nil {
+			return memstore.Failed(err)
+		}
+		row[m_price_history.BaseNum] = baseNum
+		row[m_price_history.BaseDen] = baseDen
+		row[m_price_history.EffectiveNum] = effNum
+		row[m_price_history.EffectiveDen] = effDen
+	}
+	key := memstore.Key(rec.ProductID, string(rec.Currency), rec.From.Format(time.RFC3339Nano))
+	return memstore.InsertOrUpdate(m_price_history.Table, key, row)
+}
+
+var _ contracts.PriceHistoryRepo = (*PriceHistoryRepo)(nil)
@@ -0,0 +1,208 @@
+package memrepo
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/app/product/repo"
+	"product-catalog-service/internal/infra/memstore"
+	"product-catalog-service/internal/models/m_price_list"
+	"product-catalog-service/internal/models/m_price_list_entry"
+	"product-catalog-service/internal/pkg/clock"
+)
+
+type PriceListRepo struct {
+	store *memstore.Store
+	clock clock.Clock
+}
+
+func NewPriceListRepo(store *memstore.Store, clk clock.Clock) *PriceListRepo {
+	return &PriceListRepo{store: store, clock: clk}
+}
+
+func (r *PriceListRepo) GetByID(ctx context.Context, id string) (*domain.PriceList, error) {
+	lists, err := priceLists(r.store.Snapshot(), contracts.PriceListSelector{ID: id})
+	if err != nil {
+		return nil, err
+	}
+	return lists[0], nil
+}
+
+// Select ignores productIDs; the lists come with all their entries.
+func (r *PriceListRepo) Select(ctx context.Context, sel contracts.PriceListSelector, productIDs []string) ([]*domain.PriceList, error) {
+	return priceLists(r.store.Snapshot(), sel)
+}
+
+func (r *PriceListRepo) InsertMut(l *domain.PriceList) contracts.Mutation {
+	now := r.clock.Now()
+
+	batch := memstore.Batch{memstore.Insert(m_price_list.Table, memstore.Key(l.ID()), memstore.Row{
+		m_price_list.PriceListID:   l.ID(),
+		m_price_list.Name:          l.Name(),
+		m_price_list.CustomerGroup: string(l.CustomerGroup()),
+		m_price_list.Priority:      l.Priority(),
+		m_price_list.ValidFrom:     timeOrNil(l.ValidFrom()),
+		m_price_list.ValidTo:       timeOrNil(l.ValidTo()),
+		m_price_list.CreatedAt:     now,
+		m_price_list.UpdatedAt:     now,
+		m_price_list.Version:       l.Version() + 1,
+	})}
+	batch = append(batch, entryMuts(l.ID(), nil, l.Entries(), now)...)
+	if len(batch) == 1 {
+		return batch[0]
+	}
+	return batch
+}
+
+func (r *PriceListRepo) UpdateMut(l *domain.PriceList) contracts.Mutation {
+	ch := l.Changes()
+
+	updates := memstore.Row{}
+	if ch.Dirty(domain.FieldName) {
+		updates[m_price_list.Name] = l.Name()
+	}
+	if ch.Dirty(domain.FieldPriority) {
+		updates[m_price_list.Priority] = l.Priority()
+	}
+	if ch.Dirty(domain.FieldValidity) {
+		updates[m_price_list.ValidFrom] = timeOrNil(l.ValidFrom())
+		updates[m_price_list.ValidTo] = timeOrNil(l.ValidTo())
+	}
+	var children []memstore.Mutation
+	if c, ok := ch.Change(domain.FieldEntries); ok {
+		old, _ := c.Old.([]*domain.PriceListEntry)
+		children = entryMuts(l.ID(), old, l.Entries(), r.clock.Now())
+	}
+
+	if len(updates) == 0 && len(children) == 0 {
+		return nil
+	}
+	updates[m_price_list.UpdatedAt] = r.clock.Now()
+	updates[m_price_list.Version] = l.Version() + 1
+
+	m := memstore.UpdateGuarded(m_price_list.Table, memstore.Key(l.ID()), updates, memstore.VersionGuard{
+		Column:  m_price_list.Version,
+		Version: l.Version(),
+	})
+	if len(children) == 0 {
+		return m
+	}
+	return append(memstore.Batch{m}, children...)
+}
+
+// priceLists returns the lists sel picks, with all their entries. A list
+// picked by ID must exist; no selection picks nothing.
+func priceLists(snap *memstore.Snapshot, sel contracts.PriceListSelector) ([]*domain.PriceList, error) {
+	var rows []memstore.Row
+	switch {
+	case sel.ID != "":
+		row, ok := snap.Get(m_price_list.Table, memstore.Key(sel.ID))
+		if !ok {
+			return nil, repo.ErrPriceListNotFound
+		}
+		rows = append(rows, row)
+	case sel.CustomerGroup != "":
+		for _, row := range snap.Rows(m_price_list.Table) {
+			if row[m_price_list.CustomerGroup] == sel.CustomerGroup {
+				rows = append(rows, row)
+			}
+		}
+	default:
+		return nil, nil
+	}
+
+	entries := map[string][]*domain.PriceListEntry{}
+	for _, row := range snap.Rows(m_price_list_entry.Table) {
+		price, err := domain.NewMoneyFromFractionIn(
+			row[m_price_list_entry.PriceNum].(int64),
+			row[m_price_list_entry.PriceDen].(int64),
+			domain.Currency(row[m_price_list_entry.Currency].(string)),
+		)
+		if err != nil {
+			continue
+		}
+		e, err := domain.NewPriceListEntry(row[m_price_list_entry.ProductID].(string), price)
+		if err != nil {
+			continue
+		}
+		listID := row[m_price_list_entry.PriceListID].(string)
+		entries[listID] = append(entries[listID], e)
+	}
+
+	out := make([]*domain.PriceList, 0, len(rows))
+	for _, row := range rows {
+		id := row[m_price_list.PriceListID].(string)
+		var from, to time.Time
+		if t := timeCol(row, m_price_list.ValidFrom); t != nil {
+			from = *t
+		}
+		if t := timeCol(row, m_price_list.ValidTo); t != nil {
+			to = *t
+		}
+		out = append(out, domain.HydratePriceList(
+			id,
+			row[m_price_list.Name].(string),
+			domain.CustomerGroup(row[m_price_list.CustomerGroup].(string)),
+			row[m_price_list.Priority].(int64),
+			from, to,
+			entries[id],
+			row[m_price_list.Version].(int64),
+		))
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].ID() < out[j].ID() })
+	return out, nil
+}
+
+func entryMuts(listID string, old, new []*domain.PriceListEntry, now time.Time) []memstore.Mutation {
+	type key struct {
+		productID string
+		currency  domain.Currency
+	}
+	stored := map[key]*domain.PriceListEntry{}
+	for _, e := range old {
+		stored[key{e.ProductID(), e.Price().Currency()}] = e
+	}
+
+	var muts []memstore.Mutation
+	for _, e := range new {
+		k := key{e.ProductID(), e.Price().Currency()}
+		prev, ok := stored[k]
+		delete(stored, k)
+		num, den, err := fractionOf(e.Price())
+		if err != nil {
+			return []memstore.Mutation{memstore.Failed(err)}
+		}
+		row := memstore.Row{
+			m_price_list_entry.PriceNum:  num,
+			m_price_list_entry.PriceDen:  den,
+			m_price_list_entry.UpdatedAt: now,
+		}
+		switch {
+		case !ok:
+			row[m_price_list_entry.PriceListID] = listID
+			row[m_price_list_entry.ProductID] = e.ProductID()
+			row[m_price_list_entry.Currency] = string(e.Price().Currency())
+			row[m_price_list_entry.CreatedAt] = now
+			muts = append(muts, memstore.Insert(m_price_list_entry.Table, memstore.Key(listID, e.ProductID(), string(e.Price().Currency())), row))
+		case prev.Price().Rat().Cmp(e.Price().Rat()) != 0:
+			muts = append(muts, memstore.Update(m_price_list_entry.Table, memstore.Key(listID, e.ProductID(), string(e.Price().Currency())), row))
+		}
+	}
+	for k := range stored {
+		muts = append(muts, memstore.Delete(m_price_list_entry.Table, memstore.Key(listID, k.productID, string(k.currency))))
+	}
+	return muts
+}
+
+// timeOrNil stores an open validity bound as nil.
+func timeOrNil(t time.Time) interface{} {
+	if t.IsZero() {
+		return nil
+	}
+	return t
+}
+
+var _ contracts.PriceListRepo = (*PriceListRepo)(nil)
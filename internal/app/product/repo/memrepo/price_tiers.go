@@ -0,0 +1,82 @@
+package memrepo
+
+import (
+	"sort"
+	"time"
+
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/infra/memstore"
+	"product-catalog-service/internal/models/m_price_tier"
+)
+
+// priceTiersByProduct loads every stored price tier, keyed by product.
+func priceTiersByProduct(snap *memstore.Snapshot) map[string][]*domain.PriceTier {
+	out := map[string][]*domain.PriceTier{}
+	for _, row := range snap.Rows(m_price_tier.Table) {
+		price, err := domain.NewMoneyFromFractionIn(
+			row[m_price_tier.PriceNum].(int64),
+			row[m_price_tier.PriceDen].(int64),
+			domain.Currency(row[m_price_tier.Currency].(string)),
+		)
+		if err != nil {
+			continue
+		}
+		maxQty, _ := row[m_price_tier.MaxQuantity].(int64)
+		t, err := domain.NewPriceTier(row[m_price_tier.MinQuantity].(int64), maxQty, price)
+		if err != nil {
+			continue
+		}
+		productID := row[m_price_tier.ProductID].(string)
+		out[productID] = append(out[productID], t)
+	}
+	for _, ts := range out {
+		sort.Slice(ts, func(i, j int) bool { return ts[i].MinQuantity() < ts[j].MinQuantity() })
+	}
+	return out
+}
+
+func priceTierMuts(productID string, old, new []*domain.PriceTier, now time.Time) []memstore.Mutation {
+	stored := map[int64]*domain.PriceTier{}
+	for _, t := range old {
+		stored[t.MinQuantity()] = t
+	}
+
+	var muts []memstore.Mutation
+	for _, t := range new {
+		key := memstore.Key(productID, t.MinQuantity())
+		prev, ok := stored[t.MinQuantity()]
+		delete(stored, t.MinQuantity())
+		num, den, err := fractionOf(t.UnitPrice())
+		if err != nil {
+			return []memstore.Mutation{memstore.Failed(err)}
+		}
+		row := memstore.Row{
+			m_price_tier.MaxQuantity: maxQuantityOf(t),
+			m_price_tier.Currency:    string(t.UnitPrice().Currency()),
+			m_price_tier.PriceNum:    num,
+			m_price_tier.PriceDen:    den,
+			m_price_tier.UpdatedAt:   now,
+		}
+		switch {
+		case !ok:
+			row[m_price_tier.ProductID] = productID
+			row[m_price_tier.MinQuantity] = t.MinQuantity()
+			row[m_price_tier.CreatedAt] = now
+			muts = append(muts, memstore.Insert(m_price_tier.Table, key, row))
+		case prev.MaxQuantity() != t.MaxQuantity() || prev.UnitPrice().Rat().Cmp(t.UnitPrice().Rat()) != 0:
+			muts = append(muts, memstore.Update(m_price_tier.Table, key, row))
+		}
+	}
+	for minQty := range stored {
+		muts = append(muts, memstore.Delete(m_price_tier.Table, memstore.Key(productID, minQty)))
+	}
+	return muts
+}
+
+// maxQuantityOf stores the open-ended last tier's bound as nil.
+func maxQuantityOf(t *domain.PriceTier) interface{} {
+	if t.MaxQuantity() == 0 {
+		return nil
+	}
+	return t.MaxQuantity()
+}
@@ -0,0 +1,79 @@
+package memrepo
+
+import (
+	"sort"
+	"time"
+
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/infra/memstore"
+	"product-catalog-service/internal/models/m_price"
+)
+
+// pricesByProduct loads every stored non-base price, keyed by product.
+func pricesByProduct(snap *memstore.Snapshot) map[string][]*domain.Money {
+	out := map[string][]*domain.Money{}
+	for _, row := range snap.Rows(m_price.Table) {
+		m, err := domain.NewMoneyFromFractionIn(
+			row[m_price.PriceNum].(int64),
+			row[m_price.PriceDen].(int64),
+			domain.Currency(row[m_price.Currency].(string)),
+		)
+		if err != nil {
+			continue
+		}
+		productID := row[m_price.ProductID].(string)
+		out[productID] = append(out[productID], m)
+	}
+	for _, ms := range out {
+		sort.Slice(ms, func(i, j int) bool { return ms[i].Currency() < ms[j].Currency() })
+	}
+	return out
+}
+
+func priceMuts(productID string, old, new []*domain.Money, now time.Time) []memstore.Mutation {
+	stored := map[domain.Currency]*domain.Money{}
+	for _, m := range old {
+		stored[m.Currency()] = m
+	}
+
+	var muts []memstore.Mutation
+	for _, m := range new {
+		key := memstore.Key(productID, string(m.Currency()))
+		prev, ok := stored[m.Currency()]
+		delete(stored, m.Currency())
+		num, den, err := fractionOf(m)
+		if err != nil {
+			return []memstore.Mutation{memstore.Failed(err)}
+		}
+		switch {
+		case !ok:
+			muts = append(muts, memstore.Insert(m_price.Table, key, memstore.Row{
+				m_price.ProductID: productID,
+				m_price.Currency:  string(m.Currency()),
+				m_price.PriceNum:  num,
+				m_price.PriceDen:  den,
+				m_price.CreatedAt: now,
+				m_price.UpdatedAt: now,
+			}))
+		case prev.Rat().Cmp(m.Rat()) != 0:
+			muts = append(muts, memstore.Update(m_price.Table, key, memstore.Row{
+				m_price.PriceNum:  num,
+				m_price.PriceDen:  den,
+				m_price.UpdatedAt: now,
+			}))
+		}
+	}
+	for c := range stored {
+		muts = append(muts, memstore.Delete(m_price.Table, memstore.Key(productID, string(c))))
+	}
+	return muts
+}
+
+// currencyCol reads a currency column, taking rows written before prices
+// had a currency to be in DefaultCurrency.
+func currencyCol(row memstore.Row, col string) domain.Currency {
+	if c, ok := row[col].(string); ok {
+		return domain.Currency(c)
+	}
+	return domain.DefaultCurrency
+}
@@ -0,0 +1,234 @@
+package memrepo
+
+import (
+	"context"
+	"time"
+
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/app/product/repo"
+	"product-catalog-service/internal/infra/memstore"
+	"product-catalog-service/internal/models/m_product"
+	"product-catalog-service/internal/pkg/clock"
+)
+
+type ProductRepo struct {
+	store *memstore.Store
+	clock clock.Clock
+}
+
+func NewProductRepo(store *memstore.Store, clk clock.Clock) *ProductRepo {
+	return &ProductRepo{store: store, clock: clk}
+}
+
+func (r *ProductRepo) GetByID(ctx context.Context, id string) (*domain.Product, error) {
+	snap := r.store.Snapshot()
+	row, ok := snap.Get(m_product.Table, memstore.Key(id))
+	if !ok {
+		return nil, repo.ErrProductNotFound
+	}
+
+	base, err := domain.NewMoneyFromFractionIn(
+		row[m_product.BasePriceNumerator].(int64),
+		row[m_product.BasePriceDenominator].(int64),
+		currencyCol(row, m_product.BasePriceCurrency),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	version, _ := row[m_product.Version].(int64)
+
+	return domain.HydrateProduct(domain.HydrateProductParams{
+		ID:          row[m_product.ProductID].(string),
+		Name:        row[m_product.Name].(string),
+		Description: stringCol(row, m_product.Description),
+		Category:    row[m_product.Category].(string),
+		SKU:         stringCol(row, m_product.SKU),
+		GTIN:        domain.GTIN(stringCol(row, m_product.GTIN)),
+		TaxClass:    domain.TaxClass(stringCol(row, m_product.TaxClass)),
+		BasePrice:   base,
+		Prices:      pricesByProduct(snap)[id],
+		PriceTiers:  priceTiersByProduct(snap)[id],
+		Variants:    variantsByProduct(snap)[id],
+		Discounts:   discountsByProduct(snap)[id],
+		Status:      domain.ProductStatus(row[m_product.Status].(string)),
+		ArchivedAt:  timeCol(row, m_product.ArchivedAt),
+		Version:     version,
+	}), nil
+}
+
+// SKUOwner returns the ID of the product that uses sku, as its own SKU or a
+// variant's, or "" when none does.
+func (r *ProductRepo) SKUOwner(ctx context.Context, sku string) (string, error) {
+	return skuOwner(r.store.Snapshot(), sku), nil
+}
+
+// GTINOwner returns the ID of the product with gtin, or "" when none has it.
+func (r *ProductRepo) GTINOwner(ctx context.Context, gtin domain.GTIN) (string, error) {
+	return gtinOwner(r.store.Snapshot(), gtin), nil
+}
+
+func (r *ProductRepo) InsertMut(p *domain.Product) contracts.Mutation {
+	now := r.clock.Now()
+	baseNum, baseDen, err := fractionOf(p.BasePrice())
+	if err != nil {
+		return memstore.Failed(err)
+	}
+
+	row := memstore.Row{
+		m_product.ProductID:            p.ID(),
+		m_product.Name:                 p.Name(),
+		m_product.Description:          p.Description(),
+		m_product.Category:             p.Category(),
+		m_product.SKU:                  nilIfEmpty(p.SKU()),
+		m_product.GTIN:                 nilIfEmpty(string(p.GTIN())),
+		m_product.TaxClass:             taxClassOf(p),
+		m_product.BasePriceNumerator:   baseNum,
+		m_product.BasePriceDenominator: baseDen,
+		m_product.BasePriceCurrency:    string(p.BasePrice().Currency()),
+		m_product.Status:               string(p.Status()),
+		m_product.CreatedAt:            now,
+		m_product.UpdatedAt:            now,
+		m_product.ArchivedAt:           nil,
+		m_product.Version:              p.Version() + 1,
+	}
+
+	batch := memstore.Batch{memstore.Insert(m_product.Table, memstore.Key(p.ID()), row)}
+	batch = append(batch, priceMuts(p.ID(), nil, p.Prices()[1:], now)...)
+	batch = append(batch, priceTierMuts(p.ID(), nil, p.PriceTiers(), now)...)
+	batch = append(batch, variantMuts(p.ID(), nil, p.Variants(), now)...)
+	batch = append(batch, skuMuts(p.ID(), nil, skusOf(p.SKU(), p.Variants()), now)...)
+	batch = append(batch, discountMuts(p.ID(), nil, p.Discounts(), now)...)
+	if len(batch) == 1 {
+		return batch[0]
+	}
+	return batch
+}
+
+func (r *ProductRepo) UpdateMut(p *domain.Product) contracts.Mutation {
+	ch := p.Changes()
+
+	updates := memstore.Row{}
+
+	if ch.Dirty(domain.FieldName) {
+		updates[m_product.Name] = p.Name()
+	}
+	if ch.Dirty(domain.FieldDescription) {
+		updates[m_product.Description] = p.Description()
+	}
+	if ch.Dirty(domain.FieldCategory) {
+		updates[m_product.Category] = p.Category()
+	}
+	if ch.Dirty(domain.FieldSKU) {
+		updates[m_product.SKU] = nilIfEmpty(p.SKU())
+	}
+	if ch.Dirty(domain.FieldGTIN) {
+		updates[m_product.GTIN] = nilIfEmpty(string(p.GTIN()))
+	}
+	if ch.Dirty(domain.FieldTaxClass) {
+		updates[m_product.TaxClass] = taxClassOf(p)
+	}
+	if ch.Dirty(domain.FieldStatus) {
+		updates[m_product.Status] = string(p.Status())
+	}
+	if ch.Dirty(domain.FieldBasePrice) {
+		num, den, err := fractionOf(p.BasePrice())
+		if err != nil {
+			return memstore.Failed(err)
+		}
+		updates[m_product.BasePriceNumerator] = num
+		updates[m_product.BasePriceDenominator] = den
+		updates[m_product.BasePriceCurrency] = string(p.BasePrice().Currency())
+	}
+	if ch.Dirty(domain.FieldArchivedAt) {
+		if t := p.ArchivedAt(); t != nil {
+			updates[m_product.ArchivedAt] = *t
+		} else {
+			updates[m_product.ArchivedAt] = nil
+		}
+	}
+
+	var children []memstore.Mutation
+	if c, ok := ch.Change(domain.FieldPrices); ok {
+		old, _ := c.Old.([]*domain.Money)
+		children = priceMuts(p.ID(), old, p.Prices()[1:], r.clock.Now())
+	}
+	if c, ok := ch.Change(domain.FieldPriceTiers); ok {
+		old, _ := c.Old.([]*domain.PriceTier)
+		children = append(children, priceTierMuts(p.ID(), old, p.PriceTiers(), r.clock.Now())...)
+	}
+	if c, ok := ch.Change(domain.FieldVariants); ok {
+		old, _ := c.Old.([]*domain.Variant)
+		children = append(children, variantMuts(p.ID(), old, p.Variants(), r.clock.Now())...)
+	}
+	if ch.Dirty(domain.FieldSKU) || ch.Dirty(domain.FieldVariants) {
+		oldSKU, oldVariants := p.SKU(), p.Variants()
+		if c, ok := ch.Change(domain.FieldSKU); ok {
+			oldSKU, _ = c.Old.(string)
+		}
+		if c, ok := ch.Change(domain.FieldVariants); ok {
+			oldVariants, _ = c.Old.([]*domain.Variant)
+		}
+		children = append(children, skuMuts(p.ID(), skusOf(oldSKU, oldVariants), skusOf(p.SKU(), p.Variants()), r.clock.Now())...)
+	}
+	if c, ok := ch.Change(domain.FieldDiscounts); ok {
+		old, _ := c.Old.([]*domain.Discount)
+		children = append(children, discountMuts(p.ID(), old, p.Discounts(), r.clock.Now())...)
+	}
+
+	if len(updates) == 0 && len(children) == 0 {
+		return nil
+	}
+	updates[m_product.UpdatedAt] = r.clock.Now()
+	updates[m_product.Version] = p.Version() + 1
+
+	m := memstore.UpdateGuarded(m_product.Table, memstore.Key(p.ID()), updates, memstore.VersionGuard{
+		Column:  m_product.Version,
+		Version: p.Version(),
+	})
+	if len(children) == 0 {
+		return m
+	}
+	return append(memstore.Batch{m}, children...)
+}
+
+// taxClassOf stores a product without a class of its own as nil.
+func taxClassOf(p *domain.Product) interface{} {
+	if p.TaxClass() == "" {
+		return nil
+	}
+	return string(p.TaxClass())
+}
+
+// fractionOf is m as the numerator and denominator it is stored as,
+// failing rather than truncating an amount that does not fit in them.
+func fractionOf(m *domain.Money) (num, den int64, err error) {
+	num, den, ok := m.Fraction()
+	if !ok {
+		return 0, 0, domain.ErrMoneyOverflow
+	}
+	return num, den, nil
+}
+
+func nilIfEmpty(s string) interface{} {
+	if s == "" {
+		return nil
+	}
+	return s
+}
+
+func stringCol(row memstore.Row, col string) string {
+	s, _ := row[col].(string)
+	return s
+}
+
+func timeCol(row memstore.Row, col string) *time.Time {
+	t, ok := row[col].(time.Time)
+	if !ok {
+		return nil
+	}
+	return &t
+}
+
+var _ contracts.ProductRepo = (*ProductRepo)(nil)
@@ -0,0 +1,259 @@
+package memrepo
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/app/product/domain/services"
+	"product-catalog-service/internal/app/product/repo"
+	"product-catalog-service/internal/infra/memstore"
+	"product-catalog-service/internal/models/m_product"
+	"product-catalog-service/internal/pkg/clock"
+	"product-catalog-service/internal/pkg/pagetoken"
+)
+
+type ReadModel struct {
+	store  *memstore.Store
+	clock  clock.Clock
+	tokens *pagetoken.Codec
+	conv   *services.CurrencyConverter
+	days   int
+	mode   domain.TaxMode
+}
+
+// NewReadModel reports the lowest price over the lowestPriceDays days
+// before each read, and splits tax taking stored prices to be as taxMode
+// says.
+func NewReadModel(store *memstore.Store, clk clock.Clock, tokens *pagetoken.Codec, conv *services.CurrencyConverter, lowestPriceDays int, taxMode domain.TaxMode) *ReadModel {
+	return &ReadModel{store: store, clock: clk, tokens: tokens, conv: conv, days: lowestPriceDays, mode: taxMode}
+}
+
+func (r *ReadModel) GetProduct(ctx context.Context, id, currency, region string, lists contracts.PriceListSelector) (contracts.ProductDTO, error) {
+	snap := r.store.Snapshot()
+	row, ok := snap.Get(m_product.Table, memstore.Key(id))
+	if !ok {
+		return contracts.ProductDTO{}, repo.ErrProductNotFound
+	}
+	rel, err := r.load(snap, currency, region, lists)
+	if err != nil {
+		return contracts.ProductDTO{}, err
+	}
+	return r.mapRowToDTO(row, rel, currency)
+}
+
+func (r *ReadModel) GetProductBySKU(ctx context.Context, sku, currency, region string, lists contracts.PriceListSelector) (contracts.ProductDTO, error) {
+	id := skuOwner(r.store.Snapshot(), sku)
+	if id == "" {
+		return contracts.ProductDTO{}, repo.ErrProductNotFound
+	}
+	return r.GetProduct(ctx, id, currency, region, lists)
+}
+
+func (r *ReadModel) GetProductByGTIN(ctx context.Context, gtin, currency, region string, lists contracts.PriceListSelector) (contracts.ProductDTO, error) {
+	id := gtinOwner(r.store.Snapshot(), domain.GTIN(gtin))
+	if id == "" {
+		return contracts.ProductDTO{}, repo.ErrProductNotFound
+	}
+	return r.GetProduct(ctx, id, currency, region, lists)
+}
+
+func (r *ReadModel) BatchGetProducts(ctx context.Context, ids []string, currency, region string, lists contracts.PriceListSelector) ([]contracts.ProductDTO, error) {
+	snap := r.store.Snapshot()
+	rel, err := r.load(snap, currency, region, lists)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]contracts.ProductDTO, 0, len(ids))
+	for _, id := range ids {
+		row, ok := snap.Get(m_product.Table, memstore.Key(id))
+		if !ok {
+			return nil, repo.ErrProductNotFound
+		}
+		dto, err := r.mapRowToDTO(row, rel, currency)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, dto)
+	}
+	return out, nil
+}
+
+// related is what a page of products is priced from besides their rows.
+type related struct {
+	prices     map[string][]*domain.Money
+	tiers      map[string][]*domain.PriceTier
+	variants   map[string][]*domain.Variant
+	lists      []*domain.PriceList
+	discounts  map[string][]*domain.Discount
+	history    map[string][]*domain.PriceRecord
+	rates      map[domain.Currency]*domain.ExchangeRate
+	taxRates   map[domain.TaxClass]*domain.TaxRate
+	taxClasses map[string]domain.TaxClass
+}
+
+func (r *ReadModel) load(snap *memstore.Snapshot, currency, region string, lists contracts.PriceListSelector) (related, error) {
+	pl, err := priceLists(snap, lists)
+	if err != nil {
+		return related{}, err
+	}
+	rel := related{
+		prices:    pricesByProduct(snap),
+		tiers:     priceTiersByProduct(snap),
+		variants:  variantsByProduct(snap),
+		lists:     pl,
+		discounts: discountsByProduct(snap),
+		history:   historyByProduct(snap),
+		rates:     r.rates(snap, currency),
+	}
+	if region != "" {
+		rel.taxRates = taxRatesIn(snap, domain.Region(region))
+		rel.taxClasses = categoryTaxClasses(snap)
+	}
+	return rel, nil
+}
+
+type listCursor struct {
+	CreatedAt  time.Time `json:"c"`
+	ProductID  string    `json:"p"`
+	Category   string    `json:"cat,omitempty"`
+	OnlyActive bool      `json:"act,omitempty"`
+}
+
+func (r *ReadModel) ListProducts(ctx context.Context, f contracts.ListProductsFilter) (contracts.ListProductsResult, error) {
+	var cur *listCursor
+	if f.PageToken != "" {
+		var c listCursor
+		if err := r.tokens.Decode(f.PageToken, &c); err != nil {
+			return contracts.ListProductsResult{}, err
+		}
+		if c.Category != f.Category || c.OnlyActive != f.OnlyActive {
+			return contracts.ListProductsResult{}, pagetoken.ErrInvalid
+		}
+		cur = &c
+	}
+
+	snap := r.store.Snapshot()
+	rel, err := r.load(snap, f.Currency, f.Region, f.PriceLists)
+	if err != nil {
+		return contracts.ListProductsResult{}, err
+	}
+
+	var rows []memstore.Row
+	for _, row := range snap.Rows(m_product.Table) {
+		if row[m_product.ArchivedAt] != nil {
+			continue
+		}
+		if f.Category != "" && row[m_product.Category] != f.Category {
+			continue
+		}
+		if f.OnlyActive && row[m_product.Status] != "active" {
+			continue
+		}
+		if cur != nil && !listedAfter(row, cur.CreatedAt, cur.ProductID) {
+			continue
+		}
+		rows = append(rows, row)
+	}
+
+	sort.Slice(rows, func(i, j int) bool {
+		return listedAfter(rows[j], rows[i][m_product.CreatedAt].(time.Time), rows[i][m_product.ProductID].(string))
+	})
+
+	pageSize := contracts.PageSize(f.Limit)
+
+	var res contracts.ListProductsResult
+	for i, row := range rows {
+		if i == pageSize {
+			last := rows[i-1]
+			tok, err := r.tokens.Encode(listCursor{
+				CreatedAt:  last[m_product.CreatedAt].(time.Time),
+				ProductID:  last[m_product.ProductID].(string),
+				Category:   f.Category,
+				OnlyActive: f.OnlyActive,
+			})
+			if err != nil {
+				return contracts.ListProductsResult{}, err
+			}
+			res.NextPageToken = tok
+			break
+		}
+		dto, err := r.mapRowToDTO(row, rel, f.Currency)
+		if err != nil {
+			return contracts.ListProductsResult{}, err
+		}
+		res.Items = append(res.Items, dto)
+	}
+	return res, nil
+}
+
+// listedAfter reports whether row comes after the (createdAt, productID)
+// position in listing order, i.e. created_at DESC, product_id DESC.
+func listedAfter(row memstore.Row, createdAt time.Time, productID string) bool {
+	c := row[m_product.CreatedAt].(time.Time)
+	if !c.Equal(createdAt) {
+		return c.Before(createdAt)
+	}
+	return row[m_product.ProductID].(string) < productID
+}
+
+func (r *ReadModel) rates(snap *memstore.Snapshot, currency string) map[domain.Currency]*domain.ExchangeRate {
+	if currency == "" {
+		return nil
+	}
+	return ratesTo(snap, domain.Currency(currency), r.clock.Now())
+}
+
+func (r *ReadModel) mapRowToDTO(row memstore.Row, rel related, currency string) (contracts.ProductDTO, error) {
+	baseNum := row[m_product.BasePriceNumerator].(int64)
+	baseDen := row[m_product.BasePriceDenominator].(int64)
+	version, _ := row[m_product.Version].(int64)
+
+	dto := contracts.ProductDTO{
+		ID:           row[m_product.ProductID].(string),
+		Name:         row[m_product.Name].(string),
+		Description:  stringCol(row, m_product.Description),
+		Category:     row[m_product.Category].(string),
+		SKU:          stringCol(row, m_product.SKU),
+		GTIN:         stringCol(row, m_product.GTIN),
+		TaxClass:     stringCol(row, m_product.TaxClass),
+		Status:       row[m_product.Status].(string),
+		BasePriceNum: baseNum,
+		BasePriceDen: baseDen,
+		CreatedAt:    row[m_product.CreatedAt].(time.Time).Format(time.RFC3339),
+		UpdatedAt:    row[m_product.UpdatedAt].(time.Time).Format(time.RFC3339),
+		Version:      version,
+	}
+
+	if t := timeCol(row, m_product.ArchivedAt); t != nil {
+		dto.ArchivedAt = t.Format(time.RFC3339)
+	}
+
+	base, err := domain.NewMoneyFromFractionIn(baseNum, baseDen, currencyCol(row, m_product.BasePriceCurrency))
+	if err != nil {
+		return contracts.ProductDTO{}, err
+	}
+	now := r.clock.Now()
+	all := repo.ListPrices(&dto, append([]*domain.Money{base}, rel.prices[dto.ID]...), rel.lists, now)
+	price, err := repo.PriceIn(r.conv, all, domain.Currency(currency), rel.rates)
+	if err != nil {
+		return contracts.ProductDTO{}, err
+	}
+	b, err := repo.FillPricing(&dto, all, price, rel.discounts[dto.ID], now, r.conv.Rounding())
+	if err != nil {
+		return contracts.ProductDTO{}, err
+	}
+	repo.FillPriceTiers(&dto, rel.tiers[dto.ID], r.conv.Rounding())
+	repo.FillVariants(&dto, rel.variants[dto.ID], r.conv.Rounding())
+	if err := repo.FillLowestPrice(&dto, rel.history[dto.ID], rel.discounts[dto.ID], now, r.days, r.conv.Rounding()); err != nil {
+		return contracts.ProductDTO{}, err
+	}
+	if err := repo.FillTax(&dto, b.Effective, rel.taxClasses[dto.Category], rel.taxRates, r.mode, r.conv.Rounding()); err != nil {
+		return contracts.ProductDTO{}, err
+	}
+	return dto, nil
+}
+
+var _ contracts.ProductReadModel = (*ReadModel)(nil)
@@ -0,0 +1,87 @@
+package memrepo
+
+import (
+	"context"
+	"math/big"
+
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/infra/memstore"
+	"product-catalog-service/internal/models/m_category_tax_class"
+	"product-catalog-service/internal/models/m_tax_rate"
+	"product-catalog-service/internal/pkg/clock"
+)
+
+type TaxRepo struct {
+	store *memstore.Store
+	clock clock.Clock
+}
+
+func NewTaxRepo(store *memstore.Store, clk clock.Clock) *TaxRepo {
+	return &TaxRepo{store: store, clock: clk}
+}
+
+func (r *TaxRepo) Rate(ctx context.Context, region domain.Region, class domain.TaxClass) (*domain.TaxRate, error) {
+	rate, ok := taxRatesIn(r.store.Snapshot(), region)[class]
+	if !ok {
+		return nil, domain.ErrTaxRateNotFound
+	}
+	return rate, nil
+}
+
+func (r *TaxRepo) CategoryClass(ctx context.Context, category string) (domain.TaxClass, error) {
+	return categoryTaxClasses(r.store.Snapshot())[category], nil
+}
+
+func (r *TaxRepo) UpsertRateMut(rate *domain.TaxRate) contracts.Mutation {
+	key := memstore.Key(string(rate.Region()), string(rate.Class()))
+	return memstore.InsertOrUpdate(m_tax_rate.Table, key, memstore.Row{
+		m_tax_rate.Region:    string(rate.Region()),
+		m_tax_rate.TaxClass:  string(rate.Class()),
+		m_tax_rate.RateNum:   rate.Rate().Num().Int64(),
+		m_tax_rate.RateDen:   rate.Rate().Denom().Int64(),
+		m_tax_rate.UpdatedAt: r.clock.Now(),
+	})
+}
+
+func (r *TaxRepo) AssignCategoryMut(category string, class domain.TaxClass) contracts.Mutation {
+	key := memstore.Key(category)
+	if class == "" {
+		return memstore.Delete(m_category_tax_class.Table, key)
+	}
+	return memstore.InsertOrUpdate(m_category_tax_class.Table, key, memstore.Row{
+		m_category_tax_class.Category:  category,
+		m_category_tax_class.TaxClass:  string(class),
+		m_category_tax_class.UpdatedAt: r.clock.Now(),
+	})
+}
+
+// taxRatesIn returns region's rate for every class it has one for.
+func taxRatesIn(snap *memstore.Snapshot, region domain.Region) map[domain.TaxClass]*domain.TaxRate {
+	out := map[domain.TaxClass]*domain.TaxRate{}
+	for _, row := range snap.Rows(m_tax_rate.Table) {
+		if row[m_tax_rate.Region] != string(region) {
+			continue
+		}
+		rate, err := domain.NewTaxRate(
+			region,
+			domain.TaxClass(row[m_tax_rate.TaxClass].(string)),
+			big.NewRat(row[m_tax_rate.RateNum].(int64), row[m_tax_rate.RateDen].(int64)),
+		)
+		if err != nil {
+			continue
+		}
+		out[rate.Class()] = rate
+	}
+	return out
+}
+
+func categoryTaxClasses(snap *memstore.Snapshot) map[string]domain.TaxClass {
+	out := map[string]domain.TaxClass{}
+	for _, row := range snap.Rows(m_category_tax_class.Table) {
+		out[row[m_category_tax_class.Category].(string)] = domain.TaxClass(row[m_category_tax_class.TaxClass].(string))
+	}
+	return out
+}
+
+var _ contracts.TaxRepo = (*TaxRepo)(nil)
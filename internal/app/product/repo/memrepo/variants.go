@@ -0,0 +1,96 @@
+package memrepo
+
+import (
+	"sort"
+	"time"
+
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/infra/memstore"
+	"product-catalog-service/internal/models/m_product_variant"
+)
+
+// variantsByProduct loads every stored variant, keyed by product.
+func variantsByProduct(snap *memstore.Snapshot) map[string][]*domain.Variant {
+	out := map[string][]*domain.Variant{}
+	for _, row := range snap.Rows(m_product_variant.Table) {
+		var price *domain.Money
+		if num, ok := row[m_product_variant.PriceNum].(int64); ok {
+			m, err := domain.NewMoneyFromFractionIn(
+				num,
+				row[m_product_variant.PriceDen].(int64),
+				domain.Currency(row[m_product_variant.Currency].(string)),
+			)
+			if err != nil {
+				continue
+			}
+			price = m
+		}
+		attrs, _ := row[m_product_variant.Attributes].(map[string]string)
+		v, err := domain.NewVariant(
+			row[m_product_variant.VariantID].(string),
+			row[m_product_variant.SKU].(string),
+			attrs,
+			price,
+			domain.VariantStatus(row[m_product_variant.Status].(string)),
+		)
+		if err != nil {
+			continue
+		}
+		productID := row[m_product_variant.ProductID].(string)
+		out[productID] = append(out[productID], v)
+	}
+	for _, vs := range out {
+		sort.Slice(vs, func(i, j int) bool { return vs[i].SKU() < vs[j].SKU() })
+	}
+	return out
+}
+
+// variantMuts diffs two sets of variants, keyed by ID. The product replaces
+// a variant rather than changing it, so an unchanged variant is the same
+// value in both sets.
+func variantMuts(productID string, old, new []*domain.Variant, now time.Time) []memstore.Mutation {
+	stored := map[string]*domain.Variant{}
+	for _, v := range old {
+		stored[v.ID()] = v
+	}
+
+	var muts []memstore.Mutation
+	for _, v := range new {
+		key := memstore.Key(productID, v.ID())
+		prev, ok := stored[v.ID()]
+		delete(stored, v.ID())
+		if prev == v {
+			continue
+		}
+		row := memstore.Row{
+			m_product_variant.SKU:        v.SKU(),
+			m_product_variant.Attributes: v.Attributes(),
+			m_product_variant.Currency:   nil,
+			m_product_variant.PriceNum:   nil,
+			m_product_variant.PriceDen:   nil,
+			m_product_variant.Status:     string(v.Status()),
+			m_product_variant.UpdatedAt:  now,
+		}
+		if p := v.Price(); p != nil {
+			num, den, err := fractionOf(p)
+			if err != nil {
+				return []memstore.Mutation{memstore.Failed(err)}
+			}
+			row[m_product_variant.Currency] = string(p.Currency())
+			row[m_product_variant.PriceNum] = num
+			row[m_product_variant.PriceDen] = den
+		}
+		if !ok {
+			row[m_product_variant.ProductID] = productID
+			row[m_product_variant.VariantID] = v.ID()
+			row[m_product_variant.CreatedAt] = now
+			muts = append(muts, memstore.Insert(m_product_variant.Table, key, row))
+		} else {
+			muts = append(muts, memstore.Update(m_product_variant.Table, key, row))
+		}
+	}
+	for id := range stored {
+		muts = append(muts, memstore.Delete(m_product_variant.Table, memstore.Key(productID, id)))
+	}
+	return muts
+}
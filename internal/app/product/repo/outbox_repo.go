@@ -26,8 +26,9 @@ func (r *OutboxRepo) InsertMut(eventID, eventType, aggregateID string, payload [
 		m_outbox.EventType:   eventType,
 		m_outbox.AggregateID: aggregateID,
 		m_outbox.Payload:     spanner.NullJSON{Value: string(payload), Valid: true},
-		m_outbox.Status:      "NEW",
+		m_outbox.Status:      m_outbox.StatusNew,
 		m_outbox.CreatedAt:   r.clock.Now(),
+		m_outbox.Attempts:    int64(0),
 	}
 
 	return spannerx.Wrap(r.model.InsertMut(row))
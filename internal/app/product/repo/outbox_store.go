@@ -0,0 +1,117 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/api/iterator"
+
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/models/m_outbox"
+	"product-catalog-service/internal/pkg/clock"
+)
+
+type OutboxStore struct {
+	client *spanner.Client
+	model  m_outbox.Model
+	clock  clock.Clock
+}
+
+func NewOutboxStore(client *spanner.Client, clk clock.Clock) *OutboxStore {
+	return &OutboxStore{client: client, model: m_outbox.Model{}, clock: clk}
+}
+
+func (s *OutboxStore) Claim(ctx context.Context, limit int, leaseUntil time.Time) ([]contracts.OutboxMessage, error) {
+	var out []contracts.OutboxMessage
+
+	_, err := s.client.ReadWriteTransaction(ctx, func(ctx context.Context, tx *spanner.ReadWriteTransaction) error {
+		out = out[:0]
+
+		st := spanner.NewStatement(`
+			SELECT event_id, event_type, aggregate_id, payload, created_at, attempts
+			FROM outbox_events@{FORCE_INDEX=idx_outbox_status}
+			WHERE status = @new
+			   OR (status = @failed AND next_attempt_at <= @now)
+			   OR (status = @processing AND locked_until <= @now)
+			ORDER BY created_at
+			LIMIT @limit
+		`)
+		st.Params["new"] = m_outbox.StatusNew
+		st.Params["failed"] = m_outbox.StatusFailed
+		st.Params["processing"] = m_outbox.StatusProcessing
+		st.Params["now"] = s.clock.Now()
+		st.Params["limit"] = int64(limit)
+
+		iter := tx.Query(ctx, st)
+		defer iter.Stop()
+
+		var muts []*spanner.Mutation
+		for {
+			row, err := iter.Next()
+			if err == iterator.Done {
+				break
+			}
+			if err != nil {
+				return err
+			}
+
+			var (
+				msg      contracts.OutboxMessage
+				payload  spanner.NullJSON
+				attempts spanner.NullInt64
+			)
+			if err := row.Columns(&msg.EventID, &msg.EventType, &msg.AggregateID, &payload, &msg.CreatedAt, &attempts); err != nil {
+				return err
+			}
+			msg.Payload = []byte(payload.String())
+			msg.Attempts = attempts.Int64
+			out = append(out, msg)
+
+			muts = append(muts, s.model.UpdateMut(map[string]interface{}{
+				m_outbox.EventID:     msg.EventID,
+				m_outbox.Status:      m_outbox.StatusProcessing,
+				m_outbox.LockedUntil: leaseUntil,
+			}))
+		}
+
+		if len(muts) == 0 {
+			return nil
+		}
+		return tx.BufferWrite(muts)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (s *OutboxStore) MarkPublished(ctx context.Context, eventID string) error {
+	_, err := s.client.Apply(ctx, []*spanner.Mutation{s.model.UpdateMut(map[string]interface{}{
+		m_outbox.EventID:     eventID,
+		m_outbox.Status:      m_outbox.StatusPublished,
+		m_outbox.ProcessedAt: s.clock.Now(),
+		m_outbox.LockedUntil: spanner.NullTime{Valid: false},
+		m_outbox.LastError:   spanner.NullString{Valid: false},
+	})})
+	return err
+}
+
+func (s *OutboxStore) MarkFailed(ctx context.Context, eventID string, attempts int64, nextAttemptAt *time.Time, lastErr string) error {
+	row := map[string]interface{}{
+		m_outbox.EventID:       eventID,
+		m_outbox.Status:        m_outbox.StatusFailed,
+		m_outbox.Attempts:      attempts,
+		m_outbox.NextAttemptAt: spanner.NullTime{Valid: false},
+		m_outbox.LockedUntil:   spanner.NullTime{Valid: false},
+		m_outbox.LastError:     lastErr,
+	}
+	if nextAttemptAt != nil {
+		row[m_outbox.NextAttemptAt] = *nextAttemptAt
+	}
+
+	_, err := s.client.Apply(ctx, []*spanner.Mutation{s.model.UpdateMut(row)})
+	return err
+}
+
+var _ contracts.OutboxStore = (*OutboxStore)(nil)
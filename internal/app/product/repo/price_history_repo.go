@@ -0,0 +1,355 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/api/iterator"
+
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/infra/spannerx"
+	"product-catalog-service/internal/models/m_price_history"
+	"product-catalog-service/internal/pkg/clock"
+)
+
+type PriceHistoryRepo struct {
+	client *spanner.Client
+	model  m_price_history.Model
+	clock  clock.Clock
+}
+
+func NewPriceHistoryRepo(client *spanner.Client, clk clock.Clock) *PriceHistoryRepo {
+	return &PriceHistoryRepo{client: client, model: m_price_history.Model{}, clock: clk}
+}
+
+func (r *PriceHistoryRepo) List(ctx context.Context, productID string, currency domain.Currency) ([]*domain.PriceRecord, error) {
+	byProduct, err := readPriceHistory(ctx, r.client.Single(), []string{productID}, currency)
+	if err != nil {
+		return nil, err
+	}
+	return byProduct[productID], nil
+}
+
+// ListPage pages through the product's history by its primary key, so a
+// page reads no more rows than it returns. With f.Since set it first finds
+// the record each currency was at then.
+func (r *PriceHistoryRepo) ListPage(ctx context.Context, f contracts.PriceHistoryFilter) ([]*domain.PriceRecord, error) {
+	tx := r.client.ReadOnlyTransaction()
+	defer tx.Close()
+
+	query := `
+		SELECT ` + historyColumns + `
+		FROM price_history
+		WHERE product_id = @id
+	`
+	params := map[string]interface{}{"id": f.ProductID}
+	if f.Currency != "" {
+		query += " AND currency = @currency"
+		params["currency"] = string(f.Currency)
+	}
+	if !f.Since.IsZero() {
+		starts, err := readHistoryStarts(ctx, tx, f.ProductID, f.Currency, f.Since)
+		if err != nil {
+			return nil, err
+		}
+		cond := "valid_from > @since"
+		params["since"] = f.Since
+		i := 0
+		for cur, from := range starts {
+			c, v := fmt.Sprintf("start_currency_%d", i), fmt.Sprintf("start_from_%d", i)
+			cond += fmt.Sprintf(" OR (currency = @%s AND valid_from = @%s)", c, v)
+			params[c], params[v] = string(cur), from
+			i++
+		}
+		query += " AND (" + cond + ")"
+	}
+	if !f.AfterFrom.IsZero() {
+		query += " AND (currency > @after_currency OR (currency = @after_currency AND valid_from > @after_from))"
+		params["after_currency"] = string(f.AfterCurrency)
+		params["after_from"] = f.AfterFrom
+	}
+	query += " ORDER BY currency, valid_from LIMIT @limit"
+	params["limit"] = int64(f.Limit)
+
+	st := spanner.NewStatement(query)
+	st.Params = params
+
+	iter := tx.Query(ctx, st)
+	defer iter.Stop()
+
+	var out []*domain.PriceRecord
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			return out, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		rec, err := scanPriceRecord(row)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, rec)
+	}
+}
+
+// Latest finds when each currency last changed, then reads just those
+// rows by key.
+func (r *PriceHistoryRepo) Latest(ctx context.Context, productID string) (map[domain.Currency]*domain.PriceRecord, error) {
+	tx := r.client.ReadOnlyTransaction()
+	defer tx.Close()
+
+	st := spanner.NewStatement(`
+		SELECT currency, MAX(valid_from)
+		FROM price_history
+		WHERE product_id = @id
+		GROUP BY currency
+	`)
+	st.Params["id"] = productID
+
+	var keys []spanner.KeySet
+	err := tx.Query(ctx, st).Do(func(row *spanner.Row) error {
+		var (
+			cur  string
+			from time.Time
+		)
+		if err := row.Columns(&cur, &from); err != nil {
+			return err
+		}
+		keys = append(keys, spanner.Key{productID, cur, from})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(map[domain.Currency]*domain.PriceRecord, len(keys))
+	if len(keys) == 0 {
+		return out, nil
+	}
+	err = tx.Read(ctx, m_price_history.Table, spanner.KeySets(keys...), historyCols).Do(func(row *spanner.Row) error {
+		rec, err := scanPriceRecord(row)
+		if err != nil {
+			return err
+		}
+		out[rec.Currency] = rec
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// readHistoryStarts returns, for each of the product's currencies, or just
+// currency when it is set, when the last record from at or before since
+// took effect.
+func readHistoryStarts(ctx context.Context, tx *spanner.ReadOnlyTransaction, productID string, currency domain.Currency, since time.Time) (map[domain.Currency]time.Time, error) {
+	query := `
+		SELECT currency, MAX(valid_from)
+		FROM price_history
+		WHERE product_id = @id AND valid_from <= @since
+	`
+	params := map[string]interface{}{"id": productID, "since": since}
+	if currency != "" {
+		query += " AND currency = @currency"
+		params["currency"] = string(currency)
+	}
+	st := spanner.NewStatement(query + " GROUP BY currency")
+	st.Params = params
+
+	out := map[domain.Currency]time.Time{}
+	err := tx.Query(ctx, st).Do(func(row *spanner.Row) error {
+		var (
+			cur  string
+			from time.Time
+		)
+		if err := row.Columns(&cur, &from); err != nil {
+			return err
+		}
+		out[domain.Currency(cur)] = from
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+var (
+	historyCols = []string{
+		m_price_history.ProductID, m_price_history.Currency, m_price_history.ValidFrom,
+		m_price_history.BaseNum, m_price_history.BaseDen, m_price_history.EffectiveNum, m_price_history.EffectiveDen,
+	}
+	historyColumns = strings.Join(historyCols, ", ")
+)
+
+// readPriceWindow returns the history of each product in currency from
+// the record in effect at from onwards, oldest first.
+func readPriceWindow(ctx context.Context, tx *spanner.ReadOnlyTransaction, productIDs []string, currency domain.Currency, from time.Time) (map[string][]*domain.PriceRecord, error) {
+	out := make(map[string][]*domain.PriceRecord, len(productIDs))
+	if len(productIDs) == 0 {
+		return out, nil
+	}
+
+	st := spanner.NewStatement(`
+		SELECT product_id, MAX(valid_from)
+		FROM price_history
+		WHERE product_id IN UNNEST(@ids) AND currency = @currency AND valid_from < @from
+		GROUP BY product_id
+	`)
+	st.Params["ids"] = productIDs
+	st.Params["currency"] = string(currency)
+	st.Params["from"] = from
+
+	var keys []spanner.KeySet
+	err := tx.Query(ctx, st).Do(func(row *spanner.Row) error {
+		var (
+			id    string
+			start time.Time
+		)
+		if err := row.Columns(&id, &start); err != nil {
+			return err
+		}
+		keys = append(keys, spanner.Key{id, string(currency), start})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(keys) > 0 {
+		err = tx.Read(ctx, m_price_history.Table, spanner.KeySets(keys...), historyCols).Do(func(row *spanner.Row) error {
+			rec, err := scanPriceRecord(row)
+			if err != nil {
+				return err
+			}
+			out[rec.ProductID] = append(out[rec.ProductID], rec)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	st = spanner.NewStatement(`
+		SELECT ` + historyColumns + `
+		FROM price_history
+		WHERE product_id IN UNNEST(@ids) AND currency = @currency AND valid_from >= @from
+		ORDER BY product_id, valid_from
+	`)
+	st.Params["ids"] = productIDs
+	st.Params["currency"] = string(currency)
+	st.Params["from"] = from
+	err = tx.Query(ctx, st).Do(func(row *spanner.Row) error {
+		rec, err := scanPriceRecord(row)
+		if err != nil {
+			return err
+		}
+		out[rec.ProductID] = append(out[rec.ProductID], rec)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// readPriceHistory returns the history of each product, ordered by currency
+// then oldest first, in currency alone unless it is empty.
+func readPriceHistory(ctx context.Context, tx *spanner.ReadOnlyTransaction, productIDs []string, currency domain.Currency) (map[string][]*domain.PriceRecord, error) {
+	out := make(map[string][]*domain.PriceRecord, len(productIDs))
+	if len(productIDs) == 0 {
+		return out, nil
+	}
+
+	query := `
+		SELECT ` + historyColumns + `
+		FROM price_history
+		WHERE product_id IN UNNEST(@ids)
+	`
+	params := map[string]interface{}{"ids": productIDs}
+	if currency != "" {
+		query += " AND currency = @currency"
+		params["currency"] = string(currency)
+	}
+	query += " ORDER BY product_id, currency, valid_from"
+
+	st := spanner.NewStatement(query)
+	st.Params = params
+
+	iter := tx.Query(ctx, st)
+	defer iter.Stop()
+
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			return out, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		rec, err := scanPriceRecord(row)
+		if err != nil {
+			return nil, err
+		}
+		out[rec.ProductID] = append(out[rec.ProductID], rec)
+	}
+}
+
+// scanPriceRecord reads a row of historyCols.
+func scanPriceRecord(row *spanner.Row) (*domain.PriceRecord, error) {
+	var (
+		productID, cur                   string
+		from                             time.Time
+		baseNum, baseDen, effNum, effDen spanner.NullInt64
+	)
+	if err := row.Columns(&productID, &cur, &from, &baseNum, &baseDen, &effNum, &effDen); err != nil {
+		return nil, err
+	}
+	rec := &domain.PriceRecord{ProductID: productID, Currency: domain.Currency(cur), From: from}
+	if effNum.Valid {
+		var err error
+		if rec.Base, err = domain.NewMoneyFromFractionIn(baseNum.Int64, baseDen.Int64, rec.Currency); err != nil {
+			return nil, err
+		}
+		if rec.Effective, err = domain.NewMoneyFromFractionIn(effNum.Int64, effDen.Int64, rec.Currency); err != nil {
+			return nil, err
+		}
+	}
+	return rec, nil
+}
+
+func (r *PriceHistoryRepo) RecordMut(rec *domain.PriceRecord) contracts.Mutation {
+	row := map[string]interface{}{
+		m_price_history.ProductID:    rec.ProductID,
+		m_price_history.Currency:     string(rec.Currency),
+		m_price_history.ValidFrom:    rec.From,
+		m_price_history.BaseNum:      spanner.NullInt64{},
+		m_price_history.BaseDen:      spanner.NullInt64{},
+		m_price_history.EffectiveNum: spanner.NullInt64{},
+		m_price_history.EffectiveDen: spanner.NullInt64{},
+		m_price_history.RecordedAt:   r.clock.Now(),
+	}
+	if rec.Priced() {
+		baseNum, baseDen, err := fractionOf(rec.Base)
+		if err != nil {
+			return spannerx.Failed(err)
+		}
+		effNum, effDen, err := fractionOf(rec.Effective)
+		if err != nil {
+			return spannerx.Failed(err)
+		}
+		row[m_price_history.BaseNum] = baseNum
+		row[m_price_history.BaseDen] = baseDen
+		row[m_price_history.EffectiveNum] = effNum
+		row[m_price_history.EffectiveDen] = effDen
+	}
+	return spannerx.Wrap(r.model.InsertOrUpdateMut(row))
+}
+
+var _ contracts.PriceHistoryRepo = (*PriceHistoryRepo)(nil)
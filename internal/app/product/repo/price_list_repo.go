@@ -0,0 +1,298 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/api/iterator"
+
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/infra/spannerx"
+	"product-catalog-service/internal/models/m_price_list"
+	"product-catalog-service/internal/models/m_price_list_entry"
+	"product-catalog-service/internal/pkg/clock"
+)
+
+var ErrPriceListNotFound = errors.New("price list not found")
+
+type PriceListRepo struct {
+	client  *spanner.Client
+	model   m_price_list.Model
+	entries m_price_list_entry.Model
+	clock   clock.Clock
+}
+
+func NewPriceListRepo(client *spanner.Client, clk clock.Clock) *PriceListRepo {
+	return &PriceListRepo{client: client, model: m_price_list.Model{}, entries: m_price_list_entry.Model{}, clock: clk}
+}
+
+func (r *PriceListRepo) GetByID(ctx context.Context, id string) (*domain.PriceList, error) {
+	tx := r.client.ReadOnlyTransaction()
+	defer tx.Close()
+
+	lists, err := readPriceLists(ctx, tx, contracts.PriceListSelector{ID: id}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return lists[0], nil
+}
+
+func (r *PriceListRepo) Select(ctx context.Context, sel contracts.PriceListSelector, productIDs []string) ([]*domain.PriceList, error) {
+	tx := r.client.ReadOnlyTransaction()
+	defer tx.Close()
+	return readPriceLists(ctx, tx, sel, productIDs)
+}
+
+func (r *PriceListRepo) InsertMut(l *domain.PriceList) contracts.Mutation {
+	now := r.clock.Now()
+	entries, err := entryMuts(r.entries, l.ID(), nil, l.Entries(), now)
+	if err != nil {
+		return spannerx.Failed(err)
+	}
+
+	batch := spannerx.Batch{{M: r.model.InsertMut(map[string]interface{}{
+		m_price_list.PriceListID:   l.ID(),
+		m_price_list.Name:          l.Name(),
+		m_price_list.CustomerGroup: string(l.CustomerGroup()),
+		m_price_list.Priority:      l.Priority(),
+		m_price_list.ValidFrom:     nullTimeOf(l.ValidFrom()),
+		m_price_list.ValidTo:       nullTimeOf(l.ValidTo()),
+		m_price_list.CreatedAt:     now,
+		m_price_list.UpdatedAt:     now,
+		m_price_list.Version:       l.Version() + 1,
+	})}}
+	for _, m := range entries {
+		batch = append(batch, spannerx.Mutation{M: m})
+	}
+	if len(batch) == 1 {
+		return batch[0]
+	}
+	return batch
+}
+
+func (r *PriceListRepo) UpdateMut(l *domain.PriceList) contracts.Mutation {
+	ch := l.Changes()
+
+	updates := map[string]interface{}{
+		m_price_list.PriceListID: l.ID(),
+	}
+	if ch.Dirty(domain.FieldName) {
+		updates[m_price_list.Name] = l.Name()
+	}
+	if ch.Dirty(domain.FieldPriority) {
+		updates[m_price_list.Priority] = l.Priority()
+	}
+	if ch.Dirty(domain.FieldValidity) {
+		updates[m_price_list.ValidFrom] = nullTimeOf(l.ValidFrom())
+		updates[m_price_list.ValidTo] = nullTimeOf(l.ValidTo())
+	}
+	var children []*spanner.Mutation
+	if c, ok := ch.Change(domain.FieldEntries); ok {
+		old, _ := c.Old.([]*domain.PriceListEntry)
+		muts, err := entryMuts(r.entries, l.ID(), old, l.Entries(), r.clock.Now())
+		if err != nil {
+			return spannerx.Failed(err)
+		}
+		children = muts
+	}
+
+	if len(updates) == 1 && len(children) == 0 {
+		return nil
+	}
+	updates[m_price_list.UpdatedAt] = r.clock.Now()
+	updates[m_price_list.Version] = l.Version() + 1
+
+	m := spannerx.WrapGuarded(r.model.UpdateMut(updates), spannerx.VersionGuard{
+		Table:   m_price_list.Table,
+		Key:     spanner.Key{l.ID()},
+		Column:  m_price_list.Version,
+		Version: l.Version(),
+	})
+	if len(children) == 0 {
+		return m
+	}
+	batch := spannerx.Batch{m.(spannerx.Mutation)}
+	for _, c := range children {
+		batch = append(batch, spannerx.Mutation{M: c})
+	}
+	return batch
+}
+
+// readPriceLists loads the lists sel picks with their entries for
+// productIDs, or with all their entries when productIDs is nil. A list
+// picked by ID must exist; no selection loads nothing.
+func readPriceLists(ctx context.Context, tx *spanner.ReadOnlyTransaction, sel contracts.PriceListSelector, productIDs []string) ([]*domain.PriceList, error) {
+	var st spanner.Statement
+	switch {
+	case sel.ID != "":
+		st = spanner.NewStatement(`
+			SELECT price_list_id, name, customer_group, priority, valid_from, valid_to, version
+			FROM price_lists
+			WHERE price_list_id = @id
+		`)
+		st.Params["id"] = sel.ID
+	case sel.CustomerGroup != "":
+		st = spanner.NewStatement(`
+			SELECT price_list_id, name, customer_group, priority, valid_from, valid_to, version
+			FROM price_lists
+			WHERE customer_group = @group
+			ORDER BY price_list_id
+		`)
+		st.Params["group"] = sel.CustomerGroup
+	default:
+		return nil, nil
+	}
+
+	type header struct {
+		id, name, group    string
+		priority, version  int64
+		validFrom, validTo spanner.NullTime
+	}
+	var (
+		headers []header
+		ids     []string
+	)
+	iter := tx.Query(ctx, st)
+	defer iter.Stop()
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		var h header
+		if err := row.Columns(&h.id, &h.name, &h.group, &h.priority, &h.validFrom, &h.validTo, &h.version); err != nil {
+			return nil, err
+		}
+		headers = append(headers, h)
+		ids = append(ids, h.id)
+	}
+	if len(headers) == 0 {
+		if sel.ID != "" {
+			return nil, ErrPriceListNotFound
+		}
+		return nil, nil
+	}
+
+	entries, err := readPriceListEntries(ctx, tx, ids, productIDs)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*domain.PriceList, 0, len(headers))
+	for _, h := range headers {
+		out = append(out, domain.HydratePriceList(
+			h.id, h.name, domain.CustomerGroup(h.group), h.priority,
+			timeOrZero(h.validFrom), timeOrZero(h.validTo),
+			entries[h.id], h.version,
+		))
+	}
+	return out, nil
+}
+
+func readPriceListEntries(ctx context.Context, tx *spanner.ReadOnlyTransaction, listIDs, productIDs []string) (map[string][]*domain.PriceListEntry, error) {
+	sql := `
+		SELECT price_list_id, product_id, currency, price_numerator, price_denominator
+		FROM price_list_entries
+		WHERE price_list_id IN UNNEST(@lists)`
+	if productIDs != nil {
+		sql += ` AND product_id IN UNNEST(@ids)`
+	}
+	st := spanner.NewStatement(sql + `
+		ORDER BY price_list_id, product_id, currency
+	`)
+	st.Params["lists"] = listIDs
+	if productIDs != nil {
+		st.Params["ids"] = productIDs
+	}
+
+	out := map[string][]*domain.PriceListEntry{}
+	iter := tx.Query(ctx, st)
+	defer iter.Stop()
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			return out, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		var (
+			listID, productID, currency string
+			num, den                    int64
+		)
+		if err := row.Columns(&listID, &productID, &currency, &num, &den); err != nil {
+			return nil, err
+		}
+		price, err := domain.NewMoneyFromFractionIn(num, den, domain.Currency(currency))
+		if err != nil {
+			return nil, err
+		}
+		e, err := domain.NewPriceListEntry(productID, price)
+		if err != nil {
+			return nil, err
+		}
+		out[listID] = append(out[listID], e)
+	}
+}
+
+// entryMuts diffs two sets of list entries, keyed by product and currency,
+// into child-row mutations.
+func entryMuts(model m_price_list_entry.Model, listID string, old, new []*domain.PriceListEntry, now time.Time) ([]*spanner.Mutation, error) {
+	type key struct {
+		productID string
+		currency  domain.Currency
+	}
+	stored := map[key]*domain.PriceListEntry{}
+	for _, e := range old {
+		stored[key{e.ProductID(), e.Price().Currency()}] = e
+	}
+
+	var muts []*spanner.Mutation
+	for _, e := range new {
+		k := key{e.ProductID(), e.Price().Currency()}
+		prev, ok := stored[k]
+		delete(stored, k)
+		num, den, err := fractionOf(e.Price())
+		if err != nil {
+			return nil, err
+		}
+		row := map[string]interface{}{
+			m_price_list_entry.PriceListID: listID,
+			m_price_list_entry.ProductID:   e.ProductID(),
+			m_price_list_entry.Currency:    string(e.Price().Currency()),
+			m_price_list_entry.PriceNum:    num,
+			m_price_list_entry.PriceDen:    den,
+			m_price_list_entry.UpdatedAt:   now,
+		}
+		switch {
+		case !ok:
+			row[m_price_list_entry.CreatedAt] = now
+			muts = append(muts, model.InsertMut(row))
+		case prev.Price().Rat().Cmp(e.Price().Rat()) != 0:
+			muts = append(muts, model.UpdateMut(row))
+		}
+	}
+	for k := range stored {
+		muts = append(muts, model.DeleteMut(listID, k.productID, string(k.currency)))
+	}
+	return muts, nil
+}
+
+// nullTimeOf stores an open validity bound as NULL.
+func nullTimeOf(t time.Time) spanner.NullTime {
+	return spanner.NullTime{Time: t, Valid: !t.IsZero()}
+}
+
+func timeOrZero(t spanner.NullTime) time.Time {
+	if t.Valid {
+		return t.Time
+	}
+	return time.Time{}
+}
+
+var _ contracts.PriceListRepo = (*PriceListRepo)(nil)
@@ -0,0 +1,105 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/api/iterator"
+
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/models/m_price_tier"
+)
+
+// readPriceTiers loads the quantity breaks of the given products, ordered
+// by quantity.
+func readPriceTiers(ctx context.Context, tx *spanner.ReadOnlyTransaction, productIDs []string) (map[string][]*domain.PriceTier, error) {
+	out := make(map[string][]*domain.PriceTier, len(productIDs))
+	if len(productIDs) == 0 {
+		return out, nil
+	}
+
+	st := spanner.NewStatement(`
+		SELECT product_id, min_quantity, max_quantity, currency, price_numerator, price_denominator
+		FROM product_price_tiers
+		WHERE product_id IN UNNEST(@ids)
+		ORDER BY product_id, min_quantity
+	`)
+	st.Params["ids"] = productIDs
+
+	iter := tx.Query(ctx, st)
+	defer iter.Stop()
+
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			return out, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			productID, currency string
+			minQty              int64
+			maxQty              spanner.NullInt64
+			num, den            int64
+		)
+		if err := row.Columns(&productID, &minQty, &maxQty, &currency, &num, &den); err != nil {
+			return nil, err
+		}
+		price, err := domain.NewMoneyFromFractionIn(num, den, domain.Currency(currency))
+		if err != nil {
+			return nil, err
+		}
+		t, err := domain.NewPriceTier(minQty, maxQty.Int64, price)
+		if err != nil {
+			return nil, err
+		}
+		out[productID] = append(out[productID], t)
+	}
+}
+
+// priceTierMuts diffs two sets of price tiers, keyed by their first
+// quantity, into child-row mutations.
+func priceTierMuts(model m_price_tier.Model, productID string, old, new []*domain.PriceTier, now time.Time) ([]*spanner.Mutation, error) {
+	stored := map[int64]*domain.PriceTier{}
+	for _, t := range old {
+		stored[t.MinQuantity()] = t
+	}
+
+	var muts []*spanner.Mutation
+	for _, t := range new {
+		prev, ok := stored[t.MinQuantity()]
+		delete(stored, t.MinQuantity())
+		num, den, err := fractionOf(t.UnitPrice())
+		if err != nil {
+			return nil, err
+		}
+		row := map[string]interface{}{
+			m_price_tier.ProductID:   productID,
+			m_price_tier.MinQuantity: t.MinQuantity(),
+			m_price_tier.MaxQuantity: maxQuantityOf(t),
+			m_price_tier.Currency:    string(t.UnitPrice().Currency()),
+			m_price_tier.PriceNum:    num,
+			m_price_tier.PriceDen:    den,
+			m_price_tier.UpdatedAt:   now,
+		}
+		switch {
+		case !ok:
+			row[m_price_tier.CreatedAt] = now
+			muts = append(muts, model.InsertMut(row))
+		case prev.MaxQuantity() != t.MaxQuantity() || prev.UnitPrice().Rat().Cmp(t.UnitPrice().Rat()) != 0:
+			muts = append(muts, model.UpdateMut(row))
+		}
+	}
+	for minQty := range stored {
+		muts = append(muts, model.DeleteMut(productID, minQty))
+	}
+	return muts, nil
+}
+
+// maxQuantityOf stores the open-ended last tier's bound as NULL.
+func maxQuantityOf(t *domain.PriceTier) spanner.NullInt64 {
+	return spanner.NullInt64{Int64: t.MaxQuantity(), Valid: t.MaxQuantity() != 0}
+}
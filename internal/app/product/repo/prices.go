@@ -0,0 +1,105 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/api/iterator"
+
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/models/m_price"
+)
+
+// readPrices loads the prices the given products carry besides their base
+// price.
+func readPrices(ctx context.Context, tx *spanner.ReadOnlyTransaction, productIDs []string) (map[string][]*domain.Money, error) {
+	out := make(map[string][]*domain.Money, len(productIDs))
+	if len(productIDs) == 0 {
+		return out, nil
+	}
+
+	st := spanner.NewStatement(`
+		SELECT product_id, currency, price_numerator, price_denominator
+		FROM product_prices
+		WHERE product_id IN UNNEST(@ids)
+		ORDER BY product_id, currency
+	`)
+	st.Params["ids"] = productIDs
+
+	iter := tx.Query(ctx, st)
+	defer iter.Stop()
+
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			return out, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			productID, currency string
+			num, den            int64
+		)
+		if err := row.Columns(&productID, &currency, &num, &den); err != nil {
+			return nil, err
+		}
+		m, err := domain.NewMoneyFromFractionIn(num, den, domain.Currency(currency))
+		if err != nil {
+			return nil, err
+		}
+		out[productID] = append(out[productID], m)
+	}
+}
+
+// currencyOf reads a currency column, taking rows written before prices
+// had a currency to be in DefaultCurrency.
+func currencyOf(c spanner.NullString) domain.Currency {
+	if !c.Valid {
+		return domain.DefaultCurrency
+	}
+	return domain.Currency(c.StringVal)
+}
+
+// priceMuts diffs two sets of non-base prices into child-row mutations.
+func priceMuts(model m_price.Model, productID string, old, new []*domain.Money, now time.Time) ([]*spanner.Mutation, error) {
+	stored := map[domain.Currency]*domain.Money{}
+	for _, m := range old {
+		stored[m.Currency()] = m
+	}
+
+	var muts []*spanner.Mutation
+	for _, m := range new {
+		prev, ok := stored[m.Currency()]
+		delete(stored, m.Currency())
+		num, den, err := fractionOf(m)
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case !ok:
+			muts = append(muts, model.InsertMut(map[string]interface{}{
+				m_price.ProductID: productID,
+				m_price.Currency:  string(m.Currency()),
+				m_price.PriceNum:  num,
+				m_price.PriceDen:  den,
+				m_price.CreatedAt: now,
+				m_price.UpdatedAt: now,
+			}))
+		case prev.Rat().Cmp(m.Rat()) != 0:
+			muts = append(muts, model.UpdateMut(map[string]interface{}{
+				m_price.ProductID: productID,
+				m_price.Currency:  string(m.Currency()),
+				m_price.PriceNum:  num,
+				m_price.PriceDen:  den,
+				m_price.UpdatedAt: now,
+			}))
+		}
+	}
+	for c := range stored {
+		muts = append(muts, model.DeleteMut(productID, string(c)))
+	}
+	return muts, nil
+}
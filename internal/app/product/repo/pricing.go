@@ -0,0 +1,197 @@
+package repo
+
+import (
+	"time"
+
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/app/product/domain/services"
+)
+
+var pricing = services.NewPricingCalculator()
+
+// PriceIn picks the price the read side shows in currency: the product's
+// own price in it, else its base price converted at the rate from the base
+// currency, and its base price when currency is empty. Without either it
+// fails with domain.ErrExchangeRateNotFound. prices holds the stored
+// prices, base first.
+func PriceIn(conv *services.CurrencyConverter, prices []*domain.Money, currency domain.Currency, rates map[domain.Currency]*domain.ExchangeRate) (*domain.Money, error) {
+	if currency == "" {
+		return prices[0], nil
+	}
+	price, _, err := conv.PriceIn(prices, currency, rates[prices[0].Currency()])
+	return price, err
+}
+
+// ListPrices swaps in the prices of the price list that applies to the
+// product at now, if any, and records it on dto. prices holds the stored
+// prices, base first.
+func ListPrices(dto *contracts.ProductDTO, prices []*domain.Money, lists []*domain.PriceList, now time.Time) []*domain.Money {
+	out, list := pricing.ListPrices(dto.ID, prices, lists, now)
+	if list != nil {
+		dto.PriceListID = list.ID()
+	}
+	return out
+}
+
+// FillPricing sets the price and discount fields of dto by evaluating price
+// and the discount schedule at now with the shared pricing engine, then
+// rounding for display. prices holds the stored prices, base first. It
+// returns the breakdown as shown.
+func FillPricing(dto *contracts.ProductDTO, prices []*domain.Money, price *domain.Money, discounts []*domain.Discount, now time.Time, rounding domain.Rounding) (services.PriceBreakdown, error) {
+	dto.Prices = make([]contracts.PriceDTO, 0, len(prices))
+	for _, m := range prices {
+		pd := contracts.PriceDTO{Currency: string(m.Currency()), Amount: rounding.Format(m)}
+		pd.Num, pd.Den, _ = rounding.Round(m).Fraction()
+		dto.Prices = append(dto.Prices, pd)
+	}
+
+	exact, err := pricing.Breakdown(price, discounts, now)
+	if err != nil {
+		return services.PriceBreakdown{}, err
+	}
+	b := exact.Round(rounding)
+
+	dto.Currency = string(price.Currency())
+	dto.BasePriceNum, dto.BasePriceDen, _ = b.Base.Fraction()
+	dto.BasePrice = rounding.Format(b.Base)
+	dto.EffectiveNum, dto.EffectiveDen, _ = b.Effective.Fraction()
+	dto.EffectivePrice = rounding.Format(b.Effective)
+	dto.DiscountAmountNum, dto.DiscountAmountDen, _ = b.DiscountAmount.Fraction()
+	dto.DiscountAmount = rounding.Format(b.DiscountAmount)
+	dto.DiscountActive = len(b.Applied) > 0
+
+	applied := map[string]bool{}
+	var current *domain.Discount
+	for _, a := range b.Applied {
+		applied[a.Discount.ID()] = true
+		if current == nil {
+			current = a.Discount
+		}
+	}
+
+	dto.Discounts = make([]contracts.DiscountDTO, 0, len(discounts))
+	for _, d := range discounts {
+		if d.IsExpiredAt(now) {
+			continue
+		}
+		if current == nil && !d.IsValidAt(now) {
+			current = d
+		}
+		dd := contracts.DiscountDTO{
+			ID:       d.ID(),
+			Kind:     string(d.Kind()),
+			Start:    d.Start().Format(time.RFC3339),
+			End:      d.End().Format(time.RFC3339),
+			Status:   string(d.Status()),
+			Priority: d.Priority(),
+			Stacking: string(d.Stacking()),
+			Active:   applied[d.ID()],
+		}
+		if pct := d.Percent(); pct != nil {
+			dd.Percent = pct.RatString()
+		}
+		if a := d.Amount(); a != nil {
+			dd.AmountNum, dd.AmountDen, _ = a.Fraction()
+			dd.AmountCur = string(a.Currency())
+			dd.Amount = rounding.Format(a)
+		}
+		dto.Discounts = append(dto.Discounts, dd)
+	}
+
+	if current != nil {
+		dto.DiscountID = current.ID()
+		if pct := current.Percent(); pct != nil {
+			dto.DiscountPct = pct.RatString()
+		}
+		dto.DiscountStart = current.Start().Format(time.RFC3339)
+		dto.DiscountEnd = current.End().Format(time.RFC3339)
+	}
+	return b, nil
+}
+
+// FillPriceTiers sets dto.PriceTiers from the product's quantity breaks.
+func FillPriceTiers(dto *contracts.ProductDTO, tiers []*domain.PriceTier, rounding domain.Rounding) {
+	dto.PriceTiers = make([]contracts.PriceTierDTO, 0, len(tiers))
+	for _, t := range tiers {
+		td := contracts.PriceTierDTO{
+			MinQuantity: t.MinQuantity(),
+			MaxQuantity: t.MaxQuantity(),
+			Currency:    string(t.UnitPrice().Currency()),
+			Amount:      rounding.Format(t.UnitPrice()),
+		}
+		td.Num, td.Den, _ = rounding.Round(t.UnitPrice()).Fraction()
+		dto.PriceTiers = append(dto.PriceTiers, td)
+	}
+}
+
+func FillVariants(dto *contracts.ProductDTO, variants []*domain.Variant, rounding domain.Rounding) {
+	dto.Variants = make([]contracts.VariantDTO, 0, len(variants))
+	for _, v := range variants {
+		vd := contracts.VariantDTO{ID: v.ID(), SKU: v.SKU(), Attributes: v.Attributes(), Status: string(v.Status())}
+		if m := v.Price(); m != nil {
+			vd.Currency = string(m.Currency())
+			vd.Price = rounding.Format(m)
+			vd.Num, vd.Den, _ = rounding.Round(m).Fraction()
+		}
+		dto.Variants = append(dto.Variants, vd)
+	}
+}
+
+// FillTax sets dto.Tax by splitting effective, the price as shown, at the
+// rate rates holds for the class the product is taxed under. categoryClass
+// is the class assigned to the product's category. Without such a rate Tax
+// stays nil.
+func FillTax(dto *contracts.ProductDTO, effective *domain.Money, categoryClass domain.TaxClass, rates map[domain.TaxClass]*domain.TaxRate, mode domain.TaxMode, rounding domain.Rounding) error {
+	rate, ok := rates[domain.ResolveTaxClass(domain.TaxClass(dto.TaxClass), categoryClass)]
+	if !ok {
+		return nil
+	}
+	exact, err := rate.Apply(effective, mode)
+	if err != nil {
+		return err
+	}
+	t := exact.Round(rounding)
+	td := &contracts.TaxDTO{
+		Region: string(rate.Region()),
+		Class:  string(rate.Class()),
+		Mode:   string(mode),
+		Net:    rounding.Format(t.Net),
+		Tax:    rounding.Format(t.Tax),
+		Gross:  rounding.Format(t.Gross),
+	}
+	if r := rate.Rate(); r.Num().IsInt64() && r.Denom().IsInt64() {
+		td.RateNum, td.RateDen = r.Num().Int64(), r.Denom().Int64()
+	}
+	td.NetNum, td.NetDen, _ = t.Net.Fraction()
+	td.TaxNum, td.TaxDen, _ = t.Tax.Fraction()
+	td.GrossNum, td.GrossDen, _ = t.Gross.Fraction()
+	dto.Tax = td
+	return nil
+}
+
+// FillLowestPrice sets the lowest-price fields of dto from the product's
+// price history in dto.Currency over the days before now. It runs after
+// ListPrices and FillPricing; history may hold every currency, each oldest
+// first. The history is of the product's own prices, so nothing is set
+// when a price list applies.
+func FillLowestPrice(dto *contracts.ProductDTO, history []*domain.PriceRecord, discounts []*domain.Discount, now time.Time, days int, rounding domain.Rounding) error {
+	dto.LowestPriceDays = int32(days)
+	if dto.PriceListID != "" {
+		return nil
+	}
+	var records []*domain.PriceRecord
+	for _, r := range history {
+		if string(r.Currency) == dto.Currency {
+			records = append(records, r)
+		}
+	}
+	lowest, ok, err := pricing.LowestPrice(records, discounts, now.AddDate(0, 0, -days), now)
+	if err != nil || !ok {
+		return err
+	}
+	eff := lowest.Round(rounding).Effective
+	dto.LowestPriceNum, dto.LowestPriceDen, _ = eff.Fraction()
+	dto.LowestPrice = rounding.Format(eff)
+	return nil
+}
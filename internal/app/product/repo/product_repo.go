@@ -3,7 +3,6 @@ package repo
 import (
 	"context"
 	"errors"
-	"math/big"
 	"time"
 
 	"cloud.google.com/go/spanner"
@@ -12,38 +11,56 @@ import (
 	"product-catalog-service/internal/app/product/contracts"
 	"product-catalog-service/internal/app/product/domain"
 	"product-catalog-service/internal/infra/spannerx"
+	"product-catalog-service/internal/models/m_discount"
+	"product-catalog-service/internal/models/m_price"
+	"product-catalog-service/internal/models/m_price_tier"
 	"product-catalog-service/internal/models/m_product"
+	"product-catalog-service/internal/models/m_product_sku"
+	"product-catalog-service/internal/models/m_product_variant"
 	"product-catalog-service/internal/pkg/clock"
 )
 
 var ErrProductNotFound = errors.New("product not found")
 
 type ProductRepo struct {
-	client *spanner.Client
-	model  m_product.Model
-	clock  clock.Clock
+	client    *spanner.Client
+	model     m_product.Model
+	prices    m_price.Model
+	tiers     m_price_tier.Model
+	variants  m_product_variant.Model
+	skus      m_product_sku.Model
+	discounts m_discount.Model
+	clock     clock.Clock
 }
 
 func NewProductRepo(client *spanner.Client, clk clock.Clock) *ProductRepo {
 	return &ProductRepo{
-		client: client,
-		model:  m_product.Model{},
-		clock:  clk,
+		client:    client,
+		model:     m_product.Model{},
+		prices:    m_price.Model{},
+		tiers:     m_price_tier.Model{},
+		variants:  m_product_variant.Model{},
+		skus:      m_product_sku.Model{},
+		discounts: m_discount.Model{},
+		clock:     clk,
 	}
 }
 
 func (r *ProductRepo) GetByID(ctx context.Context, id string) (*domain.Product, error) {
 	st := spanner.NewStatement(`
-		SELECT product_id, name, description, category,
-		       base_price_numerator, base_price_denominator,
+		SELECT product_id, name, description, category, sku, gtin, tax_class,
+		       base_price_numerator, base_price_denominator, base_price_currency,
 		       discount_percent, discount_start_date, discount_end_date,
-		       status, archived_at
+		       status, archived_at, version
 		FROM products
 		WHERE product_id = @id
 	`)
 	st.Params["id"] = id
 
-	iter := r.client.Single().Query(ctx, st)
+	tx := r.client.ReadOnlyTransaction()
+	defer tx.Close()
+
+	iter := tx.Query(ctx, st)
 	defer iter.Stop()
 
 	row, err := iter.Next()
@@ -59,81 +76,153 @@ func (r *ProductRepo) GetByID(ctx context.Context, id string) (*domain.Product,
 		name      string
 		desc      spanner.NullString
 		category  string
+		sku       spanner.NullString
+		gtin      spanner.NullString
+		taxClass  spanner.NullString
 
 		baseNum int64
 		baseDen int64
+		baseCur spanner.NullString
 
 		discPercent spanner.NullNumeric
 		discStart   spanner.NullTime
 		discEnd     spanner.NullTime
 		statusStr   string
 		archivedAt  spanner.NullTime
+		version     spanner.NullInt64
 	)
 
 	if err := row.Columns(
-		&productID, &name, &desc, &category,
-		&baseNum, &baseDen,
+		&productID, &name, &desc, &category, &sku, &gtin, &taxClass,
+		&baseNum, &baseDen, &baseCur,
 		&discPercent, &discStart, &discEnd,
-		&statusStr, &archivedAt,
+		&statusStr, &archivedAt, &version,
 	); err != nil {
 		return nil, err
 	}
 
-	base, err := domain.NewMoneyFromFraction(baseNum, baseDen)
+	base, err := domain.NewMoneyFromFractionIn(baseNum, baseDen, currencyOf(baseCur))
+	if err != nil {
+		return nil, err
+	}
+
+	prices, err := readPrices(ctx, tx, []string{productID})
+	if err != nil {
+		return nil, err
+	}
+
+	tiers, err := readPriceTiers(ctx, tx, []string{productID})
 	if err != nil {
 		return nil, err
 	}
 
-	var discount *domain.Discount
-	if discPercent.Valid && discStart.Valid && discEnd.Valid {
-		dp := new(big.Rat).Set(&discPercent.Numeric)
-		d, derr := domain.NewDiscount(dp, discStart.Time, discEnd.Time)
+	variants, err := readVariants(ctx, tx, []string{productID})
+	if err != nil {
+		return nil, err
+	}
 
-		if derr != nil {
-			return nil, derr
+	byProduct, err := readDiscounts(ctx, tx, []string{productID}, time.Time{})
+	if err != nil {
+		return nil, err
+	}
+	discounts := byProduct[productID]
+	if len(discounts) == 0 {
+		if d := legacyDiscount(discPercent, discStart, discEnd); d != nil {
+			discounts = append(discounts, d)
 		}
-		discount = d
 	}
 
 	status := parseStatus(statusStr)
 
-	p := domain.HydrateProduct(
-		productID,
-		name,
-		nullString(desc),
-		category,
-		base,
-		discount,
-		status,
-		nullTime(archivedAt),
-	)
+	p := domain.HydrateProduct(domain.HydrateProductParams{
+		ID:          productID,
+		Name:        name,
+		Description: nullString(desc),
+		Category:    category,
+		SKU:         nullString(sku),
+		GTIN:        domain.GTIN(nullString(gtin)),
+		TaxClass:    domain.TaxClass(nullString(taxClass)),
+		BasePrice:   base,
+		Prices:      prices[productID],
+		PriceTiers:  tiers[productID],
+		Variants:    variants[productID],
+		Discounts:   discounts,
+		Status:      status,
+		ArchivedAt:  nullTime(archivedAt),
+		Version:     version.Int64,
+	})
 
 	return p, nil
 }
 
+// SKUOwner returns the ID of the product that uses sku, as its own SKU or a
+// variant's, or "" when none does.
+func (r *ProductRepo) SKUOwner(ctx context.Context, sku string) (string, error) {
+	tx := r.client.ReadOnlyTransaction()
+	defer tx.Close()
+	return readSKUOwner(ctx, tx, sku)
+}
+
+// GTINOwner returns the ID of the product with gtin, or "" when none has it.
+func (r *ProductRepo) GTINOwner(ctx context.Context, gtin domain.GTIN) (string, error) {
+	tx := r.client.ReadOnlyTransaction()
+	defer tx.Close()
+	return readGTINOwner(ctx, tx, gtin)
+}
+
 func (r *ProductRepo) InsertMut(p *domain.Product) contracts.Mutation {
 	now := r.clock.Now()
+	baseNum, baseDen, err := fractionOf(p.BasePrice())
+	if err != nil {
+		return spannerx.Failed(err)
+	}
 
 	row := map[string]interface{}{
 		m_product.ProductID:            p.ID(),
 		m_product.Name:                 p.Name(),
 		m_product.Description:          p.Description(),
 		m_product.Category:             p.Category(),
-		m_product.BasePriceNumerator:   p.BasePrice().Numerator(),
-		m_product.BasePriceDenominator: p.BasePrice().Denominator(),
+		m_product.SKU:                  nullStringOf(p.SKU()),
+		m_product.GTIN:                 nullStringOf(string(p.GTIN())),
+		m_product.TaxClass:             taxClassOf(p),
+		m_product.BasePriceNumerator:   baseNum,
+		m_product.BasePriceDenominator: baseDen,
+		m_product.BasePriceCurrency:    string(p.BasePrice().Currency()),
 		m_product.Status:               string(p.Status()),
 		m_product.CreatedAt:            now,
 		m_product.UpdatedAt:            now,
 		m_product.ArchivedAt:           spanner.NullTime{Valid: false},
+		m_product.Version:              p.Version() + 1,
 	}
 
-	if d := p.Discount(); d != nil {
-		row[m_product.DiscountPercent] = spanner.NullNumeric{Numeric: *d.Percent(), Valid: true}
-		row[m_product.DiscountStartDate] = d.Start()
-		row[m_product.DiscountEndDate] = d.End()
+	batch := spannerx.Batch{{M: r.model.InsertMut(row)}}
+	children, err := priceMuts(r.prices, p.ID(), nil, p.Prices()[1:], now)
+	if err != nil {
+		return spannerx.Failed(err)
 	}
-
-	return spannerx.Wrap(r.model.InsertMut(row))
+	tiers, err := priceTierMuts(r.tiers, p.ID(), nil, p.PriceTiers(), now)
+	if err != nil {
+		return spannerx.Failed(err)
+	}
+	variants, err := variantMuts(r.variants, p.ID(), nil, p.Variants(), now)
+	if err != nil {
+		return spannerx.Failed(err)
+	}
+	discounts, err := discountMuts(r.discounts, p.ID(), nil, p.Discounts(), now)
+	if err != nil {
+		return spannerx.Failed(err)
+	}
+	children = append(children, tiers...)
+	children = append(children, variants...)
+	children = append(children, skuMuts(r.skus, p.ID(), nil, skusOf(p.SKU(), p.Variants()), now)...)
+	children = append(children, discounts...)
+	for _, m := range children {
+		batch = append(batch, spannerx.Mutation{M: m})
+	}
+	if len(batch) == 1 {
+		return batch[0]
+	}
+	return batch
 }
 
 func (r *ProductRepo) UpdateMut(p *domain.Product) contracts.Mutation {
@@ -152,19 +241,73 @@ func (r *ProductRepo) UpdateMut(p *domain.Product) contracts.Mutation {
 	if ch.Dirty(domain.FieldCategory) {
 		updates[m_product.Category] = p.Category()
 	}
+	if ch.Dirty(domain.FieldSKU) {
+		updates[m_product.SKU] = nullStringOf(p.SKU())
+	}
+	if ch.Dirty(domain.FieldGTIN) {
+		updates[m_product.GTIN] = nullStringOf(string(p.GTIN()))
+	}
+	if ch.Dirty(domain.FieldTaxClass) {
+		updates[m_product.TaxClass] = taxClassOf(p)
+	}
 	if ch.Dirty(domain.FieldStatus) {
 		updates[m_product.Status] = string(p.Status())
 	}
-	if ch.Dirty(domain.FieldDiscount) {
-		if d := p.Discount(); d != nil {
-			updates[m_product.DiscountPercent] = spanner.NullNumeric{Numeric: *d.Percent(), Valid: true}
-			updates[m_product.DiscountStartDate] = d.Start()
-			updates[m_product.DiscountEndDate] = d.End()
-		} else {
-			updates[m_product.DiscountPercent] = spanner.NullNumeric{Valid: false}
-			updates[m_product.DiscountStartDate] = spanner.NullTime{Valid: false}
-			updates[m_product.DiscountEndDate] = spanner.NullTime{Valid: false}
+	if ch.Dirty(domain.FieldBasePrice) {
+		num, den, err := fractionOf(p.BasePrice())
+		if err != nil {
+			return spannerx.Failed(err)
+		}
+		updates[m_product.BasePriceNumerator] = num
+		updates[m_product.BasePriceDenominator] = den
+		updates[m_product.BasePriceCurrency] = string(p.BasePrice().Currency())
+	}
+	var children []*spanner.Mutation
+	if c, ok := ch.Change(domain.FieldPrices); ok {
+		old, _ := c.Old.([]*domain.Money)
+		muts, err := priceMuts(r.prices, p.ID(), old, p.Prices()[1:], r.clock.Now())
+		if err != nil {
+			return spannerx.Failed(err)
+		}
+		children = muts
+	}
+	if c, ok := ch.Change(domain.FieldPriceTiers); ok {
+		old, _ := c.Old.([]*domain.PriceTier)
+		muts, err := priceTierMuts(r.tiers, p.ID(), old, p.PriceTiers(), r.clock.Now())
+		if err != nil {
+			return spannerx.Failed(err)
+		}
+		children = append(children, muts...)
+	}
+	if c, ok := ch.Change(domain.FieldVariants); ok {
+		old, _ := c.Old.([]*domain.Variant)
+		muts, err := variantMuts(r.variants, p.ID(), old, p.Variants(), r.clock.Now())
+		if err != nil {
+			return spannerx.Failed(err)
 		}
+		children = append(children, muts...)
+	}
+	if ch.Dirty(domain.FieldSKU) || ch.Dirty(domain.FieldVariants) {
+		oldSKU, oldVariants := p.SKU(), p.Variants()
+		if c, ok := ch.Change(domain.FieldSKU); ok {
+			oldSKU, _ = c.Old.(string)
+		}
+		if c, ok := ch.Change(domain.FieldVariants); ok {
+			oldVariants, _ = c.Old.([]*domain.Variant)
+		}
+		children = append(children, skuMuts(r.skus, p.ID(), skusOf(oldSKU, oldVariants), skusOf(p.SKU(), p.Variants()), r.clock.Now())...)
+	}
+	if c, ok := ch.Change(domain.FieldDiscounts); ok {
+		old, _ := c.Old.([]*domain.Discount)
+		muts, err := discountMuts(r.discounts, p.ID(), old, p.Discounts(), r.clock.Now())
+		if err != nil {
+			return spannerx.Failed(err)
+		}
+		children = append(children, muts...)
+		// The schedule now lives in product_discounts; drop any legacy copy.
+		updates[m_product.DiscountPercent] = spanner.NullNumeric{Valid: false}
+		updates[m_product.DiscountStartDate] = spanner.NullTime{Valid: false}
+		updates[m_product.DiscountEndDate] = spanner.NullTime{Valid: false}
 	}
 	if ch.Dirty(domain.FieldArchivedAt) {
 		if t := p.ArchivedAt(); t != nil {
@@ -174,12 +317,46 @@ func (r *ProductRepo) UpdateMut(p *domain.Product) contracts.Mutation {
 		}
 	}
 
-	if len(updates) == 1 {
+	if len(updates) == 1 && len(children) == 0 {
 		return nil
 	}
 	updates[m_product.UpdatedAt] = r.clock.Now()
+	updates[m_product.Version] = p.Version() + 1
+
+	m := spannerx.WrapGuarded(r.model.UpdateMut(updates), spannerx.VersionGuard{
+		Table:   m_product.Table,
+		Key:     spanner.Key{p.ID()},
+		Column:  m_product.Version,
+		Version: p.Version(),
+	})
+	if len(children) == 0 {
+		return m
+	}
+	batch := spannerx.Batch{m.(spannerx.Mutation)}
+	for _, c := range children {
+		batch = append(batch, spannerx.Mutation{M: c})
+	}
+	return batch
+}
+
+// taxClassOf stores a product without a class of its own as NULL.
+func taxClassOf(p *domain.Product) spanner.NullString {
+	return spanner.NullString{StringVal: string(p.TaxClass()), Valid: p.TaxClass() != ""}
+}
 
-	return spannerx.Wrap(r.model.UpdateMut(updates))
+// nullStringOf stores an empty identifier as NULL rather than "".
+func nullStringOf(s string) spanner.NullString {
+	return spanner.NullString{StringVal: s, Valid: s != ""}
+}
+
+// fractionOf is m as the numerator and denominator it is stored as,
+// failing rather than truncating an amount that does not fit in them.
+func fractionOf(m *domain.Money) (num, den int64, err error) {
+	num, den, ok := m.Fraction()
+	if !ok {
+		return 0, 0, domain.ErrMoneyOverflow
+	}
+	return num, den, nil
 }
 
 func nullString(s spanner.NullString) string {
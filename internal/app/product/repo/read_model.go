@@ -2,37 +2,131 @@ package repo
 
 import (
 	"context"
-	"math/big"
 	"time"
 
 	"cloud.google.com/go/spanner"
 	"google.golang.org/api/iterator"
 
 	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/app/product/domain/services"
 	"product-catalog-service/internal/pkg/clock"
+	"product-catalog-service/internal/pkg/pagetoken"
 )
 
 type SpannerReadModel struct {
 	client *spanner.Client
 	clock  clock.Clock
+	tokens *pagetoken.Codec
+	conv   *services.CurrencyConverter
+	days   int
+	mode   domain.TaxMode
 }
 
-func NewSpannerReadModel(client *spanner.Client, clk clock.Clock) *SpannerReadModel {
-	return &SpannerReadModel{client: client, clock: clk}
+// NewSpannerReadModel reports the lowest price over the lowestPriceDays
+// days before each read, and splits tax taking stored prices to be as
+// taxMode says.
+func NewSpannerReadModel(client *spanner.Client, clk clock.Clock, tokens *pagetoken.Codec, conv *services.CurrencyConverter, lowestPriceDays int, taxMode domain.TaxMode) *SpannerReadModel {
+	return &SpannerReadModel{client: client, clock: clk, tokens: tokens, conv: conv, days: lowestPriceDays, mode: taxMode}
 }
 
-func (r *SpannerReadModel) GetProduct(ctx context.Context, id string) (contracts.ProductDTO, error) {
+func (r *SpannerReadModel) GetProduct(ctx context.Context, id, currency, region string, lists contracts.PriceListSelector) (contracts.ProductDTO, error) {
+	tx := r.client.ReadOnlyTransaction()
+	defer tx.Close()
+	return r.getProduct(ctx, tx, id, currency, region, lists)
+}
+
+func (r *SpannerReadModel) GetProductBySKU(ctx context.Context, sku, currency, region string, lists contracts.PriceListSelector) (contracts.ProductDTO, error) {
+	tx := r.client.ReadOnlyTransaction()
+	defer tx.Close()
+	id, err := readSKUOwner(ctx, tx, sku)
+	if err != nil {
+		return contracts.ProductDTO{}, err
+	}
+	if id == "" {
+		return contracts.ProductDTO{}, ErrProductNotFound
+	}
+	return r.getProduct(ctx, tx, id, currency, region, lists)
+}
+
+func (r *SpannerReadModel) GetProductByGTIN(ctx context.Context, gtin, currency, region string, lists contracts.PriceListSelector) (contracts.ProductDTO, error) {
+	tx := r.client.ReadOnlyTransaction()
+	defer tx.Close()
+	id, err := readGTINOwner(ctx, tx, domain.GTIN(gtin))
+	if err != nil {
+		return contracts.ProductDTO{}, err
+	}
+	if id == "" {
+		return contracts.ProductDTO{}, ErrProductNotFound
+	}
+	return r.getProduct(ctx, tx, id, currency, region, lists)
+}
+
+func (r *SpannerReadModel) BatchGetProducts(ctx context.Context, ids []string, currency, region string, lists contracts.PriceListSelector) ([]contracts.ProductDTO, error) {
 	st := spanner.NewStatement(`
-		SELECT product_id, name, description, category,
-		       base_price_numerator, base_price_denominator,
+		SELECT product_id, name, description, category, sku, gtin, tax_class,
+		       base_price_numerator, base_price_denominator, base_price_currency,
 		       discount_percent, discount_start_date, discount_end_date,
-		       status, created_at, updated_at, archived_at
+		       status, created_at, updated_at, archived_at, version
+		FROM products
+		WHERE product_id IN UNNEST(@ids)
+	`)
+	st.Params["ids"] = ids
+
+	tx := r.client.ReadOnlyTransaction()
+	defer tx.Close()
+
+	iter := tx.Query(ctx, st)
+	defer iter.Stop()
+
+	var rows []scannedRow
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		sr, err := r.scanRow(row)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, sr)
+	}
+	iter.Stop()
+
+	dtos, err := r.withPricing(ctx, tx, rows, currency, region, lists)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]contracts.ProductDTO, len(dtos))
+	for _, dto := range dtos {
+		byID[dto.ID] = dto
+	}
+	out := make([]contracts.ProductDTO, 0, len(ids))
+	for _, id := range ids {
+		dto, ok := byID[id]
+		if !ok {
+			return nil, ErrProductNotFound
+		}
+		out = append(out, dto)
+	}
+	return out, nil
+}
+
+func (r *SpannerReadModel) getProduct(ctx context.Context, tx *spanner.ReadOnlyTransaction, id, currency, region string, lists contracts.PriceListSelector) (contracts.ProductDTO, error) {
+	st := spanner.NewStatement(`
+		SELECT product_id, name, description, category, sku, gtin, tax_class,
+		       base_price_numerator, base_price_denominator, base_price_currency,
+		       discount_percent, discount_start_date, discount_end_date,
+		       status, created_at, updated_at, archived_at, version
 		FROM products
 		WHERE product_id = @id
 	`)
 	st.Params["id"] = id
 
-	iter := r.client.Single().Query(ctx, st)
+	iter := tx.Query(ctx, st)
 	defer iter.Stop()
 
 	row, err := iter.Next()
@@ -43,15 +137,30 @@ func (r *SpannerReadModel) GetProduct(ctx context.Context, id string) (contracts
 		return contracts.ProductDTO{}, err
 	}
 
-	return r.mapRowToDTO(row)
+	sr, err := r.scanRow(row)
+	if err != nil {
+		return contracts.ProductDTO{}, err
+	}
+	out, err := r.withPricing(ctx, tx, []scannedRow{sr}, currency, region, lists)
+	if err != nil {
+		return contracts.ProductDTO{}, err
+	}
+	return out[0], nil
+}
+
+type listCursor struct {
+	CreatedAt  time.Time `json:"c"`
+	ProductID  string    `json:"p"`
+	Category   string    `json:"cat,omitempty"`
+	OnlyActive bool      `json:"act,omitempty"`
 }
 
 func (r *SpannerReadModel) ListProducts(ctx context.Context, f contracts.ListProductsFilter) (contracts.ListProductsResult, error) {
 	query := `
-		SELECT product_id, name, description, category,
-		       base_price_numerator, base_price_denominator,
+		SELECT product_id, name, description, category, sku, gtin, tax_class,
+		       base_price_numerator, base_price_denominator, base_price_currency,
 		       discount_percent, discount_start_date, discount_end_date,
-		       status, created_at, updated_at, archived_at
+		       status, created_at, updated_at, archived_at, version
 		FROM products
 		WHERE archived_at IS NULL
 	`
@@ -66,20 +175,37 @@ func (r *SpannerReadModel) ListProducts(ctx context.Context, f contracts.ListPro
 		query += " AND status = 'active'"
 	}
 
-	query += " ORDER BY created_at DESC"
+	if f.PageToken != "" {
+		var cur listCursor
+		if err := r.tokens.Decode(f.PageToken, &cur); err != nil {
+			return contracts.ListProductsResult{}, err
+		}
+		if cur.Category != f.Category || cur.OnlyActive != f.OnlyActive {
+			return contracts.ListProductsResult{}, pagetoken.ErrInvalid
+		}
+		query += " AND (created_at < @cursor_created_at OR (created_at = @cursor_created_at AND product_id < @cursor_product_id))"
+		params["cursor_created_at"] = cur.CreatedAt
+		params["cursor_product_id"] = cur.ProductID
+	}
+
+	pageSize := contracts.PageSize(f.Limit)
+
+	query += " ORDER BY created_at DESC, product_id DESC LIMIT @limit"
+	params["limit"] = int64(pageSize + 1)
 
 	st := spanner.NewStatement(query)
 	st.Params = params
 
-	pageSize := int(f.Limit)
-	if pageSize <= 0 {
-		pageSize = 20
-	}
+	tx := r.client.ReadOnlyTransaction()
+	defer tx.Close()
 
-	iter := r.client.Single().Query(ctx, st)
+	iter := tx.Query(ctx, st)
 	defer iter.Stop()
 
-	var items []contracts.ProductDTO
+	var (
+		rows      []scannedRow
+		createdAt []time.Time
+	)
 	for {
 		row, err := iter.Next()
 		if err == iterator.Done {
@@ -89,41 +215,178 @@ func (r *SpannerReadModel) ListProducts(ctx context.Context, f contracts.ListPro
 			return contracts.ListProductsResult{}, err
 		}
 
-		dto, err := r.mapRowToDTO(row)
+		sr, err := r.scanRow(row)
 		if err != nil {
 			return contracts.ListProductsResult{}, err
 		}
-		items = append(items, dto)
+		var ts time.Time
+		if err := row.ColumnByName("created_at", &ts); err != nil {
+			return contracts.ListProductsResult{}, err
+		}
+		rows = append(rows, sr)
+		createdAt = append(createdAt, ts)
+	}
+	iter.Stop()
 
-		if len(items) >= pageSize {
-			// Basic pagination: just stop here for now as Task doesn't require full sophisticated pagination
-			break
+	items, err := r.withPricing(ctx, tx, rows, f.Currency, f.Region, f.PriceLists)
+	if err != nil {
+		return contracts.ListProductsResult{}, err
+	}
+
+	res := contracts.ListProductsResult{Items: items}
+	if len(items) > pageSize {
+		res.Items = items[:pageSize]
+		last := res.Items[pageSize-1]
+		tok, err := r.tokens.Encode(listCursor{
+			CreatedAt:  createdAt[pageSize-1],
+			ProductID:  last.ID,
+			Category:   f.Category,
+			OnlyActive: f.OnlyActive,
+		})
+		if err != nil {
+			return contracts.ListProductsResult{}, err
+		}
+		res.NextPageToken = tok
+	}
+
+	return res, nil
+}
+
+// scannedRow is a product row whose pricing still needs its discounts.
+type scannedRow struct {
+	dto    contracts.ProductDTO
+	base   *domain.Money
+	legacy *domain.Discount
+}
+
+func (r *SpannerReadModel) withPricing(ctx context.Context, tx *spanner.ReadOnlyTransaction, rows []scannedRow, currency, region string, sel contracts.PriceListSelector) ([]contracts.ProductDTO, error) {
+	ids := make([]string, 0, len(rows))
+	var categories []string
+	for _, sr := range rows {
+		ids = append(ids, sr.dto.ID)
+		categories = append(categories, sr.dto.Category)
+	}
+	prices, err := readPrices(ctx, tx, ids)
+	if err != nil {
+		return nil, err
+	}
+	tiers, err := readPriceTiers(ctx, tx, ids)
+	if err != nil {
+		return nil, err
+	}
+	variants, err := readVariants(ctx, tx, ids)
+	if err != nil {
+		return nil, err
+	}
+	now := r.clock.Now()
+	// Older expired discounts change neither the price nor the lowest price.
+	byProduct, err := readDiscounts(ctx, tx, ids, now.AddDate(0, 0, -r.days))
+	if err != nil {
+		return nil, err
+	}
+	lists, err := readPriceLists(ctx, tx, sel, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	var rates map[domain.Currency]*domain.ExchangeRate
+	if currency != "" {
+		if rates, err = readRatesTo(ctx, tx, domain.Currency(currency), now); err != nil {
+			return nil, err
 		}
 	}
+	var (
+		taxRates   map[domain.TaxClass]*domain.TaxRate
+		taxClasses map[string]domain.TaxClass
+	)
+	if region != "" {
+		if taxRates, err = readTaxRates(ctx, tx, domain.Region(region)); err != nil {
+			return nil, err
+		}
+		if taxClasses, err = readCategoryTaxClasses(ctx, tx, categories); err != nil {
+			return nil, err
+		}
+	}
+	out := make([]contracts.ProductDTO, 0, len(rows))
+	scheduled := make(map[string][]*domain.Discount, len(rows))
+	for _, sr := range rows {
+		discounts := byProduct[sr.dto.ID]
+		if len(discounts) == 0 && sr.legacy != nil {
+			discounts = []*domain.Discount{sr.legacy}
+		}
+		all := ListPrices(&sr.dto, append([]*domain.Money{sr.base}, prices[sr.dto.ID]...), lists, now)
+		price, err := PriceIn(r.conv, all, domain.Currency(currency), rates)
+		if err != nil {
+			return nil, err
+		}
+		b, err := FillPricing(&sr.dto, all, price, discounts, now, r.conv.Rounding())
+		if err != nil {
+			return nil, err
+		}
+		FillPriceTiers(&sr.dto, tiers[sr.dto.ID], r.conv.Rounding())
+		FillVariants(&sr.dto, variants[sr.dto.ID], r.conv.Rounding())
+		if err := FillTax(&sr.dto, b.Effective, taxClasses[sr.dto.Category], taxRates, r.mode, r.conv.Rounding()); err != nil {
+			return nil, err
+		}
+		out = append(out, sr.dto)
+		scheduled[sr.dto.ID] = discounts
+	}
+	if err := r.fillLowestPrices(ctx, tx, out, scheduled, now); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-	return contracts.ListProductsResult{
-		Items: items,
-	}, nil
+// fillLowestPrices reads only the history FillLowestPrice looks at: each
+// product's shown currency over the lookback window, skipping products a
+// price list applies to.
+func (r *SpannerReadModel) fillLowestPrices(ctx context.Context, tx *spanner.ReadOnlyTransaction, dtos []contracts.ProductDTO, discounts map[string][]*domain.Discount, now time.Time) error {
+	from := now.AddDate(0, 0, -r.days)
+	byCurrency := map[string][]string{}
+	for _, dto := range dtos {
+		if dto.PriceListID == "" {
+			byCurrency[dto.Currency] = append(byCurrency[dto.Currency], dto.ID)
+		}
+	}
+	history := map[string][]*domain.PriceRecord{}
+	for currency, ids := range byCurrency {
+		window, err := readPriceWindow(ctx, tx, ids, domain.Currency(currency), from)
+		if err != nil {
+			return err
+		}
+		for id, records := range window {
+			history[id] = records
+		}
+	}
+	for i := range dtos {
+		if err := FillLowestPrice(&dtos[i], history[dtos[i].ID], discounts[dtos[i].ID], now, r.days, r.conv.Rounding()); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-func (r *SpannerReadModel) mapRowToDTO(row *spanner.Row) (contracts.ProductDTO, error) {
+func (r *SpannerReadModel) scanRow(row *spanner.Row) (scannedRow, error) {
 	var (
 		id, name, category, status string
+		sku, gtin, taxClass        spanner.NullString
 		baseNum, baseDen           int64
+		baseCur                    spanner.NullString
 		discPercent                spanner.NullNumeric
 		discStart, discEnd         spanner.NullTime
 		createdAt, updatedAt       time.Time
 		archivedAt                 spanner.NullTime
 		description                spanner.NullString
+		version                    spanner.NullInt64
 	)
 
 	if err := row.Columns(
-		&id, &name, &description, &category,
-		&baseNum, &baseDen,
+		&id, &name, &description, &category, &sku, &gtin, &taxClass,
+		&baseNum, &baseDen, &baseCur,
 		&discPercent, &discStart, &discEnd,
-		&status, &createdAt, &updatedAt, &archivedAt,
+		&status, &createdAt, &updatedAt, &archivedAt, &version,
 	); err != nil {
-		return contracts.ProductDTO{}, err
+		return scannedRow{}, err
 	}
 
 	dto := contracts.ProductDTO{
@@ -131,39 +394,25 @@ func (r *SpannerReadModel) mapRowToDTO(row *spanner.Row) (contracts.ProductDTO,
 		Name:         name,
 		Description:  description.StringVal,
 		Category:     category,
+		SKU:          nullString(sku),
+		GTIN:         nullString(gtin),
+		TaxClass:     nullString(taxClass),
 		Status:       status,
 		BasePriceNum: baseNum,
 		BasePriceDen: baseDen,
 		CreatedAt:    createdAt.Format(time.RFC3339),
 		UpdatedAt:    updatedAt.Format(time.RFC3339),
-		EffectiveNum: baseNum,
-		EffectiveDen: baseDen,
+		Version:      version.Int64,
 	}
 
 	if archivedAt.Valid {
 		dto.ArchivedAt = archivedAt.Time.Format(time.RFC3339)
 	}
 
-	if discPercent.Valid && discStart.Valid && discEnd.Valid {
-		now := r.clock.Now().UTC()
-		if now.After(discStart.Time) && now.Before(discEnd.Time) {
-			pct := new(big.Rat).Set(&discPercent.Numeric)
-			base := big.NewRat(baseNum, baseDen)
-
-			one := big.NewRat(1, 1)
-			hundred := big.NewRat(100, 1)
-			pctRatio := new(big.Rat).Quo(pct, hundred)
-			multiplier := new(big.Rat).Sub(one, pctRatio)
-			effective := new(big.Rat).Mul(base, multiplier)
-
-			dto.EffectiveNum = effective.Num().Int64()
-			dto.EffectiveDen = effective.Denom().Int64()
-
-			dto.DiscountPct = pct.FloatString(2)
-			dto.DiscountStart = discStart.Time.Format(time.RFC3339)
-			dto.DiscountEnd = discEnd.Time.Format(time.RFC3339)
-		}
+	base, err := domain.NewMoneyFromFractionIn(baseNum, baseDen, currencyOf(baseCur))
+	if err != nil {
+		return scannedRow{}, err
 	}
 
-	return dto, nil
+	return scannedRow{dto: dto, base: base, legacy: legacyDiscount(discPercent, discStart, discEnd)}, nil
 }
@@ -0,0 +1,145 @@
+package repo
+
+import (
+	"context"
+	"math/big"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/api/iterator"
+
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/infra/spannerx"
+	"product-catalog-service/internal/models/m_category_tax_class"
+	"product-catalog-service/internal/models/m_tax_rate"
+	"product-catalog-service/internal/pkg/clock"
+)
+
+type TaxRepo struct {
+	client     *spanner.Client
+	rates      m_tax_rate.Model
+	categories m_category_tax_class.Model
+	clock      clock.Clock
+}
+
+func NewTaxRepo(client *spanner.Client, clk clock.Clock) *TaxRepo {
+	return &TaxRepo{client: client, rates: m_tax_rate.Model{}, categories: m_category_tax_class.Model{}, clock: clk}
+}
+
+func (r *TaxRepo) Rate(ctx context.Context, region domain.Region, class domain.TaxClass) (*domain.TaxRate, error) {
+	tx := r.client.Single()
+	defer tx.Close()
+
+	rates, err := readTaxRates(ctx, tx, region)
+	if err != nil {
+		return nil, err
+	}
+	rate, ok := rates[class]
+	if !ok {
+		return nil, domain.ErrTaxRateNotFound
+	}
+	return rate, nil
+}
+
+func (r *TaxRepo) CategoryClass(ctx context.Context, category string) (domain.TaxClass, error) {
+	tx := r.client.Single()
+	defer tx.Close()
+
+	classes, err := readCategoryTaxClasses(ctx, tx, []string{category})
+	if err != nil {
+		return "", err
+	}
+	return classes[category], nil
+}
+
+func (r *TaxRepo) UpsertRateMut(rate *domain.TaxRate) contracts.Mutation {
+	return spannerx.Wrap(r.rates.InsertOrUpdateMut(map[string]interface{}{
+		m_tax_rate.Region:    string(rate.Region()),
+		m_tax_rate.TaxClass:  string(rate.Class()),
+		m_tax_rate.RateNum:   rate.Rate().Num().Int64(),
+		m_tax_rate.RateDen:   rate.Rate().Denom().Int64(),
+		m_tax_rate.UpdatedAt: r.clock.Now(),
+	}))
+}
+
+func (r *TaxRepo) AssignCategoryMut(category string, class domain.TaxClass) contracts.Mutation {
+	if class == "" {
+		return spannerx.Wrap(r.categories.DeleteMut(category))
+	}
+	return spannerx.Wrap(r.categories.InsertOrUpdateMut(map[string]interface{}{
+		m_category_tax_class.Category:  category,
+		m_category_tax_class.TaxClass:  string(class),
+		m_category_tax_class.UpdatedAt: r.clock.Now(),
+	}))
+}
+
+// readTaxRates loads region's rate for every class it has one for.
+func readTaxRates(ctx context.Context, tx *spanner.ReadOnlyTransaction, region domain.Region) (map[domain.TaxClass]*domain.TaxRate, error) {
+	st := spanner.NewStatement(`
+		SELECT tax_class, rate_numerator, rate_denominator
+		FROM tax_rates
+		WHERE region = @region
+	`)
+	st.Params["region"] = string(region)
+
+	iter := tx.Query(ctx, st)
+	defer iter.Stop()
+
+	out := map[domain.TaxClass]*domain.TaxRate{}
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			return out, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		var (
+			class    string
+			num, den int64
+		)
+		if err := row.Columns(&class, &num, &den); err != nil {
+			return nil, err
+		}
+		rate, err := domain.NewTaxRate(region, domain.TaxClass(class), big.NewRat(num, den))
+		if err != nil {
+			return nil, err
+		}
+		out[rate.Class()] = rate
+	}
+}
+
+// readCategoryTaxClasses loads the classes assigned to any of categories.
+func readCategoryTaxClasses(ctx context.Context, tx *spanner.ReadOnlyTransaction, categories []string) (map[string]domain.TaxClass, error) {
+	out := make(map[string]domain.TaxClass, len(categories))
+	if len(categories) == 0 {
+		return out, nil
+	}
+
+	st := spanner.NewStatement(`
+		SELECT category, tax_class
+		FROM category_tax_classes
+		WHERE category IN UNNEST(@categories)
+	`)
+	st.Params["categories"] = categories
+
+	iter := tx.Query(ctx, st)
+	defer iter.Stop()
+
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			return out, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		var category, class string
+		if err := row.Columns(&category, &class); err != nil {
+			return nil, err
+		}
+		out[category] = domain.TaxClass(class)
+	}
+}
+
+var _ contracts.TaxRepo = (*TaxRepo)(nil)
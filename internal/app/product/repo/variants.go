@@ -0,0 +1,120 @@
+package repo
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/api/iterator"
+
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/models/m_product_variant"
+)
+
+// readVariants loads the variants of the given products, ordered by SKU.
+func readVariants(ctx context.Context, tx *spanner.ReadOnlyTransaction, productIDs []string) (map[string][]*domain.Variant, error) {
+	out := make(map[string][]*domain.Variant, len(productIDs))
+	if len(productIDs) == 0 {
+		return out, nil
+	}
+
+	st := spanner.NewStatement(`
+		SELECT product_id, variant_id, sku, attributes, currency, price_numerator, price_denominator, status
+		FROM product_variants
+		WHERE product_id IN UNNEST(@ids)
+		ORDER BY product_id, sku
+	`)
+	st.Params["ids"] = productIDs
+
+	iter := tx.Query(ctx, st)
+	defer iter.Stop()
+
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			return out, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			productID, variantID, sku, attrs, status string
+			currency                                 spanner.NullString
+			num, den                                 spanner.NullInt64
+		)
+		if err := row.Columns(&productID, &variantID, &sku, &attrs, &currency, &num, &den, &status); err != nil {
+			return nil, err
+		}
+		var attributes map[string]string
+		if err := json.Unmarshal([]byte(attrs), &attributes); err != nil {
+			return nil, err
+		}
+		var price *domain.Money
+		if num.Valid {
+			if price, err = domain.NewMoneyFromFractionIn(num.Int64, den.Int64, domain.Currency(currency.StringVal)); err != nil {
+				return nil, err
+			}
+		}
+		v, err := domain.NewVariant(variantID, sku, attributes, price, domain.VariantStatus(status))
+		if err != nil {
+			return nil, err
+		}
+		out[productID] = append(out[productID], v)
+	}
+}
+
+// variantMuts diffs two sets of variants, keyed by ID, into child-row
+// mutations. The product replaces a variant rather than changing it, so an
+// unchanged variant is the same value in both sets.
+func variantMuts(model m_product_variant.Model, productID string, old, new []*domain.Variant, now time.Time) ([]*spanner.Mutation, error) {
+	stored := map[string]*domain.Variant{}
+	for _, v := range old {
+		stored[v.ID()] = v
+	}
+
+	var muts []*spanner.Mutation
+	for _, v := range new {
+		prev, ok := stored[v.ID()]
+		delete(stored, v.ID())
+		if prev == v {
+			continue
+		}
+		row := map[string]interface{}{
+			m_product_variant.ProductID:  productID,
+			m_product_variant.VariantID:  v.ID(),
+			m_product_variant.SKU:        v.SKU(),
+			m_product_variant.Attributes: attributesJSON(v.Attributes()),
+			m_product_variant.Currency:   spanner.NullString{},
+			m_product_variant.PriceNum:   spanner.NullInt64{},
+			m_product_variant.PriceDen:   spanner.NullInt64{},
+			m_product_variant.Status:     string(v.Status()),
+			m_product_variant.UpdatedAt:  now,
+		}
+		if p := v.Price(); p != nil {
+			num, den, err := fractionOf(p)
+			if err != nil {
+				return nil, err
+			}
+			row[m_product_variant.Currency] = string(p.Currency())
+			row[m_product_variant.PriceNum] = num
+			row[m_product_variant.PriceDen] = den
+		}
+		if !ok {
+			row[m_product_variant.CreatedAt] = now
+			muts = append(muts, model.InsertMut(row))
+		} else {
+			muts = append(muts, model.UpdateMut(row))
+		}
+	}
+	for id := range stored {
+		muts = append(muts, model.DeleteMut(productID, id))
+	}
+	return muts, nil
+}
+
+func attributesJSON(attrs map[string]string) string {
+	b, _ := json.Marshal(attrs) // a map of strings always encodes
+	return string(b)
+}
@@ -0,0 +1,95 @@
+package sweeper
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/repo"
+	"product-catalog-service/internal/app/product/usecases/advance_discounts"
+	"product-catalog-service/internal/pkg/clock"
+	"product-catalog-service/internal/pkg/committer"
+)
+
+type Config struct {
+	BatchSize    int
+	PollInterval time.Duration
+	// Retention is how long expired discounts are kept for quotes and the
+	// lowest price to look back on; zero keeps them.
+	Retention time.Duration
+}
+
+func DefaultConfig() Config {
+	return Config{
+		BatchSize:    100,
+		PollInterval: time.Minute,
+	}
+}
+
+// Sweeper starts scheduled discounts, expires ended ones as their windows
+// pass and prunes those past the retention, so the stored status and the discount events follow the clock
+// rather than the next write to the product.
+type Sweeper struct {
+	schedule contracts.DiscountSchedule
+	advance  *advance_discounts.Interactor
+	clock    clock.Clock
+	cfg      Config
+}
+
+func New(schedule contracts.DiscountSchedule, advance *advance_discounts.Interactor, clk clock.Clock, cfg Config) *Sweeper {
+	def := DefaultConfig()
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = def.BatchSize
+	}
+	if cfg.PollInterval <= 0 {
+		cfg.PollInterval = def.PollInterval
+	}
+	return &Sweeper{schedule: schedule, advance: advance, clock: clk, cfg: cfg}
+}
+
+// Run sweeps until ctx is cancelled. A full batch is followed immediately
+// by another sweep.
+func (s *Sweeper) Run(ctx context.Context) error {
+	for {
+		n, err := s.RunOnce(ctx)
+		if err != nil && ctx.Err() == nil {
+			log.Printf("discount sweeper: %v", err)
+		}
+		if n >= s.cfg.BatchSize && err == nil {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(s.cfg.PollInterval):
+		}
+	}
+}
+
+// RunOnce advances one batch of due products and returns how many were
+// due. A product changed concurrently is left for the next sweep.
+func (s *Sweeper) RunOnce(ctx context.Context) (int, error) {
+	now := s.clock.Now()
+	var pruneBefore time.Time
+	if s.cfg.Retention > 0 {
+		pruneBefore = now.Add(-s.cfg.Retention)
+	}
+	ids, err := s.schedule.DueProducts(ctx, now, pruneBefore, s.cfg.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, id := range ids {
+		err := s.advance.Execute(ctx, advance_discounts.Request{ProductID: id, PruneBefore: pruneBefore})
+		if errors.Is(err, committer.ErrConcurrentModification) || errors.Is(err, repo.ErrProductNotFound) {
+			continue
+		}
+		if err != nil {
+			return len(ids), err
+		}
+	}
+	return len(ids), nil
+}
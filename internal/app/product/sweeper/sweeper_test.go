@@ -0,0 +1,125 @@
+package sweeper
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/app/product/repo/memrepo"
+	"product-catalog-service/internal/app/product/usecases/activate_product"
+	"product-catalog-service/internal/app/product/usecases/advance_discounts"
+	"product-catalog-service/internal/app/product/usecases/apply_discount"
+	"product-catalog-service/internal/app/product/usecases/create_product"
+	"product-catalog-service/internal/infra/memstore"
+	"product-catalog-service/internal/models/m_outbox"
+)
+
+type fakeClock struct{ t time.Time }
+
+func (f *fakeClock) Now() time.Time { return f.t }
+
+func TestRunOnce_StartsThenExpiresScheduledDiscount(t *testing.T) {
+	ctx := context.Background()
+	clk := &fakeClock{t: time.Date(2026, 1, 12, 12, 0, 0, 0, time.UTC)}
+	st := memstore.NewStore()
+	products, outbox, comm := memrepo.NewProductRepo(st, clk), memrepo.NewOutboxRepo(clk), memstore.NewCommitter(st)
+	history := memrepo.NewPriceHistoryRepo(st, clk)
+
+	price, _ := domain.NewMoneyFromFraction(100, 1)
+	if _, err := create_product.New(products, outbox, history, comm, clk).Execute(ctx, create_product.Request{ID: "p1", Name: "n", Category: "c", BasePrice: price}); err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	if err := activate_product.New(products, outbox, comm, clk).Execute(ctx, activate_product.Request{ProductID: "p1"}); err != nil {
+		t.Fatalf("activate: %v", err)
+	}
+	start := clk.t.Add(time.Hour)
+	if err := apply_discount.New(products, outbox, history, comm, clk).Execute(ctx, apply_discount.Request{ProductID: "p1", DiscountID: "d1", Percent: big.NewRat(10, 1), Start: start, End: start.Add(time.Hour)}); err != nil {
+		t.Fatalf("apply discount: %v", err)
+	}
+
+	s := New(memrepo.NewDiscountSchedule(st), advance_discounts.New(products, outbox, history, comm, clk), clk, Config{})
+
+	if n, err := s.RunOnce(ctx); err != nil || n != 0 {
+		t.Fatalf("expected nothing due before the window, got n=%d err=%v", n, err)
+	}
+
+	clk.t = start
+	if n, err := s.RunOnce(ctx); err != nil || n != 1 {
+		t.Fatalf("expected one product due at start, got n=%d err=%v", n, err)
+	}
+	p, err := products.GetByID(ctx, "p1")
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if ds := p.DiscountsAt(clk.t); len(ds) != 1 || ds[0].Status() != domain.DiscountActive {
+		t.Fatalf("expected discount started, got %v", ds)
+	}
+
+	clk.t = start.Add(time.Hour)
+	if _, err := s.RunOnce(ctx); err != nil {
+		t.Fatalf("sweep: %v", err)
+	}
+	if p, _ = products.GetByID(ctx, "p1"); len(p.Discounts()) != 1 || p.Discounts()[0].Status() != domain.DiscountExpired {
+		t.Fatalf("expected ended discount expired, got %v", p.Discounts())
+	}
+	if n, _ := s.RunOnce(ctx); n != 0 {
+		t.Fatalf("expected nothing left due, got %d", n)
+	}
+
+	var types []string
+	for _, row := range st.Snapshot().Rows(m_outbox.Table) {
+		types = append(types, row[m_outbox.EventType].(string))
+	}
+	for _, want := range []string{"discount.scheduled", "discount.started", "discount.expired"} {
+		found := false
+		for _, got := range types {
+			found = found || got == want
+		}
+		if !found {
+			t.Fatalf("expected %s in outbox, got %v", want, types)
+		}
+	}
+}
+
+func TestRunOnce_PrunesExpiredDiscountsPastRetention(t *testing.T) {
+	ctx := context.Background()
+	clk := &fakeClock{t: time.Date(2026, 1, 12, 12, 0, 0, 0, time.UTC)}
+	st := memstore.NewStore()
+	products, outbox, comm := memrepo.NewProductRepo(st, clk), memrepo.NewOutboxRepo(clk), memstore.NewCommitter(st)
+	history := memrepo.NewPriceHistoryRepo(st, clk)
+
+	price, _ := domain.NewMoneyFromFraction(100, 1)
+	if _, err := create_product.New(products, outbox, history, comm, clk).Execute(ctx, create_product.Request{ID: "p1", Name: "n", Category: "c", BasePrice: price}); err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	if err := activate_product.New(products, outbox, comm, clk).Execute(ctx, activate_product.Request{ProductID: "p1"}); err != nil {
+		t.Fatalf("activate: %v", err)
+	}
+	end := clk.t.Add(time.Hour)
+	if err := apply_discount.New(products, outbox, history, comm, clk).Execute(ctx, apply_discount.Request{ProductID: "p1", DiscountID: "d1", Percent: big.NewRat(10, 1), Start: clk.t, End: end}); err != nil {
+		t.Fatalf("apply discount: %v", err)
+	}
+
+	s := New(memrepo.NewDiscountSchedule(st), advance_discounts.New(products, outbox, history, comm, clk), clk, Config{Retention: 24 * time.Hour})
+
+	// Expired discounts stay for the retention, then go
+	clk.t = end.Add(24 * time.Hour)
+	if _, err := s.RunOnce(ctx); err != nil {
+		t.Fatalf("sweep: %v", err)
+	}
+	if p, _ := products.GetByID(ctx, "p1"); len(p.Discounts()) != 1 || p.Discounts()[0].Status() != domain.DiscountExpired {
+		t.Fatalf("expected the discount kept as expired, got %v", p.Discounts())
+	}
+	clk.t = clk.t.Add(time.Second)
+	if n, err := s.RunOnce(ctx); err != nil || n != 1 {
+		t.Fatalf("expected the product due for pruning, got n=%d err=%v", n, err)
+	}
+	if p, _ := products.GetByID(ctx, "p1"); len(p.Discounts()) != 0 {
+		t.Fatalf("expected the discount pruned, got %v", p.Discounts())
+	}
+	if n, _ := s.RunOnce(ctx); n != 0 {
+		t.Fatalf("expected nothing left due, got %d", n)
+	}
+}
@@ -0,0 +1,138 @@
+package product
+
+import (
+	"context"
+	"errors"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/app/product/idempotency"
+	"product-catalog-service/internal/app/product/queries/get_product"
+	"product-catalog-service/internal/app/product/queries/quote_price"
+	"product-catalog-service/internal/app/product/repo"
+	"product-catalog-service/internal/pkg/committer"
+	"product-catalog-service/internal/pkg/pagetoken"
+)
+
+// invalidFields maps validation errors to the request fields they concern.
+var invalidFields = []struct {
+	err    error
+	fields []string
+}{
+	{domain.ErrInvalidProductID, []string{"product_id"}},
+	{domain.ErrInvalidProductName, []string{"name"}},
+	{domain.ErrInvalidCategory, []string{"category"}},
+	{domain.ErrInvalidMoney, []string{"base_price_numerator", "base_price_denominator"}},
+	{domain.ErrInvalidCurrency, []string{"currency"}},
+	{domain.ErrBaseCurrencyPrice, []string{"currency"}},
+	{domain.ErrInvalidExchangeRate, []string{"rate_numerator", "rate_denominator"}},
+	{domain.ErrInvalidTaxRate, []string{"rate_numerator", "rate_denominator"}},
+	{domain.ErrInvalidTaxClass, []string{"tax_class"}},
+	{domain.ErrInvalidRegion, []string{"region"}},
+	{domain.ErrInvalidPriceTier, []string{"tiers"}},
+	{domain.ErrPriceTiersNotContiguous, []string{"tiers"}},
+	{domain.ErrInvalidQuantity, []string{"quantity"}},
+	{domain.ErrInvalidVariantID, []string{"variant_id"}},
+	{domain.ErrInvalidSKU, []string{"sku"}},
+	{domain.ErrInvalidGTIN, []string{"gtin"}},
+	{domain.ErrInvalidAttributes, []string{"attributes"}},
+	{domain.ErrInvalidVariantStatus, []string{"status"}},
+	{domain.ErrInvalidPriceListID, []string{"price_list_id"}},
+	{domain.ErrInvalidPriceListName, []string{"name"}},
+	{domain.ErrInvalidPriceListPeriod, []string{"valid_from_timestamp", "valid_to_timestamp"}},
+	{domain.ErrInvalidCustomerGroup, []string{"customer_group"}},
+	{domain.ErrInvalidDiscountID, []string{"discount_id"}},
+	{domain.ErrInvalidDiscountPercent, []string{"percent_numerator", "percent_denominator"}},
+	{domain.ErrInvalidStackingPolicy, []string{"stacking"}},
+	{domain.ErrInvalidDiscountKind, []string{"kind"}},
+	{domain.ErrInvalidDiscountAmount, []string{"amount_numerator", "amount_denominator"}},
+	{domain.ErrInvalidDiscountPeriod, []string{"start_timestamp", "end_timestamp"}},
+	{pagetoken.ErrInvalid, []string{"page_token"}},
+	{quote_price.ErrBatchTooLarge, []string{"product_ids"}},
+	{get_product.ErrBatchTooLarge, []string{"product_ids"}},
+	{idempotency.ErrInvalidKey, []string{"idempotency_key"}},
+	{idempotency.ErrKeyReused, []string{"idempotency_key"}},
+}
+
+var preconditionErrors = []error{
+	domain.ErrProductNotActive,
+	domain.ErrDiscountOverlaps,
+	domain.ErrCurrencyMismatch,
+	domain.ErrExchangeRateNotFound,
+	domain.ErrTaxRateNotFound,
+	domain.ErrRestoreWindowExpired,
+}
+
+// toStatus translates application errors into gRPC status errors so that
+// clients can branch on codes instead of messages.
+func toStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := err.(interface{ GRPCStatus() *status.Status }); ok {
+		return err
+	}
+
+	switch {
+	case errors.Is(err, repo.ErrProductNotFound), errors.Is(err, domain.ErrDiscountNotFound),
+		errors.Is(err, domain.ErrPriceNotFound), errors.Is(err, repo.ErrPriceListNotFound),
+		errors.Is(err, domain.ErrPriceListEntryNotFound), errors.Is(err, domain.ErrVariantNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, domain.ErrVariantExists), errors.Is(err, domain.ErrDuplicateSKU),
+		errors.Is(err, domain.ErrDuplicateGTIN):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, domain.ErrMoneyOverflow):
+		return status.Error(codes.OutOfRange, err.Error())
+	case errors.Is(err, committer.ErrConcurrentModification):
+		return status.Error(codes.Aborted, err.Error())
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	}
+
+	for _, v := range invalidFields {
+		if errors.Is(err, v.err) {
+			return invalidArgument(err, v.fields...)
+		}
+	}
+	for _, pe := range preconditionErrors {
+		if errors.Is(err, pe) {
+			return status.Error(codes.FailedPrecondition, err.Error())
+		}
+	}
+
+	if c := spanner.ErrCode(err); c != codes.Unknown {
+		return status.Error(c, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
+
+// priceStatus is toStatus for RPCs whose price is not the base price, so
+// invalid money points at their own fields.
+func priceStatus(err error, fields ...string) error {
+	if errors.Is(err, domain.ErrInvalidMoney) {
+		return invalidArgument(err, fields...)
+	}
+	return toStatus(err)
+}
+
+func invalidArgument(err error, fields ...string) error {
+	br := &errdetails.BadRequest{}
+	for _, f := range fields {
+		br.FieldViolations = append(br.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       f,
+			Description: err.Error(),
+		})
+	}
+
+	st, derr := status.New(codes.InvalidArgument, err.Error()).WithDetails(br)
+	if derr != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return st.Err()
+}
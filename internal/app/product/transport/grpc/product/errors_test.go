@@ -0,0 +1,81 @@
+package product
+
+import (
+	"fmt"
+	"testing"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/app/product/repo"
+	"product-catalog-service/internal/pkg/committer"
+)
+
+func TestToStatus_Codes(t *testing.T) {
+	cases := []struct {
+		err  error
+		want codes.Code
+	}{
+		{repo.ErrProductNotFound, codes.NotFound},
+		{fmt.Errorf("load: %w", repo.ErrProductNotFound), codes.NotFound},
+		{domain.ErrProductNotActive, codes.FailedPrecondition},
+		{domain.ErrDiscountOverlaps, codes.FailedPrecondition},
+		{domain.ErrDuplicateSKU, codes.AlreadyExists},
+		{domain.ErrDuplicateGTIN, codes.AlreadyExists},
+		{committer.ErrConcurrentModification, codes.Aborted},
+		{spanner.ToSpannerError(status.Error(codes.Aborted, "txn aborted")), codes.Aborted},
+		{status.Error(codes.PermissionDenied, "nope"), codes.PermissionDenied},
+		{fmt.Errorf("boom"), codes.Internal},
+	}
+
+	for _, c := range cases {
+		if got := status.Code(toStatus(c.err)); got != c.want {
+			t.Fatalf("%v: expected %s, got %s", c.err, c.want, got)
+		}
+	}
+	if toStatus(nil) != nil {
+		t.Fatalf("expected nil for nil error")
+	}
+}
+
+func TestToStatus_ValidationErrorCarriesFieldViolations(t *testing.T) {
+	st := status.Convert(toStatus(domain.ErrInvalidProductName))
+	if st.Code() != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument, got %s", st.Code())
+	}
+
+	var br *errdetails.BadRequest
+	for _, d := range st.Details() {
+		if v, ok := d.(*errdetails.BadRequest); ok {
+			br = v
+		}
+	}
+	if br == nil || len(br.FieldViolations) != 1 || br.FieldViolations[0].Field != "name" {
+		t.Fatalf("expected field violation on name, got %v", br)
+	}
+}
+
+func TestPriceStatus_NamesTheRPCsPriceFields(t *testing.T) {
+	st := status.Convert(priceStatus(fmt.Errorf("set price: %w", domain.ErrInvalidMoney), "price_numerator", "price_denominator"))
+	if st.Code() != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument, got %s", st.Code())
+	}
+
+	var fields []string
+	for _, d := range st.Details() {
+		if br, ok := d.(*errdetails.BadRequest); ok {
+			for _, v := range br.FieldViolations {
+				fields = append(fields, v.Field)
+			}
+		}
+	}
+	if len(fields) != 2 || fields[0] != "price_numerator" || fields[1] != "price_denominator" {
+		t.Fatalf("expected violations on price_numerator and price_denominator, got %v", fields)
+	}
+	if got := status.Code(priceStatus(repo.ErrProductNotFound, "price_numerator")); got != codes.NotFound {
+		t.Fatalf("expected other errors to map as toStatus does, got %s", got)
+	}
+}
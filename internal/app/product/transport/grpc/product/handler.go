@@ -9,90 +9,746 @@ import (
 
 	"product-catalog-service/internal/app/product/contracts"
 	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/app/product/domain/services"
+	"product-catalog-service/internal/app/product/idempotency"
 	"product-catalog-service/internal/app/product/queries/get_product"
+	"product-catalog-service/internal/app/product/queries/list_exchange_rates"
+	"product-catalog-service/internal/app/product/queries/list_price_history"
 	"product-catalog-service/internal/app/product/queries/list_products"
+	"product-catalog-service/internal/app/product/queries/quote_price"
 	"product-catalog-service/internal/app/product/usecases/activate_product"
+	"product-catalog-service/internal/app/product/usecases/add_variant"
 	"product-catalog-service/internal/app/product/usecases/apply_discount"
 	"product-catalog-service/internal/app/product/usecases/archive_product"
+	"product-catalog-service/internal/app/product/usecases/change_base_price"
+	"product-catalog-service/internal/app/product/usecases/create_price_list"
 	"product-catalog-service/internal/app/product/usecases/create_product"
 	"product-catalog-service/internal/app/product/usecases/deactivate_product"
 	"product-catalog-service/internal/app/product/usecases/remove_discount"
+	"product-catalog-service/internal/app/product/usecases/remove_price"
+	"product-catalog-service/internal/app/product/usecases/remove_price_list_entry"
+	"product-catalog-service/internal/app/product/usecases/remove_variant"
+	"product-catalog-service/internal/app/product/usecases/restore_product"
+	"product-catalog-service/internal/app/product/usecases/set_category_tax_class"
+	"product-catalog-service/internal/app/product/usecases/set_exchange_rate"
+	"product-catalog-service/internal/app/product/usecases/set_identifiers"
+	"product-catalog-service/internal/app/product/usecases/set_price"
+	"product-catalog-service/internal/app/product/usecases/set_price_list_entry"
+	"product-catalog-service/internal/app/product/usecases/set_price_tiers"
+	"product-catalog-service/internal/app/product/usecases/set_tax_class"
+	"product-catalog-service/internal/app/product/usecases/set_tax_rate"
+	"product-catalog-service/internal/app/product/usecases/update_price_list"
 	"product-catalog-service/internal/app/product/usecases/update_product"
+	"product-catalog-service/internal/app/product/usecases/update_variant"
 	pb "product-catalog-service/proto/product/v1"
 )
 
+// Deps are the use cases and queries the handler delegates to.
+type Deps struct {
+	CreateProduct        *create_product.Interactor
+	UpdateProduct        *update_product.Interactor
+	ActivateProduct      *activate_product.Interactor
+	DeactivateProduct    *deactivate_product.Interactor
+	ArchiveProduct       *archive_product.Interactor
+	RestoreProduct       *restore_product.Interactor
+	ApplyDiscount        *apply_discount.Interactor
+	RemoveDiscount       *remove_discount.Interactor
+	SetPrice             *set_price.Interactor
+	RemovePrice          *remove_price.Interactor
+	ChangeBasePrice      *change_base_price.Interactor
+	SetTaxClass          *set_tax_class.Interactor
+	SetIdentifiers       *set_identifiers.Interactor
+	SetPriceTiers        *set_price_tiers.Interactor
+	AddVariant           *add_variant.Interactor
+	UpdateVariant        *update_variant.Interactor
+	RemoveVariant        *remove_variant.Interactor
+	SetExchangeRate      *set_exchange_rate.Interactor
+	SetTaxRate           *set_tax_rate.Interactor
+	SetCategoryTaxClass  *set_category_tax_class.Interactor
+	CreatePriceList      *create_price_list.Interactor
+	UpdatePriceList      *update_price_list.Interactor
+	SetPriceListEntry    *set_price_list_entry.Interactor
+	RemovePriceListEntry *remove_price_list_entry.Interactor
+	GetProduct           *get_product.Query
+	ListProducts         *list_products.Query
+	QuotePrice           *quote_price.Query
+	ListPriceHistory     *list_price_history.Query
+	ListExchangeRates    *list_exchange_rates.Query
+	Idempotency          *idempotency.Guard
+}
+
 type Handler struct {
 	pb.UnimplementedProductServiceServer
-	cUC  *create_product.Interactor
-	uUC  *update_product.Interactor
-	aUC  *activate_product.Interactor
-	dUC  *deactivate_product.Interactor
-	rUC  *archive_product.Interactor
-	adUC *apply_discount.Interactor
-	rdUC *remove_discount.Interactor
-	gpQ  *get_product.Query
-	lpQ  *list_products.Query
+	deps Deps
 }
 
-func NewHandler(c *create_product.Interactor, u *update_product.Interactor, a *activate_product.Interactor, d *deactivate_product.Interactor, r *archive_product.Interactor, ad *apply_discount.Interactor, rd *remove_discount.Interactor, gp *get_product.Query, lp *list_products.Query) *Handler {
-	return &Handler{cUC: c, uUC: u, aUC: a, dUC: d, rUC: r, adUC: ad, rdUC: rd, gpQ: gp, lpQ: lp}
+func NewHandler(deps Deps) *Handler {
+	return &Handler{deps: deps}
 }
 
 func (h *Handler) CreateProduct(ctx context.Context, req *pb.CreateProductRequest) (*pb.CreateProductReply, error) {
-	bp, err := domain.NewMoneyFromFraction(req.BasePriceNumerator, req.BasePriceDenominator)
+	currency, err := domain.ParseCurrency(req.Currency)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	bp, err := domain.NewMoneyFromFractionIn(req.BasePriceNumerator, req.BasePriceDenominator, currency)
 	if err != nil {
-		return nil, err
+		return nil, toStatus(err)
 	}
-	id, err := h.cUC.Execute(ctx, create_product.Request{ID: uuid.NewString(), Name: req.Name, Description: req.Description, Category: req.Category, BasePrice: bp})
+	gtin, err := domain.ParseGTIN(req.Gtin)
 	if err != nil {
-		return nil, err
+		return nil, toStatus(err)
 	}
-	return &pb.CreateProductReply{ProductId: id}, nil
+	reply := &pb.CreateProductReply{ProductId: uuid.NewString()}
+	err = h.idempotent(ctx, "CreateProduct", req, reply, func(ctx context.Context) error {
+		_, err := h.deps.CreateProduct.Execute(ctx, create_product.Request{ID: reply.ProductId, Name: req.Name, Description: req.Description, Category: req.Category, SKU: req.Sku, GTIN: gtin, BasePrice: bp})
+		return err
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return reply, nil
 }
 
 func (h *Handler) UpdateProduct(ctx context.Context, req *pb.UpdateProductRequest) (*pb.UpdateProductReply, error) {
-	return &pb.UpdateProductReply{}, h.uUC.Execute(ctx, update_product.Request{ProductID: req.ProductId, Name: req.Name, Description: req.Description, Category: req.Category})
+	reply := &pb.UpdateProductReply{}
+	return reply, toStatus(h.idempotent(ctx, "UpdateProduct", req, reply, func(ctx context.Context) error {
+		return h.deps.UpdateProduct.Execute(ctx, update_product.Request{ProductID: req.ProductId, Name: req.Name, Description: req.Description, Category: req.Category, ExpectedVersion: req.ExpectedVersion})
+	}))
 }
 
 func (h *Handler) ActivateProduct(ctx context.Context, req *pb.ActivateProductRequest) (*pb.ActivateProductReply, error) {
-	return &pb.ActivateProductReply{}, h.aUC.Execute(ctx, activate_product.Request{ProductID: req.ProductId})
+	reply := &pb.ActivateProductReply{}
+	return reply, toStatus(h.idempotent(ctx, "ActivateProduct", req, reply, func(ctx context.Context) error {
+		return h.deps.ActivateProduct.Execute(ctx, activate_product.Request{ProductID: req.ProductId, ExpectedVersion: req.ExpectedVersion})
+	}))
 }
 
 func (h *Handler) DeactivateProduct(ctx context.Context, req *pb.DeactivateProductRequest) (*pb.DeactivateProductReply, error) {
-	return &pb.DeactivateProductReply{}, h.dUC.Execute(ctx, deactivate_product.Request{ProductID: req.ProductId})
+	reply := &pb.DeactivateProductReply{}
+	return reply, toStatus(h.idempotent(ctx, "DeactivateProduct", req, reply, func(ctx context.Context) error {
+		return h.deps.DeactivateProduct.Execute(ctx, deactivate_product.Request{ProductID: req.ProductId, ExpectedVersion: req.ExpectedVersion})
+	}))
+}
+
+func (h *Handler) ArchiveProduct(ctx context.Context, req *pb.ArchiveProductRequest) (*pb.ArchiveProductReply, error) {
+	reply := &pb.ArchiveProductReply{}
+	return reply, toStatus(h.idempotent(ctx, "ArchiveProduct", req, reply, func(ctx context.Context) error {
+		return h.deps.ArchiveProduct.Execute(ctx, archive_product.Request{ProductID: req.ProductId, ExpectedVersion: req.ExpectedVersion})
+	}))
+}
+
+func (h *Handler) RestoreProduct(ctx context.Context, req *pb.RestoreProductRequest) (*pb.RestoreProductReply, error) {
+	reply := &pb.RestoreProductReply{}
+	return reply, toStatus(h.idempotent(ctx, "RestoreProduct", req, reply, func(ctx context.Context) error {
+		return h.deps.RestoreProduct.Execute(ctx, restore_product.Request{ProductID: req.ProductId, ExpectedVersion: req.ExpectedVersion})
+	}))
 }
 
 func (h *Handler) ApplyDiscount(ctx context.Context, req *pb.ApplyDiscountRequest) (*pb.ApplyDiscountReply, error) {
-	return &pb.ApplyDiscountReply{}, h.adUC.Execute(ctx, apply_discount.Request{ProductID: req.ProductId, Percent: big.NewRat(req.PercentNumerator, req.PercentDenominator), Start: time.Unix(req.StartTimestamp, 0), End: time.Unix(req.EndTimestamp, 0)})
+	stacking, err := domain.ParseStackingPolicy(req.Stacking)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	kind, err := domain.ParseDiscountKind(req.Kind)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	var (
+		amount         *big.Rat
+		amountCurrency domain.Currency
+	)
+	if kind != domain.DiscountPercentage {
+		if amount = ratOrNil(req.AmountNumerator, req.AmountDenominator); amount == nil {
+			return nil, toStatus(domain.ErrInvalidDiscountAmount)
+		}
+		if req.AmountCurrency != "" {
+			if amountCurrency, err = domain.ParseCurrency(req.AmountCurrency); err != nil {
+				return nil, invalidArgument(err, "amount_currency")
+			}
+		}
+	}
+	reply := &pb.ApplyDiscountReply{DiscountId: uuid.NewString()}
+	return reply, toStatus(h.idempotent(ctx, "ApplyDiscount", req, reply, func(ctx context.Context) error {
+		return h.deps.ApplyDiscount.Execute(ctx, apply_discount.Request{ProductID: req.ProductId, DiscountID: reply.DiscountId, Kind: kind, Percent: ratOrNil(req.PercentNumerator, req.PercentDenominator), Amount: amount, AmountCurrency: amountCurrency, Start: time.Unix(req.StartTimestamp, 0), End: time.Unix(req.EndTimestamp, 0), Priority: req.Priority, Stacking: stacking, ExpectedVersion: req.ExpectedVersion})
+	}))
 }
 
 func (h *Handler) RemoveDiscount(ctx context.Context, req *pb.RemoveDiscountRequest) (*pb.RemoveDiscountReply, error) {
-	return &pb.RemoveDiscountReply{}, h.rdUC.Execute(ctx, remove_discount.Request{ProductID: req.ProductId})
+	reply := &pb.RemoveDiscountReply{}
+	return reply, toStatus(h.idempotent(ctx, "RemoveDiscount", req, reply, func(ctx context.Context) error {
+		return h.deps.RemoveDiscount.Execute(ctx, remove_discount.Request{ProductID: req.ProductId, DiscountID: req.DiscountId, ExpectedVersion: req.ExpectedVersion})
+	}))
+}
+
+func (h *Handler) SetProductPrice(ctx context.Context, req *pb.SetProductPriceRequest) (*pb.SetProductPriceReply, error) {
+	currency, err := parseRequestedCurrency(req.Currency)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	price, err := domain.NewMoneyFromFractionIn(req.PriceNumerator, req.PriceDenominator, currency)
+	if err != nil {
+		return nil, invalidArgument(err, "price_numerator", "price_denominator")
+	}
+	reply := &pb.SetProductPriceReply{}
+	return reply, priceStatus(h.idempotent(ctx, "SetProductPrice", req, reply, func(ctx context.Context) error {
+		return h.deps.SetPrice.Execute(ctx, set_price.Request{ProductID: req.ProductId, Price: price, ExpectedVersion: req.ExpectedVersion})
+	}), "price_numerator", "price_denominator")
+}
+
+func (h *Handler) RemoveProductPrice(ctx context.Context, req *pb.RemoveProductPriceRequest) (*pb.RemoveProductPriceReply, error) {
+	currency, err := parseRequestedCurrency(req.Currency)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	reply := &pb.RemoveProductPriceReply{}
+	return reply, toStatus(h.idempotent(ctx, "RemoveProductPrice", req, reply, func(ctx context.Context) error {
+		return h.deps.RemovePrice.Execute(ctx, remove_price.Request{ProductID: req.ProductId, Currency: currency, ExpectedVersion: req.ExpectedVersion})
+	}))
+}
+
+func (h *Handler) ChangeBasePrice(ctx context.Context, req *pb.ChangeBasePriceRequest) (*pb.ChangeBasePriceReply, error) {
+	price := ratOrNil(req.BasePriceNumerator, req.BasePriceDenominator)
+	if price == nil {
+		return nil, invalidArgument(domain.ErrInvalidMoney, "base_price_numerator", "base_price_denominator")
+	}
+	reply := &pb.ChangeBasePriceReply{}
+	return reply, toStatus(h.idempotent(ctx, "ChangeBasePrice", req, reply, func(ctx context.Context) error {
+		return h.deps.ChangeBasePrice.Execute(ctx, change_base_price.Request{ProductID: req.ProductId, BasePrice: price, ExpectedVersion: req.ExpectedVersion})
+	}))
+}
+
+func (h *Handler) SetProductTaxClass(ctx context.Context, req *pb.SetProductTaxClassRequest) (*pb.SetProductTaxClassReply, error) {
+	class, err := domain.ParseTaxClass(req.TaxClass)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	reply := &pb.SetProductTaxClassReply{}
+	return reply, toStatus(h.idempotent(ctx, "SetProductTaxClass", req, reply, func(ctx context.Context) error {
+		return h.deps.SetTaxClass.Execute(ctx, set_tax_class.Request{ProductID: req.ProductId, TaxClass: class, ExpectedVersion: req.ExpectedVersion})
+	}))
+}
+
+func (h *Handler) SetProductIdentifiers(ctx context.Context, req *pb.SetProductIdentifiersRequest) (*pb.SetProductIdentifiersReply, error) {
+	gtin, err := domain.ParseGTIN(req.Gtin)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	reply := &pb.SetProductIdentifiersReply{}
+	return reply, toStatus(h.idempotent(ctx, "SetProductIdentifiers", req, reply, func(ctx context.Context) error {
+		return h.deps.SetIdentifiers.Execute(ctx, set_identifiers.Request{ProductID: req.ProductId, SKU: req.Sku, GTIN: gtin, ExpectedVersion: req.ExpectedVersion})
+	}))
+}
+
+func (h *Handler) SetPriceTiers(ctx context.Context, req *pb.SetPriceTiersRequest) (*pb.SetPriceTiersReply, error) {
+	var tiers []set_price_tiers.Tier
+	for _, t := range req.Tiers {
+		price := ratOrNil(t.UnitPriceNumerator, t.UnitPriceDenominator)
+		if price == nil {
+			return nil, invalidArgument(domain.ErrInvalidMoney, "unit_price_numerator", "unit_price_denominator")
+		}
+		tiers = append(tiers, set_price_tiers.Tier{MinQuantity: t.MinQuantity, MaxQuantity: t.MaxQuantity, UnitPrice: price})
+	}
+	reply := &pb.SetPriceTiersReply{}
+	return reply, priceStatus(h.idempotent(ctx, "SetPriceTiers", req, reply, func(ctx context.Context) error {
+		return h.deps.SetPriceTiers.Execute(ctx, set_price_tiers.Request{ProductID: req.ProductId, Tiers: tiers, ExpectedVersion: req.ExpectedVersion})
+	}), "unit_price_numerator", "unit_price_denominator")
+}
+
+func (h *Handler) AddVariant(ctx context.Context, req *pb.AddVariantRequest) (*pb.AddVariantReply, error) {
+	reply := &pb.AddVariantReply{VariantId: uuid.NewString()}
+	err := h.idempotent(ctx, "AddVariant", req, reply, func(ctx context.Context) error {
+		return h.deps.AddVariant.Execute(ctx, add_variant.Request{ProductID: req.ProductId, VariantID: reply.VariantId, SKU: req.Sku, Attributes: req.Attributes, Price: ratOrNil(req.PriceNumerator, req.PriceDenominator), Status: req.Status, ExpectedVersion: req.ExpectedVersion})
+	})
+	if err != nil {
+		return nil, priceStatus(err, "price_numerator", "price_denominator")
+	}
+	return reply, nil
+}
+
+func (h *Handler) UpdateVariant(ctx context.Context, req *pb.UpdateVariantRequest) (*pb.UpdateVariantReply, error) {
+	reply := &pb.UpdateVariantReply{}
+	return reply, priceStatus(h.idempotent(ctx, "UpdateVariant", req, reply, func(ctx context.Context) error {
+		return h.deps.UpdateVariant.Execute(ctx, update_variant.Request{ProductID: req.ProductId, VariantID: req.VariantId, SKU: req.Sku, Attributes: req.Attributes, Price: ratOrNil(req.PriceNumerator, req.PriceDenominator), Status: req.Status, ExpectedVersion: req.ExpectedVersion})
+	}), "price_numerator", "price_denominator")
+}
+
+func (h *Handler) RemoveVariant(ctx context.Context, req *pb.RemoveVariantRequest) (*pb.RemoveVariantReply, error) {
+	reply := &pb.RemoveVariantReply{}
+	return reply, toStatus(h.idempotent(ctx, "RemoveVariant", req, reply, func(ctx context.Context) error {
+		return h.deps.RemoveVariant.Execute(ctx, remove_variant.Request{ProductID: req.ProductId, VariantID: req.VariantId, ExpectedVersion: req.ExpectedVersion})
+	}))
 }
 
 func (h *Handler) GetProduct(ctx context.Context, req *pb.GetProductRequest) (*pb.GetProductReply, error) {
-	d, err := h.gpQ.Execute(ctx, req.ProductId)
+	currency, err := parseOptionalCurrency(req.Currency)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	region, err := parseOptionalRegion(req.Region)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	lists, err := parsePriceListSelector(req.PriceListId, req.CustomerGroup)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	d, err := h.deps.GetProduct.Execute(ctx, req.ProductId, currency, string(region), lists)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return productOf(d), nil
+}
+
+func (h *Handler) GetProductBySku(ctx context.Context, req *pb.GetProductBySkuRequest) (*pb.GetProductReply, error) {
+	currency, err := parseOptionalCurrency(req.Currency)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	region, err := parseOptionalRegion(req.Region)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	lists, err := parsePriceListSelector(req.PriceListId, req.CustomerGroup)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	d, err := h.deps.GetProduct.ExecuteBySKU(ctx, req.Sku, req.Gtin, currency, string(region), lists)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return productOf(d), nil
+}
+
+func (h *Handler) BatchGetProducts(ctx context.Context, req *pb.BatchGetProductsRequest) (*pb.BatchGetProductsReply, error) {
+	currency, err := parseOptionalCurrency(req.Currency)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	region, err := parseOptionalRegion(req.Region)
 	if err != nil {
-		return nil, err
+		return nil, toStatus(err)
 	}
-	var disc *pb.Discount
-	if d.DiscountPct != "" {
-		p, _ := new(big.Rat).SetString(d.DiscountPct)
-		s, _ := time.Parse(time.RFC3339, d.DiscountStart)
-		e, _ := time.Parse(time.RFC3339, d.DiscountEnd)
-		disc = &pb.Discount{PercentNumerator: p.Num().Int64(), PercentDenominator: p.Denom().Int64(), StartTimestamp: s.Unix(), EndTimestamp: e.Unix()}
+	lists, err := parsePriceListSelector(req.PriceListId, req.CustomerGroup)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	ds, err := h.deps.GetProduct.ExecuteBatch(ctx, req.ProductIds, currency, string(region), lists)
+	if err != nil {
+		return nil, toStatus(err)
 	}
-	return &pb.GetProductReply{ProductId: d.ID, Name: d.Name, Description: d.Description, Category: d.Category, BasePriceNumerator: d.BasePriceNum, BasePriceDenominator: d.BasePriceDen, Status: d.Status, Discount: disc}, nil
+	out := &pb.BatchGetProductsReply{}
+	for _, d := range ds {
+		out.Products = append(out.Products, productOf(d))
+	}
+	return out, nil
 }
 
 func (h *Handler) ListProducts(ctx context.Context, req *pb.ListProductsRequest) (*pb.ListProductsReply, error) {
-	r, err := h.lpQ.Execute(ctx, contracts.ListProductsFilter{Category: req.Category, OnlyActive: true, Limit: req.PageSize, PageToken: req.PageToken})
+	currency, err := parseOptionalCurrency(req.Currency)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	region, err := parseOptionalRegion(req.Region)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	lists, err := parsePriceListSelector(req.PriceListId, req.CustomerGroup)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	r, err := h.deps.ListProducts.Execute(ctx, contracts.ListProductsFilter{Category: req.Category, OnlyActive: true, Currency: currency, Region: string(region), PriceLists: lists, Limit: req.PageSize, PageToken: req.PageToken})
 	if err != nil {
-		return nil, err
+		return nil, toStatus(err)
 	}
 	var ps []*pb.ProductInfo
 	for _, i := range r.Items {
-		ps = append(ps, &pb.ProductInfo{ProductId: i.ID, Name: i.Name, Category: i.Category, Status: i.Status})
+		ps = append(ps, &pb.ProductInfo{ProductId: i.ID, Name: i.Name, Category: i.Category, Status: i.Status, Price: priceOf(i), Discount: discountOf(i), Discounts: discountsOf(i), Prices: pricesOf(i), LowestPrice: lowestPriceOf(i), LowestPriceDays: i.LowestPriceDays, TaxClass: i.TaxClass, Tax: taxOf(i.Tax), PriceListId: i.PriceListID, Variants: variantsOf(i), Sku: i.SKU, Gtin: i.GTIN})
 	}
 	return &pb.ListProductsReply{Products: ps, NextPageToken: r.NextPageToken}, nil
 }
+
+func (h *Handler) QuotePrice(ctx context.Context, req *pb.QuotePriceRequest) (*pb.QuotePriceReply, error) {
+	currency, err := parseOptionalCurrency(req.Currency)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	region, err := parseOptionalRegion(req.Region)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	lists, err := parsePriceListSelector(req.PriceListId, req.CustomerGroup)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	q, err := h.deps.QuotePrice.Execute(ctx, req.ProductId, unixOrZero(req.AtTimestamp), domain.Currency(currency), region, req.Quantity, lists)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.QuotePriceReply{Quote: quoteOf(q)}, nil
+}
+
+func (h *Handler) BatchQuotePrices(ctx context.Context, req *pb.BatchQuotePricesRequest) (*pb.BatchQuotePricesReply, error) {
+	currency, err := parseOptionalCurrency(req.Currency)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	region, err := parseOptionalRegion(req.Region)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	lists, err := parsePriceListSelector(req.PriceListId, req.CustomerGroup)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	qs, err := h.deps.QuotePrice.ExecuteBatch(ctx, req.ProductIds, unixOrZero(req.AtTimestamp), domain.Currency(currency), region, req.Quantity, lists)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	out := &pb.BatchQuotePricesReply{}
+	for _, q := range qs {
+		out.Quotes = append(out.Quotes, quoteOf(q))
+	}
+	return out, nil
+}
+
+func (h *Handler) ListPriceHistory(ctx context.Context, req *pb.ListPriceHistoryRequest) (*pb.ListPriceHistoryReply, error) {
+	currency, err := parseOptionalCurrency(req.Currency)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	res, err := h.deps.ListPriceHistory.Execute(ctx, list_price_history.Request{ProductID: req.ProductId, Currency: domain.Currency(currency), Since: unixOrZero(req.SinceTimestamp), PageSize: req.PageSize, PageToken: req.PageToken})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	out := &pb.ListPriceHistoryReply{NextPageToken: res.NextPageToken}
+	for _, iv := range res.Intervals {
+		pi := &pb.PriceInterval{Currency: string(iv.Currency), FromTimestamp: iv.From.Unix(), Price: breakdownOf(iv.PriceBreakdown, iv.Decimal)}
+		if !iv.To.IsZero() {
+			pi.ToTimestamp = iv.To.Unix()
+		}
+		out.Intervals = append(out.Intervals, pi)
+	}
+	return out, nil
+}
+
+func (h *Handler) SetExchangeRate(ctx context.Context, req *pb.SetExchangeRateRequest) (*pb.SetExchangeRateReply, error) {
+	from, err := parseRequestedCurrency(req.FromCurrency)
+	if err != nil {
+		return nil, invalidArgument(err, "from_currency")
+	}
+	to, err := parseRequestedCurrency(req.ToCurrency)
+	if err != nil {
+		return nil, invalidArgument(err, "to_currency")
+	}
+	reply := &pb.SetExchangeRateReply{}
+	return reply, toStatus(h.idempotent(ctx, "SetExchangeRate", req, reply, func(ctx context.Context) error {
+		return h.deps.SetExchangeRate.Execute(ctx, set_exchange_rate.Request{From: from, To: to, Rate: ratOrNil(req.RateNumerator, req.RateDenominator), EffectiveFrom: unixOrZero(req.EffectiveTimestamp)})
+	}))
+}
+
+func (h *Handler) SetTaxRate(ctx context.Context, req *pb.SetTaxRateRequest) (*pb.SetTaxRateReply, error) {
+	region, err := domain.ParseRegion(req.Region)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	reply := &pb.SetTaxRateReply{}
+	return reply, toStatus(h.idempotent(ctx, "SetTaxRate", req, reply, func(ctx context.Context) error {
+		return h.deps.SetTaxRate.Execute(ctx, set_tax_rate.Request{Region: region, TaxClass: domain.TaxClass(req.TaxClass), Rate: ratOrNil(req.RateNumerator, req.RateDenominator)})
+	}))
+}
+
+func (h *Handler) SetCategoryTaxClass(ctx context.Context, req *pb.SetCategoryTaxClassRequest) (*pb.SetCategoryTaxClassReply, error) {
+	reply := &pb.SetCategoryTaxClassReply{}
+	return reply, toStatus(h.idempotent(ctx, "SetCategoryTaxClass", req, reply, func(ctx context.Context) error {
+		return h.deps.SetCategoryTaxClass.Execute(ctx, set_category_tax_class.Request{Category: req.Category, TaxClass: domain.TaxClass(req.TaxClass)})
+	}))
+}
+
+func (h *Handler) CreatePriceList(ctx context.Context, req *pb.CreatePriceListRequest) (*pb.CreatePriceListReply, error) {
+	reply := &pb.CreatePriceListReply{PriceListId: uuid.NewString()}
+	err := h.idempotent(ctx, "CreatePriceList", req, reply, func(ctx context.Context) error {
+		_, err := h.deps.CreatePriceList.Execute(ctx, create_price_list.Request{ID: reply.PriceListId, Name: req.Name, CustomerGroup: req.CustomerGroup, Priority: req.Priority, ValidFrom: unixOrZero(req.ValidFromTimestamp), ValidTo: unixOrZero(req.ValidToTimestamp)})
+		return err
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return reply, nil
+}
+
+func (h *Handler) UpdatePriceList(ctx context.Context, req *pb.UpdatePriceListRequest) (*pb.UpdatePriceListReply, error) {
+	reply := &pb.UpdatePriceListReply{}
+	return reply, toStatus(h.idempotent(ctx, "UpdatePriceList", req, reply, func(ctx context.Context) error {
+		return h.deps.UpdatePriceList.Execute(ctx, update_price_list.Request{PriceListID: req.PriceListId, Name: req.Name, Priority: req.Priority, ValidFrom: unixOrZero(req.ValidFromTimestamp), ValidTo: unixOrZero(req.ValidToTimestamp), ExpectedVersion: req.ExpectedVersion})
+	}))
+}
+
+func (h *Handler) SetPriceListEntry(ctx context.Context, req *pb.SetPriceListEntryRequest) (*pb.SetPriceListEntryReply, error) {
+	currency, err := parseRequestedCurrency(req.Currency)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	price, err := domain.NewMoneyFromFractionIn(req.PriceNumerator, req.PriceDenominator, currency)
+	if err != nil {
+		return nil, invalidArgument(err, "price_numerator", "price_denominator")
+	}
+	reply := &pb.SetPriceListEntryReply{}
+	return reply, priceStatus(h.idempotent(ctx, "SetPriceListEntry", req, reply, func(ctx context.Context) error {
+		return h.deps.SetPriceListEntry.Execute(ctx, set_price_list_entry.Request{PriceListID: req.PriceListId, ProductID: req.ProductId, Price: price, ExpectedVersion: req.ExpectedVersion})
+	}), "price_numerator", "price_denominator")
+}
+
+func (h *Handler) RemovePriceListEntry(ctx context.Context, req *pb.RemovePriceListEntryRequest) (*pb.RemovePriceListEntryReply, error) {
+	currency, err := parseRequestedCurrency(req.Currency)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	reply := &pb.RemovePriceListEntryReply{}
+	return reply, toStatus(h.idempotent(ctx, "RemovePriceListEntry", req, reply, func(ctx context.Context) error {
+		return h.deps.RemovePriceListEntry.Execute(ctx, remove_price_list_entry.Request{PriceListID: req.PriceListId, ProductID: req.ProductId, Currency: currency, ExpectedVersion: req.ExpectedVersion})
+	}))
+}
+
+func (h *Handler) ListExchangeRates(ctx context.Context, req *pb.ListExchangeRatesRequest) (*pb.ListExchangeRatesReply, error) {
+	from, err := parseOptionalCurrency(req.FromCurrency)
+	if err != nil {
+		return nil, invalidArgument(err, "from_currency")
+	}
+	to, err := parseOptionalCurrency(req.ToCurrency)
+	if err != nil {
+		return nil, invalidArgument(err, "to_currency")
+	}
+	rates, err := h.deps.ListExchangeRates.Execute(ctx, domain.Currency(from), domain.Currency(to))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	out := &pb.ListExchangeRatesReply{}
+	for _, r := range rates {
+		out.Rates = append(out.Rates, exchangeRateOf(r))
+	}
+	return out, nil
+}
+
+func exchangeRateOf(r *domain.ExchangeRate) *pb.ExchangeRate {
+	if r == nil {
+		return nil
+	}
+	out := &pb.ExchangeRate{FromCurrency: string(r.From()), ToCurrency: string(r.To()), EffectiveTimestamp: r.EffectiveFrom().Unix()}
+	out.RateNumerator, out.RateDenominator = ratioOf(r.Rate())
+	return out
+}
+
+// ratioOf is r as an int64 fraction, left 0/0 like Money.Fraction when a
+// part does not fit rather than truncated.
+func ratioOf(r *big.Rat) (num, den int64) {
+	if !r.Num().IsInt64() || !r.Denom().IsInt64() {
+		return 0, 0
+	}
+	return r.Num().Int64(), r.Denom().Int64()
+}
+
+func quoteOf(q quote_price.Quote) *pb.PriceQuote {
+	out := &pb.PriceQuote{
+		ProductId:    q.ProductID,
+		AtTimestamp:  q.At.Unix(),
+		ExchangeRate: exchangeRateOf(q.Rate),
+		Price:        breakdownOf(q.PriceBreakdown, q.Decimal),
+		Quantity:     q.Quantity,
+		Line:         breakdownOf(q.Line, q.Decimal),
+		PriceListId:  q.PriceListID,
+	}
+	if t := q.Tax; t != nil {
+		out.Tax = &pb.TaxBreakdown{
+			Region:   string(t.Rate.Region()),
+			TaxClass: string(t.Rate.Class()),
+			Mode:     string(q.TaxMode),
+			Net:      q.Decimal(t.Net),
+			Tax:      q.Decimal(t.Tax),
+			Gross:    q.Decimal(t.Gross),
+		}
+		out.Tax.RateNumerator, out.Tax.RateDenominator = ratioOf(t.Rate.Rate())
+		out.Tax.NetNumerator, out.Tax.NetDenominator, _ = t.Net.Fraction()
+		out.Tax.TaxNumerator, out.Tax.TaxDenominator, _ = t.Tax.Fraction()
+		out.Tax.GrossNumerator, out.Tax.GrossDenominator, _ = t.Gross.Fraction()
+	}
+	for _, a := range q.Applied {
+		d := a.Discount
+		pd := &pb.Discount{StartTimestamp: d.Start().Unix(), EndTimestamp: d.End().Unix(), Active: true, DiscountId: d.ID(), Status: string(d.Status()), Priority: d.Priority(), Stacking: string(d.Stacking()), Kind: string(d.Kind())}
+		if pct := d.Percent(); pct != nil {
+			pd.PercentNumerator, pd.PercentDenominator = ratioOf(pct)
+		}
+		if m := d.Amount(); m != nil {
+			pd.AmountNumerator, pd.AmountDenominator, _ = m.Fraction()
+			pd.AmountCurrency = string(m.Currency())
+			pd.Amount = q.Decimal(m)
+		}
+		if out.AppliedDiscount == nil {
+			out.AppliedDiscount = pd
+		}
+		ad := &pb.AppliedDiscount{Discount: pd, Amount: q.Decimal(a.Amount)}
+		ad.AmountNumerator, ad.AmountDenominator, _ = a.Amount.Fraction()
+		out.AppliedDiscounts = append(out.AppliedDiscounts, ad)
+	}
+	return out
+}
+
+// breakdownOf renders a breakdown already rounded for display.
+func breakdownOf(b services.PriceBreakdown, decimal func(*domain.Money) string) *pb.PriceBreakdown {
+	out := &pb.PriceBreakdown{
+		Currency:       string(b.Base.Currency()),
+		Base:           decimal(b.Base),
+		DiscountAmount: decimal(b.DiscountAmount),
+		Effective:      decimal(b.Effective),
+	}
+	out.BaseNumerator, out.BaseDenominator, _ = b.Base.Fraction()
+	out.DiscountAmountNumerator, out.DiscountAmountDenominator, _ = b.DiscountAmount.Fraction()
+	out.EffectiveNumerator, out.EffectiveDenominator, _ = b.Effective.Fraction()
+	return out
+}
+
+func unixOrZero(sec int64) time.Time {
+	if sec == 0 {
+		return time.Time{}
+	}
+	return time.Unix(sec, 0)
+}
+
+func productOf(d contracts.ProductDTO) *pb.GetProductReply {
+	return &pb.GetProductReply{ProductId: d.ID, Name: d.Name, Description: d.Description, Category: d.Category, BasePriceNumerator: d.BasePriceNum, BasePriceDenominator: d.BasePriceDen, BasePrice: d.BasePrice, Status: d.Status, Discount: discountOf(d), Version: d.Version, Price: priceOf(d), Discounts: discountsOf(d), Prices: pricesOf(d), LowestPrice: lowestPriceOf(d), LowestPriceDays: d.LowestPriceDays, TaxClass: d.TaxClass, Tax: taxOf(d.Tax), PriceTiers: priceTiersOf(d), PriceListId: d.PriceListID, Variants: variantsOf(d), Sku: d.SKU, Gtin: d.GTIN}
+}
+
+func priceOf(d contracts.ProductDTO) *pb.PriceBreakdown {
+	return &pb.PriceBreakdown{
+		BaseNumerator:             d.BasePriceNum,
+		BaseDenominator:           d.BasePriceDen,
+		DiscountAmountNumerator:   d.DiscountAmountNum,
+		DiscountAmountDenominator: d.DiscountAmountDen,
+		EffectiveNumerator:        d.EffectiveNum,
+		EffectiveDenominator:      d.EffectiveDen,
+		Currency:                  d.Currency,
+		Base:                      d.BasePrice,
+		DiscountAmount:            d.DiscountAmount,
+		Effective:                 d.EffectivePrice,
+	}
+}
+
+func pricesOf(d contracts.ProductDTO) []*pb.Price {
+	var out []*pb.Price
+	for _, p := range d.Prices {
+		out = append(out, &pb.Price{Currency: p.Currency, Numerator: p.Num, Denominator: p.Den, Amount: p.Amount})
+	}
+	return out
+}
+
+func priceTiersOf(d contracts.ProductDTO) []*pb.PriceTier {
+	var out []*pb.PriceTier
+	for _, t := range d.PriceTiers {
+		out = append(out, &pb.PriceTier{MinQuantity: t.MinQuantity, MaxQuantity: t.MaxQuantity, UnitPriceNumerator: t.Num, UnitPriceDenominator: t.Den, Currency: t.Currency, UnitPrice: t.Amount})
+	}
+	return out
+}
+
+func variantsOf(d contracts.ProductDTO) []*pb.Variant {
+	var out []*pb.Variant
+	for _, v := range d.Variants {
+		out = append(out, &pb.Variant{VariantId: v.ID, Sku: v.SKU, Attributes: v.Attributes, Status: v.Status, PriceNumerator: v.Num, PriceDenominator: v.Den, Currency: v.Currency, Price: v.Price})
+	}
+	return out
+}
+
+func lowestPriceOf(d contracts.ProductDTO) *pb.Price {
+	if d.LowestPrice == "" {
+		return nil
+	}
+	return &pb.Price{Currency: d.Currency, Numerator: d.LowestPriceNum, Denominator: d.LowestPriceDen, Amount: d.LowestPrice}
+}
+
+func taxOf(t *contracts.TaxDTO) *pb.TaxBreakdown {
+	if t == nil {
+		return nil
+	}
+	return &pb.TaxBreakdown{
+		Region: t.Region, TaxClass: t.Class, Mode: t.Mode,
+		RateNumerator: t.RateNum, RateDenominator: t.RateDen,
+		NetNumerator: t.NetNum, NetDenominator: t.NetDen,
+		TaxNumerator: t.TaxNum, TaxDenominator: t.TaxDen,
+		GrossNumerator: t.GrossNum, GrossDenominator: t.GrossDen,
+		Net: t.Net, Tax: t.Tax, Gross: t.Gross,
+	}
+}
+
+func discountOf(d contracts.ProductDTO) *pb.Discount {
+	for _, dd := range d.Discounts {
+		if dd.ID == d.DiscountID {
+			return discountDTOOf(dd)
+		}
+	}
+	return nil
+}
+
+func discountsOf(d contracts.ProductDTO) []*pb.Discount {
+	var out []*pb.Discount
+	for _, dd := range d.Discounts {
+		out = append(out, discountDTOOf(dd))
+	}
+	return out
+}
+
+func discountDTOOf(d contracts.DiscountDTO) *pb.Discount {
+	s, _ := time.Parse(time.RFC3339, d.Start)
+	e, _ := time.Parse(time.RFC3339, d.End)
+	out := &pb.Discount{StartTimestamp: s.Unix(), EndTimestamp: e.Unix(), Active: d.Active, DiscountId: d.ID, Status: d.Status, Priority: d.Priority, Stacking: d.Stacking, Kind: d.Kind, AmountNumerator: d.AmountNum, AmountDenominator: d.AmountDen, AmountCurrency: d.AmountCur, Amount: d.Amount}
+	if p, ok := new(big.Rat).SetString(d.Percent); ok {
+		out.PercentNumerator, out.PercentDenominator = ratioOf(p)
+	}
+	return out
+}
+
+// parseRequestedCurrency rejects an empty currency, which ParseCurrency
+// would take to mean the default.
+func parseRequestedCurrency(code string) (domain.Currency, error) {
+	if code == "" {
+		return "", domain.ErrInvalidCurrency
+	}
+	return domain.ParseCurrency(code)
+}
+
+// parseOptionalCurrency validates a currency to price in; empty stays empty,
+// meaning the product's base currency.
+func parseOptionalCurrency(code string) (string, error) {
+	if code == "" {
+		return "", nil
+	}
+	c, err := domain.ParseCurrency(code)
+	return string(c), err
+}
+
+func parseOptionalRegion(code string) (domain.Region, error) {
+	if code == "" {
+		return "", nil
+	}
+	return domain.ParseRegion(code)
+}
+
+// parsePriceListSelector validates the price list context of a read; an
+// ID takes precedence over a customer group.
+func parsePriceListSelector(id, group string) (contracts.PriceListSelector, error) {
+	if id != "" || group == "" {
+		return contracts.PriceListSelector{ID: id}, nil
+	}
+	g, err := domain.ParseCustomerGroup(group)
+	if err != nil {
+		return contracts.PriceListSelector{}, err
+	}
+	return contracts.PriceListSelector{CustomerGroup: string(g)}, nil
+}
+
+// ratOrNil avoids the big.NewRat panic on a zero denominator; the nil result
+// is rejected by domain validation.
+func ratOrNil(num, den int64) *big.Rat {
+	if den == 0 {
+		return nil
+	}
+	return big.NewRat(num, den)
+}
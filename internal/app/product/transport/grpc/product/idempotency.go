@@ -0,0 +1,51 @@
+package product
+
+import (
+	"context"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+)
+
+// idempotencyHeader is accepted as an alternative to the idempotency_key
+// request field.
+const idempotencyHeader = "idempotency-key"
+
+type idempotentRequest interface {
+	proto.Message
+	GetIdempotencyKey() string
+}
+
+// idempotent runs fn through the idempotency guard when the caller supplied
+// a key. reply must already hold the response to store; on a replay it is
+// overwritten with the response of the original call.
+func (h *Handler) idempotent(ctx context.Context, op string, req idempotentRequest, reply proto.Message, fn func(ctx context.Context) error) error {
+	key := req.GetIdempotencyKey()
+	if key == "" {
+		if v := metadata.ValueFromIncomingContext(ctx, idempotencyHeader); len(v) > 0 {
+			key = v[0]
+		}
+	}
+	if key == "" || h.deps.Idempotency == nil {
+		return fn(ctx)
+	}
+
+	// The key itself is not part of the payload, so sending it as a field on
+	// one attempt and as a header on the next is still the same request.
+	norm := proto.Clone(req)
+	norm.ProtoReflect().Clear(norm.ProtoReflect().Descriptor().Fields().ByName("idempotency_key"))
+	in, err := proto.MarshalOptions{Deterministic: true}.Marshal(norm)
+	if err != nil {
+		return err
+	}
+	out, err := proto.Marshal(reply)
+	if err != nil {
+		return err
+	}
+
+	stored, err := h.deps.Idempotency.Do(ctx, key, op, in, out, fn)
+	if err != nil {
+		return err
+	}
+	return proto.Unmarshal(stored, reply)
+}
@@ -27,6 +27,12 @@ type fakeProductRepo struct {
 func (r *fakeProductRepo) GetByID(ctx context.Context, id string) (*domain.Product, error) {
 	return r.p, nil
 }
+func (r *fakeProductRepo) SKUOwner(ctx context.Context, sku string) (string, error) {
+	return "", nil
+}
+func (r *fakeProductRepo) GTINOwner(ctx context.Context, gtin domain.GTIN) (string, error) {
+	return "", nil
+}
 func (r *fakeProductRepo) InsertMut(p *domain.Product) contracts.Mutation { return fakeMut{} }
 func (r *fakeProductRepo) UpdateMut(p *domain.Product) contracts.Mutation {
 	r.updateN++
@@ -56,7 +62,7 @@ func TestActivateProduct_Smoke(t *testing.T) {
 	if err != nil {
 		t.Fatalf("setup money: %v", err)
 	}
-	p, err := domain.NewProduct("p1", "Name", "Desc", "Cat", price, now)
+	p, err := domain.NewProduct(domain.NewProductParams{ID: "p1", Name: "Name", Description: "Desc", Category: "Cat", BasePrice: price}, now)
 	if err != nil {
 		t.Fatalf("setup product: %v", err)
 	}
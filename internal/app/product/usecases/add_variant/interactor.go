@@ -0,0 +1,80 @@
+package add_variant
+
+import (
+	"context"
+	"math/big"
+
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/app/product/events"
+	"product-catalog-service/internal/app/product/identifiers"
+	"product-catalog-service/internal/pkg/clock"
+	"product-catalog-service/internal/pkg/committer"
+)
+
+type Request struct {
+	ProductID  string
+	VariantID  string
+	SKU        string
+	Attributes map[string]string
+	// Price is the variant's own price in the product's base currency; nil
+	// sells it at the base price.
+	Price *big.Rat
+	// Status defaults to active.
+	Status          string
+	ExpectedVersion int64
+}
+
+// Interactor needs no price history: the recorded price is the product's,
+// which variants do not change.
+type Interactor struct {
+	products contracts.ProductRepo
+	outbox   contracts.OutboxRepo
+	comm     committer.Committer
+	clock    clock.Clock
+}
+
+func New(products contracts.ProductRepo, outbox contracts.OutboxRepo, comm committer.Committer, clk clock.Clock) *Interactor {
+	return &Interactor{products: products, outbox: outbox, comm: comm, clock: clk}
+}
+
+func (it *Interactor) Execute(ctx context.Context, req Request) error {
+	p, err := it.products.GetByID(ctx, req.ProductID)
+	if err != nil {
+		return err
+	}
+	if req.ExpectedVersion != 0 && req.ExpectedVersion != p.Version() {
+		return committer.ErrConcurrentModification
+	}
+
+	status, err := domain.ParseVariantStatus(req.Status)
+	if err != nil {
+		return err
+	}
+	var price *domain.Money
+	if req.Price != nil {
+		if price, err = domain.NewMoney(req.Price, p.BasePrice().Currency()); err != nil {
+			return err
+		}
+	}
+	v, err := domain.NewVariant(req.VariantID, req.SKU, req.Attributes, price, status)
+	if err != nil {
+		return err
+	}
+	if err := p.AddVariant(v, it.clock.Now()); err != nil {
+		return err
+	}
+	if err := identifiers.CheckUnique(ctx, it.products, p); err != nil {
+		return err
+	}
+
+	plan := committer.NewPlan()
+
+	plan.Add(it.products.UpdateMut(p))
+
+	if err := events.AppendToPlan(plan, it.outbox, p.DomainEvents()); err != nil {
+		return err
+	}
+
+	return it.comm.Apply(ctx, plan)
+}
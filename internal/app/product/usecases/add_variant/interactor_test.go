@@ -0,0 +1,135 @@
+package add_variant
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/app/product/repo"
+	"product-catalog-service/internal/pkg/committer"
+)
+
+type fakeClock struct{ t time.Time }
+
+func (f fakeClock) Now() time.Time { return f.t }
+
+type fakeMut struct{}
+
+func (fakeMut) IsMutation() {}
+
+type fakeProductRepo struct {
+	p       *domain.Product
+	owners  map[string]string
+	updateN int
+}
+
+func (r *fakeProductRepo) GetByID(ctx context.Context, id string) (*domain.Product, error) {
+	if r.p == nil || r.p.ID() != id {
+		return nil, repo.ErrProductNotFound
+	}
+	return r.p, nil
+}
+func (r *fakeProductRepo) SKUOwner(ctx context.Context, sku string) (string, error) {
+	return r.owners[sku], nil
+}
+func (r *fakeProductRepo) GTINOwner(ctx context.Context, gtin domain.GTIN) (string, error) {
+	return r.owners[string(gtin)], nil
+}
+func (r *fakeProductRepo) InsertMut(p *domain.Product) contracts.Mutation { return fakeMut{} }
+func (r *fakeProductRepo) UpdateMut(p *domain.Product) contracts.Mutation {
+	r.updateN++
+	return fakeMut{}
+}
+
+type fakeOutboxRepo struct{}
+
+func (fakeOutboxRepo) InsertMut(eventID, eventType, aggregateID string, payload []byte) contracts.Mutation {
+	return fakeMut{}
+}
+
+type spyCommitter struct {
+	applied int
+	last    *committer.Plan
+}
+
+func (s *spyCommitter) Apply(ctx context.Context, plan *committer.Plan) error {
+	s.applied++
+	s.last = plan
+	return nil
+}
+
+func newProduct(t *testing.T, variants ...*domain.Variant) *domain.Product {
+	t.Helper()
+	price, err := domain.NewMoneyFromFraction(10, 1)
+	if err != nil {
+		t.Fatalf("setup money: %v", err)
+	}
+	return domain.HydrateProduct(domain.HydrateProductParams{ID: "p1", Name: "Name", Category: "Cat", SKU: "TEE", BasePrice: price, Variants: variants, Status: domain.ProductStatusActive, Version: 3})
+}
+
+func TestAddVariant_CommitsUpdateAndEvent(t *testing.T) {
+	now := time.Date(2026, 1, 12, 12, 0, 0, 0, time.UTC)
+	p := newProduct(t)
+	pr := &fakeProductRepo{p: p}
+	sc := &spyCommitter{}
+
+	err := New(pr, fakeOutboxRepo{}, sc, fakeClock{t: now}).Execute(context.Background(), Request{
+		ProductID: "p1", VariantID: "v1", SKU: "TEE-RED", Attributes: map[string]string{"color": "red"}, Price: big.NewRat(12, 1), ExpectedVersion: 3,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	v, ok := p.Variant("v1")
+	if !ok || v.Status() != domain.VariantStatusActive || v.Price().Currency() != domain.DefaultCurrency {
+		t.Fatalf("expected an active variant priced in the base currency, got %v", p.Variants())
+	}
+	if pr.updateN != 1 || sc.applied != 1 {
+		t.Fatalf("expected one update in one commit, got %d updates and %d commits", pr.updateN, sc.applied)
+	}
+	ev := p.DomainEvents()
+	if len(ev) != 1 || ev[0].EventType() != "product.variant_added" {
+		t.Fatalf("expected product.variant_added, got %v", ev)
+	}
+}
+
+func TestAddVariant_SKUOfAnotherProduct_ReturnsErrDuplicateSKU(t *testing.T) {
+	sc := &spyCommitter{}
+
+	pr := &fakeProductRepo{p: newProduct(t), owners: map[string]string{"TEE-RED": "p2"}}
+	err := New(pr, fakeOutboxRepo{}, sc, fakeClock{}).Execute(context.Background(), Request{ProductID: "p1", VariantID: "v1", SKU: "TEE-RED"})
+	if !errors.Is(err, domain.ErrDuplicateSKU) {
+		t.Fatalf("expected ErrDuplicateSKU, got %v", err)
+	}
+	if sc.applied != 0 {
+		t.Fatalf("expected no commit, got %d", sc.applied)
+	}
+}
+
+func TestAddVariant_ProductNotFound_CommitsNothing(t *testing.T) {
+	sc := &spyCommitter{}
+
+	err := New(&fakeProductRepo{}, fakeOutboxRepo{}, sc, fakeClock{}).Execute(context.Background(), Request{ProductID: "p1", VariantID: "v1", SKU: "TEE-RED"})
+	if !errors.Is(err, repo.ErrProductNotFound) {
+		t.Fatalf("expected ErrProductNotFound, got %v", err)
+	}
+	if sc.applied != 0 {
+		t.Fatalf("expected no commit, got %d", sc.applied)
+	}
+}
+
+func TestAddVariant_StaleExpectedVersion_ReturnsConcurrentModification(t *testing.T) {
+	p := newProduct(t)
+	sc := &spyCommitter{}
+
+	err := New(&fakeProductRepo{p: p}, fakeOutboxRepo{}, sc, fakeClock{}).Execute(context.Background(), Request{ProductID: "p1", VariantID: "v1", SKU: "TEE-RED", ExpectedVersion: 2})
+	if !errors.Is(err, committer.ErrConcurrentModification) {
+		t.Fatalf("expected ErrConcurrentModification, got %v", err)
+	}
+	if len(p.Variants()) != 0 || sc.applied != 0 {
+		t.Fatalf("expected nothing changed, got %d commits", sc.applied)
+	}
+}
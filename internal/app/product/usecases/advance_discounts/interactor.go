@@ -0,0 +1,58 @@
+package advance_discounts
+
+import (
+	"context"
+	"time"
+
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/events"
+	"product-catalog-service/internal/app/product/pricehistory"
+	"product-catalog-service/internal/pkg/clock"
+	"product-catalog-service/internal/pkg/committer"
+)
+
+type Request struct {
+	ProductID string
+	// PruneBefore, when set, also drops expired discounts that ended
+	// before it.
+	PruneBefore time.Time
+}
+
+type Interactor struct {
+	products contracts.ProductRepo
+	outbox   contracts.OutboxRepo
+	history  contracts.PriceHistoryRepo
+	comm     committer.Committer
+	clock    clock.Clock
+}
+
+func New(products contracts.ProductRepo, outbox contracts.OutboxRepo, history contracts.PriceHistoryRepo, comm committer.Committer, clk clock.Clock) *Interactor {
+	return &Interactor{products: products, outbox: outbox, history: history, comm: comm, clock: clk}
+}
+
+func (it *Interactor) Execute(ctx context.Context, req Request) error {
+	p, err := it.products.GetByID(ctx, req.ProductID)
+	if err != nil {
+		return err
+	}
+
+	advanced := p.AdvanceDiscounts(it.clock.Now())
+	pruned := !req.PruneBefore.IsZero() && p.PruneDiscounts(req.PruneBefore)
+	if !advanced && !pruned {
+		return nil
+	}
+
+	plan := committer.NewPlan()
+
+	plan.Add(it.products.UpdateMut(p))
+
+	if err := pricehistory.AppendToPlan(ctx, plan, it.history, p); err != nil {
+		return err
+	}
+
+	if err := events.AppendToPlan(plan, it.outbox, p.DomainEvents()); err != nil {
+		return err
+	}
+
+	return it.comm.Apply(ctx, plan)
+}
@@ -2,35 +2,45 @@ package apply_discount
 
 import (
 	"context"
-	"encoding/json"
-	"errors"
 	"math/big"
 	"time"
 
-	"github.com/google/uuid"
-
 	"product-catalog-service/internal/app/product/contracts"
 	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/app/product/events"
+	"product-catalog-service/internal/app/product/pricehistory"
 	"product-catalog-service/internal/pkg/clock"
 	"product-catalog-service/internal/pkg/committer"
 )
 
 type Request struct {
-	ProductID string
-	Percent   *big.Rat
-	Start     time.Time
-	End       time.Time
+	ProductID  string
+	DiscountID string
+	// Kind defaults to domain.DiscountPercentage, which uses Percent; the
+	// fixed kinds use Amount, in AmountCurrency or else the product's base
+	// currency.
+	Kind           domain.DiscountKind
+	Percent        *big.Rat
+	Amount         *big.Rat
+	AmountCurrency domain.Currency
+	Start          time.Time
+	End            time.Time
+	Priority       int64
+	// Stacking defaults to domain.StackBestWins.
+	Stacking        domain.StackingPolicy
+	ExpectedVersion int64
 }
 
 type Interactor struct {
 	products contracts.ProductRepo
 	outbox   contracts.OutboxRepo
+	history  contracts.PriceHistoryRepo
 	comm     committer.Committer
 	clock    clock.Clock
 }
 
-func New(products contracts.ProductRepo, outbox contracts.OutboxRepo, comm committer.Committer, clk clock.Clock) *Interactor {
-	return &Interactor{products: products, outbox: outbox, comm: comm, clock: clk}
+func New(products contracts.ProductRepo, outbox contracts.OutboxRepo, history contracts.PriceHistoryRepo, comm committer.Committer, clk clock.Clock) *Interactor {
+	return &Interactor{products: products, outbox: outbox, history: history, comm: comm, clock: clk}
 }
 
 func (it *Interactor) Execute(ctx context.Context, req Request) error {
@@ -38,11 +48,23 @@ func (it *Interactor) Execute(ctx context.Context, req Request) error {
 	if err != nil {
 		return err
 	}
+	if req.ExpectedVersion != 0 && req.ExpectedVersion != p.Version() {
+		return committer.ErrConcurrentModification
+	}
 
-	d, err := domain.NewDiscount(req.Percent, req.Start, req.End)
+	if req.AmountCurrency == "" {
+		req.AmountCurrency = p.BasePrice().Currency()
+	}
+	d, err := newDiscount(req)
 	if err != nil {
 		return err
 	}
+	if req.Stacking == "" {
+		req.Stacking = domain.StackBestWins
+	}
+	if d, err = d.WithStacking(req.Priority, req.Stacking); err != nil {
+		return err
+	}
 
 	if err := p.ApplyDiscount(d, it.clock.Now()); err != nil {
 		return err
@@ -52,17 +74,34 @@ func (it *Interactor) Execute(ctx context.Context, req Request) error {
 
 	plan.Add(it.products.UpdateMut(p))
 
-	for _, e := range p.DomainEvents() {
-		b, err := json.Marshal(e)
-		if err != nil {
-			return err
-		}
-		m := it.outbox.InsertMut(uuid.NewString(), e.EventType(), req.ProductID, b)
-		if m == nil {
-			return errors.New("outbox mutation is nil")
-		}
-		plan.Add(m)
+	if err := pricehistory.AppendToPlan(ctx, plan, it.history, p); err != nil {
+		return err
+	}
+
+	if err := events.AppendToPlan(plan, it.outbox, p.DomainEvents()); err != nil {
+		return err
 	}
 
 	return it.comm.Apply(ctx, plan)
 }
+
+func newDiscount(req Request) (*domain.Discount, error) {
+	if req.Kind == "" || req.Kind == domain.DiscountPercentage {
+		return domain.NewDiscount(req.DiscountID, req.Percent, req.Start, req.End)
+	}
+	if req.Kind != domain.DiscountFixedAmount && req.Kind != domain.DiscountFixedPrice {
+		return nil, domain.ErrInvalidDiscountKind
+	}
+
+	amount, err := domain.NewMoney(req.Amount, req.AmountCurrency)
+	if err == domain.ErrInvalidMoney {
+		return nil, domain.ErrInvalidDiscountAmount
+	}
+	if err != nil {
+		return nil, err
+	}
+	if req.Kind == domain.DiscountFixedAmount {
+		return domain.NewFixedAmountDiscount(req.DiscountID, amount, req.Start, req.End)
+	}
+	return domain.NewFixedPriceDiscount(req.DiscountID, amount, req.Start, req.End)
+}
@@ -29,12 +29,34 @@ type fakeProductRepo struct {
 func (r *fakeProductRepo) GetByID(ctx context.Context, id string) (*domain.Product, error) {
 	return r.p, nil
 }
+func (r *fakeProductRepo) SKUOwner(ctx context.Context, sku string) (string, error) {
+	return "", nil
+}
+func (r *fakeProductRepo) GTINOwner(ctx context.Context, gtin domain.GTIN) (string, error) {
+	return "", nil
+}
 func (r *fakeProductRepo) InsertMut(p *domain.Product) contracts.Mutation { return fakeMut{} }
 func (r *fakeProductRepo) UpdateMut(p *domain.Product) contracts.Mutation {
 	r.updateN++
 	return fakeMut{}
 }
 
+type fakeHistoryRepo struct{ recorded []*domain.PriceRecord }
+
+func (h *fakeHistoryRepo) List(ctx context.Context, productID string, currency domain.Currency) ([]*domain.PriceRecord, error) {
+	return nil, nil
+}
+func (h *fakeHistoryRepo) ListPage(ctx context.Context, f contracts.PriceHistoryFilter) ([]*domain.PriceRecord, error) {
+	return nil, nil
+}
+func (h *fakeHistoryRepo) Latest(ctx context.Context, productID string) (map[domain.Currency]*domain.PriceRecord, error) {
+	return nil, nil
+}
+func (h *fakeHistoryRepo) RecordMut(r *domain.PriceRecord) contracts.Mutation {
+	h.recorded = append(h.recorded, r)
+	return fakeMut{}
+}
+
 type fakeOutboxRepo struct{}
 
 func (fakeOutboxRepo) InsertMut(eventID, eventType, aggregateID string, payload []byte) contracts.Mutation {
@@ -58,7 +80,7 @@ func TestApplyDiscount_Smoke(t *testing.T) {
 	if err != nil {
 		t.Fatalf("setup money: %v", err)
 	}
-	p, err := domain.NewProduct("p1", "Name", "Desc", "Cat", price, now)
+	p, err := domain.NewProduct(domain.NewProductParams{ID: "p1", Name: "Name", Description: "Desc", Category: "Cat", BasePrice: price}, now)
 	if err != nil {
 		t.Fatalf("setup product: %v", err)
 	}
@@ -75,13 +97,14 @@ func TestApplyDiscount_Smoke(t *testing.T) {
 		t.Fatalf("expected nil error, got %v", err)
 	}
 
-	it := New(pr, fakeOutboxRepo{}, sc, fakeClock{t: now})
+	it := New(pr, fakeOutboxRepo{}, &fakeHistoryRepo{}, sc, fakeClock{t: now})
 
 	err = it.Execute(context.Background(), Request{
-		ProductID: "p1",
-		Percent:   big.NewRat(10, 1),
-		Start:     now.Truncate(time.Hour),
-		End:       now.Add(2 * time.Hour),
+		ProductID:  "p1",
+		DiscountID: "d1",
+		Percent:    big.NewRat(10, 1),
+		Start:      now.Truncate(time.Hour),
+		End:        now.Add(2 * time.Hour),
 	})
 	if err != nil {
 		t.Fatalf("expected nil error, got %v", err)
@@ -104,17 +127,18 @@ func TestApplyDiscount_InactiveProduct_ReturnsErr(t *testing.T) {
 		t.Fatalf("setup money: %v", err)
 	}
 
-	p, _ := domain.NewProduct("p1", "Name", "Desc", "Cat", price, now)
+	p, _ := domain.NewProduct(domain.NewProductParams{ID: "p1", Name: "Name", Description: "Desc", Category: "Cat", BasePrice: price}, now)
 
 	pr := &fakeProductRepo{p: p}
 	sc := &spyCommitter{}
-	it := New(pr, fakeOutboxRepo{}, sc, fakeClock{t: now})
+	it := New(pr, fakeOutboxRepo{}, &fakeHistoryRepo{}, sc, fakeClock{t: now})
 
 	err = it.Execute(context.Background(), Request{
-		ProductID: "p1",
-		Percent:   big.NewRat(10, 1),
-		Start:     now.Add(time.Hour),
-		End:       now.Add(2 * time.Hour),
+		ProductID:  "p1",
+		DiscountID: "d1",
+		Percent:    big.NewRat(10, 1),
+		Start:      now.Add(time.Hour),
+		End:        now.Add(2 * time.Hour),
 	})
 
 	if err == nil {
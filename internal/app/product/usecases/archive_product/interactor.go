@@ -2,18 +2,16 @@ package archive_product
 
 import (
 	"context"
-	"encoding/json"
-	"errors"
 
 	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/events"
 	"product-catalog-service/internal/pkg/clock"
 	"product-catalog-service/internal/pkg/committer"
-
-	"github.com/google/uuid"
 )
 
 type Request struct {
-	ProductID string
+	ProductID       string
+	ExpectedVersion int64
 }
 
 type Interactor struct {
@@ -32,6 +30,9 @@ func (it *Interactor) Execute(ctx context.Context, req Request) error {
 	if err != nil {
 		return err
 	}
+	if req.ExpectedVersion != 0 && req.ExpectedVersion != p.Version() {
+		return committer.ErrConcurrentModification
+	}
 
 	err = p.Archive(it.clock.Now())
 	if err != nil {
@@ -42,17 +43,8 @@ func (it *Interactor) Execute(ctx context.Context, req Request) error {
 
 	plan.Add(it.products.UpdateMut(p))
 
-	for _, e := range p.DomainEvents() {
-		b, err := json.Marshal(e)
-		if err != nil {
-			return err
-		}
-
-		m := it.outbox.InsertMut(uuid.NewString(), e.EventType(), req.ProductID, b)
-		if m == nil {
-			return errors.New("outbox mutation is nil")
-		}
-		plan.Add(m)
+	if err := events.AppendToPlan(plan, it.outbox, p.DomainEvents()); err != nil {
+		return err
 	}
 	return it.comm.Apply(ctx, plan)
 }
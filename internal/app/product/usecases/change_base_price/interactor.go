@@ -0,0 +1,65 @@
+package change_base_price
+
+import (
+	"context"
+	"math/big"
+
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/app/product/events"
+	"product-catalog-service/internal/app/product/pricehistory"
+	"product-catalog-service/internal/pkg/clock"
+	"product-catalog-service/internal/pkg/committer"
+)
+
+// Request carries the new base price as an amount; it is taken to be in
+// the product's base currency.
+type Request struct {
+	ProductID       string
+	BasePrice       *big.Rat
+	ExpectedVersion int64
+}
+
+type Interactor struct {
+	products contracts.ProductRepo
+	outbox   contracts.OutboxRepo
+	history  contracts.PriceHistoryRepo
+	comm     committer.Committer
+	clock    clock.Clock
+}
+
+func New(products contracts.ProductRepo, outbox contracts.OutboxRepo, history contracts.PriceHistoryRepo, comm committer.Committer, clk clock.Clock) *Interactor {
+	return &Interactor{products: products, outbox: outbox, history: history, comm: comm, clock: clk}
+}
+
+func (it *Interactor) Execute(ctx context.Context, req Request) error {
+	p, err := it.products.GetByID(ctx, req.ProductID)
+	if err != nil {
+		return err
+	}
+	if req.ExpectedVersion != 0 && req.ExpectedVersion != p.Version() {
+		return committer.ErrConcurrentModification
+	}
+
+	price, err := domain.NewMoney(req.BasePrice, p.BasePrice().Currency())
+	if err != nil {
+		return err
+	}
+	if err := p.ChangeBasePrice(price, it.clock.Now()); err != nil {
+		return err
+	}
+
+	plan := committer.NewPlan()
+
+	plan.Add(it.products.UpdateMut(p))
+
+	if err := pricehistory.AppendToPlan(ctx, plan, it.history, p); err != nil {
+		return err
+	}
+
+	if err := events.AppendToPlan(plan, it.outbox, p.DomainEvents()); err != nil {
+		return err
+	}
+
+	return it.comm.Apply(ctx, plan)
+}
@@ -0,0 +1,142 @@
+package change_base_price
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/app/product/repo"
+	"product-catalog-service/internal/pkg/committer"
+)
+
+type fakeClock struct{ t time.Time }
+
+func (f fakeClock) Now() time.Time { return f.t }
+
+type fakeMut struct{}
+
+func (fakeMut) IsMutation() {}
+
+type fakeProductRepo struct {
+	p       *domain.Product
+	owners  map[string]string
+	updateN int
+}
+
+func (r *fakeProductRepo) GetByID(ctx context.Context, id string) (*domain.Product, error) {
+	if r.p == nil || r.p.ID() != id {
+		return nil, repo.ErrProductNotFound
+	}
+	return r.p, nil
+}
+func (r *fakeProductRepo) SKUOwner(ctx context.Context, sku string) (string, error) {
+	return r.owners[sku], nil
+}
+func (r *fakeProductRepo) GTINOwner(ctx context.Context, gtin domain.GTIN) (string, error) {
+	return r.owners[string(gtin)], nil
+}
+func (r *fakeProductRepo) InsertMut(p *domain.Product) contracts.Mutation { return fakeMut{} }
+func (r *fakeProductRepo) UpdateMut(p *domain.Product) contracts.Mutation {
+	r.updateN++
+	return fakeMut{}
+}
+
+type fakeHistoryRepo struct {
+	latest   map[domain.Currency]*domain.PriceRecord
+	recorded []*domain.PriceRecord
+}
+
+func (h *fakeHistoryRepo) List(ctx context.Context, productID string, currency domain.Currency) ([]*domain.PriceRecord, error) {
+	return nil, nil
+}
+func (h *fakeHistoryRepo) ListPage(ctx context.Context, f contracts.PriceHistoryFilter) ([]*domain.PriceRecord, error) {
+	return nil, nil
+}
+func (h *fakeHistoryRepo) Latest(ctx context.Context, productID string) (map[domain.Currency]*domain.PriceRecord, error) {
+	return h.latest, nil
+}
+func (h *fakeHistoryRepo) RecordMut(r *domain.PriceRecord) contracts.Mutation {
+	h.recorded = append(h.recorded, r)
+	return fakeMut{}
+}
+
+type fakeOutboxRepo struct{}
+
+func (fakeOutboxRepo) InsertMut(eventID, eventType, aggregateID string, payload []byte) contracts.Mutation {
+	return fakeMut{}
+}
+
+type spyCommitter struct {
+	applied int
+	last    *committer.Plan
+}
+
+func (s *spyCommitter) Apply(ctx context.Context, plan *committer.Plan) error {
+	s.applied++
+	s.last = plan
+	return nil
+}
+
+func newProduct(t *testing.T) *domain.Product {
+	t.Helper()
+	price, err := domain.NewMoneyFromFraction(10, 1)
+	if err != nil {
+		t.Fatalf("setup money: %v", err)
+	}
+	return domain.HydrateProduct(domain.HydrateProductParams{ID: "p1", Name: "Name", Category: "Cat", BasePrice: price, Status: domain.ProductStatusActive, Version: 3})
+}
+
+func TestChangeBasePrice_CommitsUpdateHistoryAndEvent(t *testing.T) {
+	now := time.Date(2026, 1, 12, 12, 0, 0, 0, time.UTC)
+	p := newProduct(t)
+	pr := &fakeProductRepo{p: p}
+	hr := &fakeHistoryRepo{}
+	sc := &spyCommitter{}
+
+	err := New(pr, fakeOutboxRepo{}, hr, sc, fakeClock{t: now}).Execute(context.Background(), Request{ProductID: "p1", BasePrice: big.NewRat(25, 2), ExpectedVersion: 3})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if p.BasePrice().Rat().Cmp(big.NewRat(25, 2)) != 0 || p.BasePrice().Currency() != domain.DefaultCurrency {
+		t.Fatalf("expected 12.50 in the base currency, got %v", p.BasePrice())
+	}
+	if pr.updateN != 1 || sc.applied != 1 {
+		t.Fatalf("expected one update in one commit, got %d updates and %d commits", pr.updateN, sc.applied)
+	}
+	if len(hr.recorded) != 1 || !hr.recorded[0].From.Equal(now) || hr.recorded[0].Effective.Rat().Cmp(big.NewRat(25, 2)) != 0 {
+		t.Fatalf("expected the new price recorded from now, got %v", hr.recorded)
+	}
+	ev := p.DomainEvents()
+	if len(ev) != 1 || ev[0].EventType() != "product.price_changed" {
+		t.Fatalf("expected product.price_changed, got %v", ev)
+	}
+}
+
+func TestChangeBasePrice_ProductNotFound_CommitsNothing(t *testing.T) {
+	sc := &spyCommitter{}
+
+	err := New(&fakeProductRepo{}, fakeOutboxRepo{}, &fakeHistoryRepo{}, sc, fakeClock{}).Execute(context.Background(), Request{ProductID: "p1", BasePrice: big.NewRat(25, 2)})
+	if !errors.Is(err, repo.ErrProductNotFound) {
+		t.Fatalf("expected ErrProductNotFound, got %v", err)
+	}
+	if sc.applied != 0 {
+		t.Fatalf("expected no commit, got %d", sc.applied)
+	}
+}
+
+func TestChangeBasePrice_StaleExpectedVersion_ReturnsConcurrentModification(t *testing.T) {
+	p := newProduct(t)
+	sc := &spyCommitter{}
+
+	err := New(&fakeProductRepo{p: p}, fakeOutboxRepo{}, &fakeHistoryRepo{}, sc, fakeClock{}).Execute(context.Background(), Request{ProductID: "p1", BasePrice: big.NewRat(25, 2), ExpectedVersion: 2})
+	if !errors.Is(err, committer.ErrConcurrentModification) {
+		t.Fatalf("expected ErrConcurrentModification, got %v", err)
+	}
+	if sc.applied != 0 || p.BasePrice().Rat().Cmp(big.NewRat(10, 1)) != 0 {
+		t.Fatalf("expected nothing changed, got %d commits and price %v", sc.applied, p.BasePrice())
+	}
+}
@@ -0,0 +1,51 @@
+package create_price_list
+
+import (
+	"context"
+	"time"
+
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/pkg/committer"
+)
+
+type Request struct {
+	ID            string
+	Name          string
+	CustomerGroup string
+	Priority      int64
+	// ValidFrom and ValidTo bound when the list applies; zero means no
+	// bound.
+	ValidFrom time.Time
+	ValidTo   time.Time
+}
+
+type Interactor struct {
+	lists contracts.PriceListRepo
+	comm  committer.Committer
+}
+
+func New(lists contracts.PriceListRepo, comm committer.Committer) *Interactor {
+	return &Interactor{lists: lists, comm: comm}
+}
+
+func (it *Interactor) Execute(ctx context.Context, req Request) (string, error) {
+	group, err := domain.ParseCustomerGroup(req.CustomerGroup)
+	if err != nil {
+		return "", err
+	}
+	l, err := domain.NewPriceList(req.ID, req.Name, group, req.Priority, req.ValidFrom, req.ValidTo)
+	if err != nil {
+		return "", err
+	}
+
+	plan := committer.NewPlan()
+
+	plan.Add(it.lists.InsertMut(l))
+
+	if err := it.comm.Apply(ctx, plan); err != nil {
+		return "", err
+	}
+
+	return req.ID, nil
+}
@@ -0,0 +1,90 @@
+package create_price_list
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/app/product/repo"
+	"product-catalog-service/internal/pkg/committer"
+)
+
+type fakeMut struct{}
+
+func (fakeMut) IsMutation() {}
+
+type fakePriceListRepo struct {
+	l       *domain.PriceList
+	insertN int
+	updateN int
+}
+
+func (r *fakePriceListRepo) GetByID(ctx context.Context, id string) (*domain.PriceList, error) {
+	if r.l == nil || r.l.ID() != id {
+		return nil, repo.ErrPriceListNotFound
+	}
+	return r.l, nil
+}
+func (r *fakePriceListRepo) Select(ctx context.Context, sel contracts.PriceListSelector, productIDs []string) ([]*domain.PriceList, error) {
+	return nil, nil
+}
+func (r *fakePriceListRepo) InsertMut(l *domain.PriceList) contracts.Mutation {
+	r.insertN++
+	return fakeMut{}
+}
+func (r *fakePriceListRepo) UpdateMut(l *domain.PriceList) contracts.Mutation {
+	r.updateN++
+	return fakeMut{}
+}
+
+type spyCommitter struct {
+	applied int
+	last    *committer.Plan
+}
+
+func (s *spyCommitter) Apply(ctx context.Context, plan *committer.Plan) error {
+	s.applied++
+	s.last = plan
+	return nil
+}
+
+func TestCreatePriceList_CommitsInsert(t *testing.T) {
+	lr := &fakePriceListRepo{}
+	sc := &spyCommitter{}
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	id, err := New(lr, sc).Execute(context.Background(), Request{ID: "pl1", Name: "Wholesale", CustomerGroup: "wholesale", Priority: 2, ValidFrom: from})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if id != "pl1" {
+		t.Fatalf("expected id pl1, got %q", id)
+	}
+	if lr.insertN != 1 || sc.applied != 1 || len(sc.last.Mutations()) != 1 {
+		t.Fatalf("expected one insert in one commit, got %d inserts and %d commits", lr.insertN, sc.applied)
+	}
+}
+
+func TestCreatePriceList_InvalidInput_CommitsNothing(t *testing.T) {
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	for _, c := range []struct {
+		req  Request
+		want error
+	}{
+		{Request{ID: "pl1", Name: "Wholesale", CustomerGroup: "Wholesale"}, domain.ErrInvalidCustomerGroup},
+		{Request{Name: "Wholesale", CustomerGroup: "wholesale"}, domain.ErrInvalidPriceListID},
+		{Request{ID: "pl1", CustomerGroup: "wholesale"}, domain.ErrInvalidPriceListName},
+		{Request{ID: "pl1", Name: "Wholesale", CustomerGroup: "wholesale", ValidFrom: from, ValidTo: from}, domain.ErrInvalidPriceListPeriod},
+	} {
+		sc := &spyCommitter{}
+		if _, err := New(&fakePriceListRepo{}, sc).Execute(context.Background(), c.req); !errors.Is(err, c.want) {
+			t.Fatalf("%+v: expected %v, got %v", c.req, c.want, err)
+		}
+		if sc.applied != 0 {
+			t.Fatalf("%+v: expected no commit, got %d", c.req, sc.applied)
+		}
+	}
+}
@@ -2,13 +2,13 @@ package create_product
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 
-	"github.com/google/uuid"
-
 	"product-catalog-service/internal/app/product/contracts"
 	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/app/product/events"
+	"product-catalog-service/internal/app/product/identifiers"
+	"product-catalog-service/internal/app/product/pricehistory"
 	"product-catalog-service/internal/pkg/clock"
 	"product-catalog-service/internal/pkg/committer"
 )
@@ -18,18 +18,21 @@ type Request struct {
 	Name        string
 	Description string
 	Category    string
+	SKU         string
+	GTIN        domain.GTIN
 	BasePrice   *domain.Money
 }
 
 type Interactor struct {
 	products contracts.ProductRepo
 	outbox   contracts.OutboxRepo
+	history  contracts.PriceHistoryRepo
 	comm     committer.Committer
 	clock    clock.Clock
 }
 
-func New(products contracts.ProductRepo, outbox contracts.OutboxRepo, comm committer.Committer, clk clock.Clock) *Interactor {
-	return &Interactor{products: products, outbox: outbox, comm: comm, clock: clk}
+func New(products contracts.ProductRepo, outbox contracts.OutboxRepo, history contracts.PriceHistoryRepo, comm committer.Committer, clk clock.Clock) *Interactor {
+	return &Interactor{products: products, outbox: outbox, history: history, comm: comm, clock: clk}
 }
 
 func (it *Interactor) Execute(ctx context.Context, req Request) (string, error) {
@@ -39,25 +42,32 @@ func (it *Interactor) Execute(ctx context.Context, req Request) (string, error)
 
 	now := it.clock.Now()
 
-	p, err := domain.NewProduct(req.ID, req.Name, req.Description, req.Category, req.BasePrice, now)
+	p, err := domain.NewProduct(domain.NewProductParams{
+		ID:          req.ID,
+		Name:        req.Name,
+		Description: req.Description,
+		Category:    req.Category,
+		SKU:         req.SKU,
+		GTIN:        req.GTIN,
+		BasePrice:   req.BasePrice,
+	}, now)
 	if err != nil {
 		return "", err
 	}
+	if err := identifiers.CheckUnique(ctx, it.products, p); err != nil {
+		return "", err
+	}
 
 	plan := committer.NewPlan()
 
 	plan.Add(it.products.InsertMut(p))
 
-	for _, e := range p.DomainEvents() {
-		b, err := json.Marshal(e)
-		if err != nil {
-			return "", err
-		}
-		m := it.outbox.InsertMut(uuid.NewString(), e.EventType(), req.ID, b)
-		if m == nil {
-			return "", errors.New("outbox mutation is nil")
-		}
-		plan.Add(m)
+	if err := pricehistory.AppendToPlan(ctx, plan, it.history, p); err != nil {
+		return "", err
+	}
+
+	if err := events.AppendToPlan(plan, it.outbox, p.DomainEvents()); err != nil {
+		return "", err
 	}
 
 	if err := it.comm.Apply(ctx, plan); err != nil {
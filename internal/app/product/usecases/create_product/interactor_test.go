@@ -2,6 +2,7 @@ package create_product
 
 import (
 	"context"
+	"errors"
 	"math/big"
 	"testing"
 	"time"
@@ -19,11 +20,21 @@ type fakeMut struct{}
 
 func (fakeMut) IsMutation() {}
 
-type fakeProductRepo struct{ insertN int }
+type fakeProductRepo struct {
+	insertN int
+	// skus maps a SKU in use to the product using it.
+	skus map[string]string
+}
 
 func (r *fakeProductRepo) GetByID(ctx context.Context, id string) (*domain.Product, error) {
 	return nil, nil
 }
+func (r *fakeProductRepo) SKUOwner(ctx context.Context, sku string) (string, error) {
+	return r.skus[sku], nil
+}
+func (r *fakeProductRepo) GTINOwner(ctx context.Context, gtin domain.GTIN) (string, error) {
+	return "", nil
+}
 func (r *fakeProductRepo) InsertMut(p *domain.Product) contracts.Mutation {
 	r.insertN++
 	return fakeMut{}
@@ -37,6 +48,22 @@ func (o *fakeOutboxRepo) InsertMut(eventID, eventType, aggregateID string, paylo
 	return fakeMut{}
 }
 
+type fakeHistoryRepo struct{ recorded []*domain.PriceRecord }
+
+func (h *fakeHistoryRepo) List(ctx context.Context, productID string, currency domain.Currency) ([]*domain.PriceRecord, error) {
+	return nil, nil
+}
+func (h *fakeHistoryRepo) ListPage(ctx context.Context, f contracts.PriceHistoryFilter) ([]*domain.PriceRecord, error) {
+	return nil, nil
+}
+func (h *fakeHistoryRepo) Latest(ctx context.Context, productID string) (map[domain.Currency]*domain.PriceRecord, error) {
+	return nil, nil
+}
+func (h *fakeHistoryRepo) RecordMut(r *domain.PriceRecord) contracts.Mutation {
+	h.recorded = append(h.recorded, r)
+	return fakeMut{}
+}
+
 type spyCommitter struct {
 	applied int
 	last    *committer.Plan
@@ -57,9 +84,10 @@ func TestCreateProduct_Smoke(t *testing.T) {
 
 	pr := &fakeProductRepo{}
 	or := &fakeOutboxRepo{}
+	hr := &fakeHistoryRepo{}
 	sc := &spyCommitter{}
 
-	it := New(pr, or, sc, fakeClock{t: now})
+	it := New(pr, or, hr, sc, fakeClock{t: now})
 
 	id, err := it.Execute(context.Background(), Request{
 		ID:          "p1",
@@ -83,4 +111,28 @@ func TestCreateProduct_Smoke(t *testing.T) {
 	if sc.last == nil || len(sc.last.Mutations()) < 1 {
 		t.Fatalf("expected plan with at least 1 mutation")
 	}
+	if len(hr.recorded) != 1 || !hr.recorded[0].From.Equal(now) || hr.recorded[0].Effective.Rat().Cmp(price.Rat()) != 0 {
+		t.Fatalf("expected the opening price recorded at creation, got %v", hr.recorded)
+	}
+}
+
+func TestCreateProduct_RejectsSKUInUse(t *testing.T) {
+	now := time.Date(2026, 1, 12, 12, 0, 0, 0, time.UTC)
+	price, err := domain.NewMoneyFromRat(big.NewRat(1999, 100))
+	if err != nil {
+		t.Fatalf("setup money: %v", err)
+	}
+
+	pr := &fakeProductRepo{skus: map[string]string{"TSHIRT-M": "p0"}}
+	sc := &spyCommitter{}
+
+	it := New(pr, &fakeOutboxRepo{}, &fakeHistoryRepo{}, sc, fakeClock{t: now})
+
+	_, err = it.Execute(context.Background(), Request{ID: "p1", Name: "Name", Category: "Cat", SKU: "TSHIRT-M", BasePrice: price})
+	if !errors.Is(err, domain.ErrDuplicateSKU) {
+		t.Fatalf("expected ErrDuplicateSKU, got %v", err)
+	}
+	if sc.applied != 0 {
+		t.Fatalf("expected nothing committed, got %d applies", sc.applied)
+	}
 }
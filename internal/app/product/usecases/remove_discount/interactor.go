@@ -2,29 +2,32 @@ package remove_discount
 
 import (
 	"context"
-	"encoding/json"
-	"errors"
-
-	"github.com/google/uuid"
 
 	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/events"
+	"product-catalog-service/internal/app/product/pricehistory"
 	"product-catalog-service/internal/pkg/clock"
 	"product-catalog-service/internal/pkg/committer"
 )
 
 type Request struct {
 	ProductID string
+	// DiscountID selects the discount to remove; empty means the one in
+	// effect now.
+	DiscountID      string
+	ExpectedVersion int64
 }
 
 type Interactor struct {
 	products contracts.ProductRepo
 	outbox   contracts.OutboxRepo
+	history  contracts.PriceHistoryRepo
 	comm     committer.Committer
 	clock    clock.Clock
 }
 
-func New(products contracts.ProductRepo, outbox contracts.OutboxRepo, comm committer.Committer, clk clock.Clock) *Interactor {
-	return &Interactor{products: products, outbox: outbox, comm: comm, clock: clk}
+func New(products contracts.ProductRepo, outbox contracts.OutboxRepo, history contracts.PriceHistoryRepo, comm committer.Committer, clk clock.Clock) *Interactor {
+	return &Interactor{products: products, outbox: outbox, history: history, comm: comm, clock: clk}
 }
 
 func (it *Interactor) Execute(ctx context.Context, req Request) error {
@@ -32,8 +35,11 @@ func (it *Interactor) Execute(ctx context.Context, req Request) error {
 	if err != nil {
 		return err
 	}
+	if req.ExpectedVersion != 0 && req.ExpectedVersion != p.Version() {
+		return committer.ErrConcurrentModification
+	}
 
-	if err := p.RemoveDiscount(it.clock.Now()); err != nil {
+	if err := p.RemoveDiscount(req.DiscountID, it.clock.Now()); err != nil {
 		return err
 	}
 
@@ -41,16 +47,12 @@ func (it *Interactor) Execute(ctx context.Context, req Request) error {
 
 	plan.Add(it.products.UpdateMut(p))
 
-	for _, e := range p.DomainEvents() {
-		b, err := json.Marshal(e)
-		if err != nil {
-			return err
-		}
-		m := it.outbox.InsertMut(uuid.NewString(), e.EventType(), req.ProductID, b)
-		if m == nil {
-			return errors.New("outbox mutation is nil")
-		}
-		plan.Add(m)
+	if err := pricehistory.AppendToPlan(ctx, plan, it.history, p); err != nil {
+		return err
+	}
+
+	if err := events.AppendToPlan(plan, it.outbox, p.DomainEvents()); err != nil {
+		return err
 	}
 
 	return it.comm.Apply(ctx, plan)
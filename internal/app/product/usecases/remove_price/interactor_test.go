@@ -0,0 +1,153 @@
+package remove_price
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/app/product/repo"
+	"product-catalog-service/internal/pkg/committer"
+)
+
+type fakeClock struct{ t time.Time }
+
+func (f fakeClock) Now() time.Time { return f.t }
+
+type fakeMut struct{}
+
+func (fakeMut) IsMutation() {}
+
+type fakeProductRepo struct {
+	p       *domain.Product
+	owners  map[string]string
+	updateN int
+}
+
+func (r *fakeProductRepo) GetByID(ctx context.Context, id string) (*domain.Product, error) {
+	if r.p == nil || r.p.ID() != id {
+		return nil, repo.ErrProductNotFound
+	}
+	return r.p, nil
+}
+func (r *fakeProductRepo) SKUOwner(ctx context.Context, sku string) (string, error) {
+	return r.owners[sku], nil
+}
+func (r *fakeProductRepo) GTINOwner(ctx context.Context, gtin domain.GTIN) (string, error) {
+	return r.owners[string(gtin)], nil
+}
+func (r *fakeProductRepo) InsertMut(p *domain.Product) contracts.Mutation { return fakeMut{} }
+func (r *fakeProductRepo) UpdateMut(p *domain.Product) contracts.Mutation {
+	r.updateN++
+	return fakeMut{}
+}
+
+type fakeHistoryRepo struct {
+	latest   map[domain.Currency]*domain.PriceRecord
+	recorded []*domain.PriceRecord
+}
+
+func (h *fakeHistoryRepo) List(ctx context.Context, productID string, currency domain.Currency) ([]*domain.PriceRecord, error) {
+	return nil, nil
+}
+func (h *fakeHistoryRepo) ListPage(ctx context.Context, f contracts.PriceHistoryFilter) ([]*domain.PriceRecord, error) {
+	return nil, nil
+}
+func (h *fakeHistoryRepo) Latest(ctx context.Context, productID string) (map[domain.Currency]*domain.PriceRecord, error) {
+	return h.latest, nil
+}
+func (h *fakeHistoryRepo) RecordMut(r *domain.PriceRecord) contracts.Mutation {
+	h.recorded = append(h.recorded, r)
+	return fakeMut{}
+}
+
+type fakeOutboxRepo struct{}
+
+func (fakeOutboxRepo) InsertMut(eventID, eventType, aggregateID string, payload []byte) contracts.Mutation {
+	return fakeMut{}
+}
+
+type spyCommitter struct {
+	applied int
+	last    *committer.Plan
+}
+
+func (s *spyCommitter) Apply(ctx context.Context, plan *committer.Plan) error {
+	s.applied++
+	s.last = plan
+	return nil
+}
+
+func newProduct(t *testing.T) *domain.Product {
+	t.Helper()
+	price, err := domain.NewMoneyFromFraction(10, 1)
+	if err != nil {
+		t.Fatalf("setup money: %v", err)
+	}
+	usd, err := domain.NewMoneyFromFractionIn(1199, 100, "USD")
+	if err != nil {
+		t.Fatalf("setup money: %v", err)
+	}
+	return domain.HydrateProduct(domain.HydrateProductParams{ID: "p1", Name: "Name", Category: "Cat", BasePrice: price, Prices: []*domain.Money{usd}, Status: domain.ProductStatusActive, Version: 3})
+}
+
+// latestOf is the history as it stood with p's prices recorded at from.
+func latestOf(p *domain.Product, from time.Time) map[domain.Currency]*domain.PriceRecord {
+	out := map[domain.Currency]*domain.PriceRecord{}
+	for _, m := range p.Prices() {
+		out[m.Currency()] = &domain.PriceRecord{ProductID: p.ID(), Currency: m.Currency(), Base: m, Effective: m, From: from}
+	}
+	return out
+}
+
+func TestRemovePrice_CommitsUpdateHistoryAndEvent(t *testing.T) {
+	now := time.Date(2026, 1, 12, 12, 0, 0, 0, time.UTC)
+	p := newProduct(t)
+	pr := &fakeProductRepo{p: p}
+	sc := &spyCommitter{}
+
+	hr := &fakeHistoryRepo{latest: latestOf(p, now.Add(-time.Hour))}
+	if err := New(pr, fakeOutboxRepo{}, hr, sc, fakeClock{t: now}).Execute(context.Background(), Request{ProductID: "p1", Currency: "USD", ExpectedVersion: 3}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := p.PriceIn("USD"); ok {
+		t.Fatalf("expected the USD price removed, got %v", p.Prices())
+	}
+	if pr.updateN != 1 || sc.applied != 1 {
+		t.Fatalf("expected one update in one commit, got %d updates and %d commits", pr.updateN, sc.applied)
+	}
+	if len(hr.recorded) != 1 || hr.recorded[0].Currency != "USD" || hr.recorded[0].Priced() {
+		t.Fatalf("expected USD recorded as unpriced, got %v", hr.recorded)
+	}
+	ev := p.DomainEvents()
+	if len(ev) != 1 || ev[0].EventType() != "product.price_removed" {
+		t.Fatalf("expected product.price_removed, got %v", ev)
+	}
+}
+
+func TestRemovePrice_ProductNotFound_CommitsNothing(t *testing.T) {
+	sc := &spyCommitter{}
+
+	err := New(&fakeProductRepo{}, fakeOutboxRepo{}, &fakeHistoryRepo{}, sc, fakeClock{}).Execute(context.Background(), Request{ProductID: "p1", Currency: "USD"})
+	if !errors.Is(err, repo.ErrProductNotFound) {
+		t.Fatalf("expected ErrProductNotFound, got %v", err)
+	}
+	if sc.applied != 0 {
+		t.Fatalf("expected no commit, got %d", sc.applied)
+	}
+}
+
+func TestRemovePrice_StaleExpectedVersion_ReturnsConcurrentModification(t *testing.T) {
+	p := newProduct(t)
+	sc := &spyCommitter{}
+
+	err := New(&fakeProductRepo{p: p}, fakeOutboxRepo{}, &fakeHistoryRepo{}, sc, fakeClock{}).Execute(context.Background(), Request{ProductID: "p1", Currency: "USD", ExpectedVersion: 2})
+	if !errors.Is(err, committer.ErrConcurrentModification) {
+		t.Fatalf("expected ErrConcurrentModification, got %v", err)
+	}
+	if _, ok := p.PriceIn("USD"); !ok || sc.applied != 0 {
+		t.Fatalf("expected nothing changed, got %d commits", sc.applied)
+	}
+}
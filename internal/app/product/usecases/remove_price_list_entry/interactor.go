@@ -0,0 +1,45 @@
+package remove_price_list_entry
+
+import (
+	"context"
+
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/pkg/committer"
+)
+
+type Request struct {
+	PriceListID     string
+	ProductID       string
+	Currency        domain.Currency
+	ExpectedVersion int64
+}
+
+type Interactor struct {
+	lists contracts.PriceListRepo
+	comm  committer.Committer
+}
+
+func New(lists contracts.PriceListRepo, comm committer.Committer) *Interactor {
+	return &Interactor{lists: lists, comm: comm}
+}
+
+func (it *Interactor) Execute(ctx context.Context, req Request) error {
+	l, err := it.lists.GetByID(ctx, req.PriceListID)
+	if err != nil {
+		return err
+	}
+	if req.ExpectedVersion != 0 && req.ExpectedVersion != l.Version() {
+		return committer.ErrConcurrentModification
+	}
+
+	if err := l.RemoveEntry(req.ProductID, req.Currency); err != nil {
+		return err
+	}
+
+	plan := committer.NewPlan()
+
+	plan.Add(it.lists.UpdateMut(l))
+
+	return it.comm.Apply(ctx, plan)
+}
@@ -0,0 +1,117 @@
+package remove_price_list_entry
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/app/product/repo"
+	"product-catalog-service/internal/pkg/committer"
+)
+
+type fakeMut struct{}
+
+func (fakeMut) IsMutation() {}
+
+type fakePriceListRepo struct {
+	l       *domain.PriceList
+	insertN int
+	updateN int
+}
+
+func (r *fakePriceListRepo) GetByID(ctx context.Context, id string) (*domain.PriceList, error) {
+	if r.l == nil || r.l.ID() != id {
+		return nil, repo.ErrPriceListNotFound
+	}
+	return r.l, nil
+}
+func (r *fakePriceListRepo) Select(ctx context.Context, sel contracts.PriceListSelector, productIDs []string) ([]*domain.PriceList, error) {
+	return nil, nil
+}
+func (r *fakePriceListRepo) InsertMut(l *domain.PriceList) contracts.Mutation {
+	r.insertN++
+	return fakeMut{}
+}
+func (r *fakePriceListRepo) UpdateMut(l *domain.PriceList) contracts.Mutation {
+	r.updateN++
+	return fakeMut{}
+}
+
+type spyCommitter struct {
+	applied int
+	last    *committer.Plan
+}
+
+func (s *spyCommitter) Apply(ctx context.Context, plan *committer.Plan) error {
+	s.applied++
+	s.last = plan
+	return nil
+}
+
+func newPriceList(entries ...*domain.PriceListEntry) *domain.PriceList {
+	return domain.HydratePriceList("pl1", "Wholesale", "wholesale", 1, time.Time{}, time.Time{}, entries, 3)
+}
+
+func newEntry(t *testing.T) *domain.PriceListEntry {
+	t.Helper()
+	m, err := domain.NewMoneyFromFraction(8, 1)
+	if err != nil {
+		t.Fatalf("setup money: %v", err)
+	}
+	e, err := domain.NewPriceListEntry("p1", m)
+	if err != nil {
+		t.Fatalf("setup entry: %v", err)
+	}
+	return e
+}
+
+func TestRemovePriceListEntry_CommitsUpdate(t *testing.T) {
+	l := newPriceList(newEntry(t))
+	lr := &fakePriceListRepo{l: l}
+	sc := &spyCommitter{}
+
+	if err := New(lr, sc).Execute(context.Background(), Request{PriceListID: "pl1", ProductID: "p1", Currency: domain.DefaultCurrency, ExpectedVersion: 3}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(l.Entries()) != 0 {
+		t.Fatalf("expected the entry removed, got %v", l.Entries())
+	}
+	if lr.updateN != 1 || sc.applied != 1 {
+		t.Fatalf("expected one update in one commit, got %d updates and %d commits", lr.updateN, sc.applied)
+	}
+}
+
+func TestRemovePriceListEntry_NotFound_CommitsNothing(t *testing.T) {
+	for _, c := range []struct {
+		lists *fakePriceListRepo
+		want  error
+	}{
+		{&fakePriceListRepo{}, repo.ErrPriceListNotFound},
+		{&fakePriceListRepo{l: newPriceList()}, domain.ErrPriceListEntryNotFound},
+	} {
+		sc := &spyCommitter{}
+		err := New(c.lists, sc).Execute(context.Background(), Request{PriceListID: "pl1", ProductID: "p1", Currency: domain.DefaultCurrency})
+		if !errors.Is(err, c.want) {
+			t.Fatalf("expected %v, got %v", c.want, err)
+		}
+		if sc.applied != 0 {
+			t.Fatalf("expected no commit, got %d", sc.applied)
+		}
+	}
+}
+
+func TestRemovePriceListEntry_StaleExpectedVersion_ReturnsConcurrentModification(t *testing.T) {
+	l := newPriceList(newEntry(t))
+	sc := &spyCommitter{}
+
+	err := New(&fakePriceListRepo{l: l}, sc).Execute(context.Background(), Request{PriceListID: "pl1", ProductID: "p1", Currency: domain.DefaultCurrency, ExpectedVersion: 2})
+	if !errors.Is(err, committer.ErrConcurrentModification) {
+		t.Fatalf("expected ErrConcurrentModification, got %v", err)
+	}
+	if len(l.Entries()) != 1 || sc.applied != 0 {
+		t.Fatalf("expected nothing changed, got %d commits", sc.applied)
+	}
+}
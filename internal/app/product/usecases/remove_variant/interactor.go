@@ -0,0 +1,51 @@
+package remove_variant
+
+import (
+	"context"
+
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/events"
+	"product-catalog-service/internal/pkg/clock"
+	"product-catalog-service/internal/pkg/committer"
+)
+
+type Request struct {
+	ProductID       string
+	VariantID       string
+	ExpectedVersion int64
+}
+
+type Interactor struct {
+	products contracts.ProductRepo
+	outbox   contracts.OutboxRepo
+	comm     committer.Committer
+	clock    clock.Clock
+}
+
+func New(products contracts.ProductRepo, outbox contracts.OutboxRepo, comm committer.Committer, clk clock.Clock) *Interactor {
+	return &Interactor{products: products, outbox: outbox, comm: comm, clock: clk}
+}
+
+func (it *Interactor) Execute(ctx context.Context, req Request) error {
+	p, err := it.products.GetByID(ctx, req.ProductID)
+	if err != nil {
+		return err
+	}
+	if req.ExpectedVersion != 0 && req.ExpectedVersion != p.Version() {
+		return committer.ErrConcurrentModification
+	}
+
+	if err := p.RemoveVariant(req.VariantID, it.clock.Now()); err != nil {
+		return err
+	}
+
+	plan := committer.NewPlan()
+
+	plan.Add(it.products.UpdateMut(p))
+
+	if err := events.AppendToPlan(plan, it.outbox, p.DomainEvents()); err != nil {
+		return err
+	}
+
+	return it.comm.Apply(ctx, plan)
+}
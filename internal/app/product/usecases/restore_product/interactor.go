@@ -0,0 +1,56 @@
+package restore_product
+
+import (
+	"context"
+	"time"
+
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/events"
+	"product-catalog-service/internal/pkg/clock"
+	"product-catalog-service/internal/pkg/committer"
+)
+
+type Request struct {
+	ProductID       string
+	ExpectedVersion int64
+}
+
+type Interactor struct {
+	products  contracts.ProductRepo
+	outbox    contracts.OutboxRepo
+	comm      committer.Committer
+	clock     clock.Clock
+	retention time.Duration
+}
+
+func New(products contracts.ProductRepo, outbox contracts.OutboxRepo, comm committer.Committer, clk clock.Clock, retention time.Duration) *Interactor {
+	return &Interactor{products: products, outbox: outbox, comm: comm, clock: clk, retention: retention}
+}
+
+func (it *Interactor) Execute(ctx context.Context, req Request) error {
+	p, err := it.products.GetByID(ctx, req.ProductID)
+	if err != nil {
+		return err
+	}
+	if req.ExpectedVersion != 0 && req.ExpectedVersion != p.Version() {
+		return committer.ErrConcurrentModification
+	}
+
+	err = p.Restore(it.clock.Now(), it.retention)
+	if err != nil {
+		return err
+	}
+	if !p.Changes().Any() {
+		// Not archived; there is nothing to restore.
+		return nil
+	}
+
+	plan := committer.NewPlan()
+
+	plan.Add(it.products.UpdateMut(p))
+
+	if err := events.AppendToPlan(plan, it.outbox, p.DomainEvents()); err != nil {
+		return err
+	}
+	return it.comm.Apply(ctx, plan)
+}
@@ -0,0 +1,138 @@
+package restore_product
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/pkg/committer"
+)
+
+type fakeClock struct{ t time.Time }
+
+func (f fakeClock) Now() time.Time { return f.t }
+
+type fakeMut struct{}
+
+func (fakeMut) IsMutation() {}
+
+type fakeProductRepo struct {
+	p *domain.Product
+}
+
+func (r *fakeProductRepo) GetByID(ctx context.Context, id string) (*domain.Product, error) {
+	return r.p, nil
+}
+func (r *fakeProductRepo) SKUOwner(ctx context.Context, sku string) (string, error) {
+	return "", nil
+}
+func (r *fakeProductRepo) GTINOwner(ctx context.Context, gtin domain.GTIN) (string, error) {
+	return "", nil
+}
+func (r *fakeProductRepo) InsertMut(p *domain.Product) contracts.Mutation { return fakeMut{} }
+func (r *fakeProductRepo) UpdateMut(p *domain.Product) contracts.Mutation {
+	if !p.Changes().Any() {
+		return nil
+	}
+	return fakeMut{}
+}
+
+type fakeOutboxRepo struct{}
+
+func (fakeOutboxRepo) InsertMut(eventID, eventType, aggregateID string, payload []byte) contracts.Mutation {
+	return fakeMut{}
+}
+
+type spyCommitter struct {
+	applied int
+	last    *committer.Plan
+}
+
+func (s *spyCommitter) Apply(ctx context.Context, plan *committer.Plan) error {
+	s.applied++
+	s.last = plan
+	return nil
+}
+
+const retention = 30 * 24 * time.Hour
+
+func newProduct(t *testing.T, archivedAt *time.Time) *domain.Product {
+	t.Helper()
+	price, err := domain.NewMoneyFromFraction(10, 1)
+	if err != nil {
+		t.Fatalf("setup money: %v", err)
+	}
+	return domain.HydrateProduct(domain.HydrateProductParams{ID: "p1", Name: "Name", Category: "Cat", BasePrice: price, Status: domain.ProductStatusInactive, ArchivedAt: archivedAt, Version: 3})
+}
+
+func TestRestoreProduct_WithinRetention_CommitsUpdateAndEvent(t *testing.T) {
+	now := time.Date(2026, 1, 12, 12, 0, 0, 0, time.UTC)
+	archivedAt := now.Add(-retention)
+	p := newProduct(t, &archivedAt)
+	sc := &spyCommitter{}
+
+	if err := New(&fakeProductRepo{p: p}, fakeOutboxRepo{}, sc, fakeClock{t: now}, retention).Execute(context.Background(), Request{ProductID: "p1", ExpectedVersion: 3}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if p.ArchivedAt() != nil {
+		t.Fatalf("expected archivedAt cleared")
+	}
+	if sc.applied != 1 || len(sc.last.Mutations()) != 2 {
+		t.Fatalf("expected one commit with the update and the event, got %d commits", sc.applied)
+	}
+	ev := p.DomainEvents()
+	if len(ev) != 1 || ev[0].EventType() != "product.restored" {
+		t.Fatalf("expected product.restored, got %v", ev)
+	}
+}
+
+func TestRestoreProduct_AfterRetention_FailsWithoutCommit(t *testing.T) {
+	now := time.Date(2026, 1, 12, 12, 0, 0, 0, time.UTC)
+	archivedAt := now.Add(-retention - time.Second)
+	p := newProduct(t, &archivedAt)
+	sc := &spyCommitter{}
+
+	err := New(&fakeProductRepo{p: p}, fakeOutboxRepo{}, sc, fakeClock{t: now}, retention).Execute(context.Background(), Request{ProductID: "p1"})
+	if !errors.Is(err, domain.ErrRestoreWindowExpired) {
+		t.Fatalf("expected ErrRestoreWindowExpired, got %v", err)
+	}
+	if sc.applied != 0 {
+		t.Fatalf("expected no commit, got %d", sc.applied)
+	}
+	if p.ArchivedAt() == nil {
+		t.Fatalf("expected product to stay archived")
+	}
+}
+
+func TestRestoreProduct_NotArchived_WritesNothing(t *testing.T) {
+	now := time.Date(2026, 1, 12, 12, 0, 0, 0, time.UTC)
+	p := newProduct(t, nil)
+	sc := &spyCommitter{}
+
+	if err := New(&fakeProductRepo{p: p}, fakeOutboxRepo{}, sc, fakeClock{t: now}, retention).Execute(context.Background(), Request{ProductID: "p1"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if sc.applied != 0 {
+		t.Fatalf("expected no commit, got %d", sc.applied)
+	}
+	if len(p.DomainEvents()) != 0 {
+		t.Fatalf("expected no events, got %v", p.DomainEvents())
+	}
+}
+
+func TestRestoreProduct_StaleVersion_ReturnsErrConcurrentModification(t *testing.T) {
+	now := time.Date(2026, 1, 12, 12, 0, 0, 0, time.UTC)
+	archivedAt := now.Add(-time.Hour)
+	sc := &spyCommitter{}
+
+	err := New(&fakeProductRepo{p: newProduct(t, &archivedAt)}, fakeOutboxRepo{}, sc, fakeClock{t: now}, retention).Execute(context.Background(), Request{ProductID: "p1", ExpectedVersion: 2})
+	if !errors.Is(err, committer.ErrConcurrentModification) {
+		t.Fatalf("expected ErrConcurrentModification, got %v", err)
+	}
+	if sc.applied != 0 {
+		t.Fatalf("expected no commit, got %d", sc.applied)
+	}
+}
@@ -0,0 +1,41 @@
+package set_category_tax_class
+
+import (
+	"context"
+
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/pkg/committer"
+)
+
+type Request struct {
+	Category string
+	// TaxClass applies to every product in Category without a class of its
+	// own; empty removes the assignment.
+	TaxClass domain.TaxClass
+}
+
+type Interactor struct {
+	taxes contracts.TaxRepo
+	comm  committer.Committer
+}
+
+func New(taxes contracts.TaxRepo, comm committer.Committer) *Interactor {
+	return &Interactor{taxes: taxes, comm: comm}
+}
+
+func (it *Interactor) Execute(ctx context.Context, req Request) error {
+	if req.Category == "" {
+		return domain.ErrInvalidCategory
+	}
+	class, err := domain.ParseTaxClass(string(req.TaxClass))
+	if err != nil {
+		return err
+	}
+
+	plan := committer.NewPlan()
+
+	plan.Add(it.taxes.AssignCategoryMut(req.Category, class))
+
+	return it.comm.Apply(ctx, plan)
+}
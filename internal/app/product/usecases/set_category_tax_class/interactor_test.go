@@ -0,0 +1,94 @@
+package set_category_tax_class
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/pkg/committer"
+)
+
+type fakeMut struct{}
+
+func (fakeMut) IsMutation() {}
+
+type fakeTaxRepo struct {
+	rates   []*domain.TaxRate
+	classes map[string]domain.TaxClass
+}
+
+func (r *fakeTaxRepo) Rate(ctx context.Context, region domain.Region, class domain.TaxClass) (*domain.TaxRate, error) {
+	return nil, domain.ErrTaxRateNotFound
+}
+func (r *fakeTaxRepo) CategoryClass(ctx context.Context, category string) (domain.TaxClass, error) {
+	return r.classes[category], nil
+}
+func (r *fakeTaxRepo) UpsertRateMut(rate *domain.TaxRate) contracts.Mutation {
+	r.rates = append(r.rates, rate)
+	return fakeMut{}
+}
+func (r *fakeTaxRepo) AssignCategoryMut(category string, class domain.TaxClass) contracts.Mutation {
+	if r.classes == nil {
+		r.classes = map[string]domain.TaxClass{}
+	}
+	r.classes[category] = class
+	return fakeMut{}
+}
+
+type spyCommitter struct {
+	applied int
+	last    *committer.Plan
+}
+
+func (s *spyCommitter) Apply(ctx context.Context, plan *committer.Plan) error {
+	s.applied++
+	s.last = plan
+	return nil
+}
+
+func TestSetCategoryTaxClass_CommitsAssignment(t *testing.T) {
+	tr := &fakeTaxRepo{}
+	sc := &spyCommitter{}
+
+	if err := New(tr, sc).Execute(context.Background(), Request{Category: "books", TaxClass: "reduced"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if tr.classes["books"] != "reduced" {
+		t.Fatalf("expected books assigned reduced, got %v", tr.classes)
+	}
+	if sc.applied != 1 || len(sc.last.Mutations()) != 1 {
+		t.Fatalf("expected one commit with one mutation, got %d commits", sc.applied)
+	}
+}
+
+func TestSetCategoryTaxClass_EmptyClass_RemovesAssignment(t *testing.T) {
+	tr := &fakeTaxRepo{classes: map[string]domain.TaxClass{"books": "reduced"}}
+	sc := &spyCommitter{}
+
+	if err := New(tr, sc).Execute(context.Background(), Request{Category: "books"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if c, ok := tr.classes["books"]; !ok || c != "" || sc.applied != 1 {
+		t.Fatalf("expected the assignment removed in one commit, got %v", tr.classes)
+	}
+}
+
+func TestSetCategoryTaxClass_InvalidInput_CommitsNothing(t *testing.T) {
+	for _, c := range []struct {
+		req  Request
+		want error
+	}{
+		{Request{TaxClass: "reduced"}, domain.ErrInvalidCategory},
+		{Request{Category: "books", TaxClass: "Reduced Rate"}, domain.ErrInvalidTaxClass},
+	} {
+		sc := &spyCommitter{}
+		if err := New(&fakeTaxRepo{}, sc).Execute(context.Background(), c.req); !errors.Is(err, c.want) {
+			t.Fatalf("%+v: expected %v, got %v", c.req, c.want, err)
+		}
+		if sc.applied != 0 {
+			t.Fatalf("%+v: expected no commit, got %d", c.req, sc.applied)
+		}
+	}
+}
@@ -0,0 +1,47 @@
+package set_exchange_rate
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/pkg/clock"
+	"product-catalog-service/internal/pkg/committer"
+)
+
+type Request struct {
+	From domain.Currency
+	To   domain.Currency
+	Rate *big.Rat
+	// EffectiveFrom defaults to now. Setting a rate again for the same
+	// pair and time replaces it.
+	EffectiveFrom time.Time
+}
+
+type Interactor struct {
+	rates contracts.ExchangeRateRepo
+	comm  committer.Committer
+	clock clock.Clock
+}
+
+func New(rates contracts.ExchangeRateRepo, comm committer.Committer, clk clock.Clock) *Interactor {
+	return &Interactor{rates: rates, comm: comm, clock: clk}
+}
+
+func (it *Interactor) Execute(ctx context.Context, req Request) error {
+	if req.EffectiveFrom.IsZero() {
+		req.EffectiveFrom = it.clock.Now()
+	}
+	rate, err := domain.NewExchangeRate(req.From, req.To, req.Rate, req.EffectiveFrom)
+	if err != nil {
+		return err
+	}
+
+	plan := committer.NewPlan()
+
+	plan.Add(it.rates.UpsertMut(rate))
+
+	return it.comm.Apply(ctx, plan)
+}
@@ -0,0 +1,130 @@
+package set_identifiers
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/app/product/repo"
+	"product-catalog-service/internal/pkg/committer"
+)
+
+type fakeClock struct{ t time.Time }
+
+func (f fakeClock) Now() time.Time { return f.t }
+
+type fakeMut struct{}
+
+func (fakeMut) IsMutation() {}
+
+type fakeProductRepo struct {
+	p       *domain.Product
+	owners  map[string]string
+	updateN int
+}
+
+func (r *fakeProductRepo) GetByID(ctx context.Context, id string) (*domain.Product, error) {
+	if r.p == nil || r.p.ID() != id {
+		return nil, repo.ErrProductNotFound
+	}
+	return r.p, nil
+}
+func (r *fakeProductRepo) SKUOwner(ctx context.Context, sku string) (string, error) {
+	return r.owners[sku], nil
+}
+func (r *fakeProductRepo) GTINOwner(ctx context.Context, gtin domain.GTIN) (string, error) {
+	return r.owners[string(gtin)], nil
+}
+func (r *fakeProductRepo) InsertMut(p *domain.Product) contracts.Mutation { return fakeMut{} }
+func (r *fakeProductRepo) UpdateMut(p *domain.Product) contracts.Mutation {
+	r.updateN++
+	return fakeMut{}
+}
+
+type fakeOutboxRepo struct{}
+
+func (fakeOutboxRepo) InsertMut(eventID, eventType, aggregateID string, payload []byte) contracts.Mutation {
+	return fakeMut{}
+}
+
+type spyCommitter struct {
+	applied int
+	last    *committer.Plan
+}
+
+func (s *spyCommitter) Apply(ctx context.Context, plan *committer.Plan) error {
+	s.applied++
+	s.last = plan
+	return nil
+}
+
+func newProduct(t *testing.T, variants ...*domain.Variant) *domain.Product {
+	t.Helper()
+	price, err := domain.NewMoneyFromFraction(10, 1)
+	if err != nil {
+		t.Fatalf("setup money: %v", err)
+	}
+	return domain.HydrateProduct(domain.HydrateProductParams{ID: "p1", Name: "Name", Category: "Cat", SKU: "TEE", BasePrice: price, Variants: variants, Status: domain.ProductStatusActive, Version: 3})
+}
+
+func TestSetIdentifiers_CommitsUpdateAndEvent(t *testing.T) {
+	now := time.Date(2026, 1, 12, 12, 0, 0, 0, time.UTC)
+	p := newProduct(t)
+	pr := &fakeProductRepo{p: p}
+	sc := &spyCommitter{}
+
+	if err := New(pr, fakeOutboxRepo{}, sc, fakeClock{t: now}).Execute(context.Background(), Request{ProductID: "p1", SKU: "SHIRT", GTIN: "4006381333931", ExpectedVersion: 3}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if p.SKU() != "SHIRT" || p.GTIN() != "04006381333931" {
+		t.Fatalf("expected the new identifiers, got %q and %q", p.SKU(), p.GTIN())
+	}
+	if pr.updateN != 1 || sc.applied != 1 {
+		t.Fatalf("expected one update in one commit, got %d updates and %d commits", pr.updateN, sc.applied)
+	}
+	ev := p.DomainEvents()
+	if len(ev) != 1 || ev[0].EventType() != "product.updated" {
+		t.Fatalf("expected product.updated, got %v", ev)
+	}
+}
+
+func TestSetIdentifiers_GTINOfAnotherProduct_ReturnsErrDuplicateGTIN(t *testing.T) {
+	sc := &spyCommitter{}
+
+	pr := &fakeProductRepo{p: newProduct(t), owners: map[string]string{"04006381333931": "p2"}}
+	err := New(pr, fakeOutboxRepo{}, sc, fakeClock{}).Execute(context.Background(), Request{ProductID: "p1", SKU: "TEE", GTIN: "4006381333931"})
+	if !errors.Is(err, domain.ErrDuplicateGTIN) {
+		t.Fatalf("expected ErrDuplicateGTIN, got %v", err)
+	}
+	if sc.applied != 0 {
+		t.Fatalf("expected no commit, got %d", sc.applied)
+	}
+}
+
+func TestSetIdentifiers_ProductNotFound_CommitsNothing(t *testing.T) {
+	sc := &spyCommitter{}
+
+	err := New(&fakeProductRepo{}, fakeOutboxRepo{}, sc, fakeClock{}).Execute(context.Background(), Request{ProductID: "p1", SKU: "SHIRT"})
+	if !errors.Is(err, repo.ErrProductNotFound) {
+		t.Fatalf("expected ErrProductNotFound, got %v", err)
+	}
+	if sc.applied != 0 {
+		t.Fatalf("expected no commit, got %d", sc.applied)
+	}
+}
+
+func TestSetIdentifiers_StaleExpectedVersion_ReturnsConcurrentModification(t *testing.T) {
+	p := newProduct(t)
+	sc := &spyCommitter{}
+
+	err := New(&fakeProductRepo{p: p}, fakeOutboxRepo{}, sc, fakeClock{}).Execute(context.Background(), Request{ProductID: "p1", SKU: "SHIRT", ExpectedVersion: 2})
+	if !errors.Is(err, committer.ErrConcurrentModification) {
+		t.Fatalf("expected ErrConcurrentModification, got %v", err)
+	}
+	if p.SKU() != "TEE" || sc.applied != 0 {
+		t.Fatalf("expected nothing changed, got %d commits", sc.applied)
+	}
+}
@@ -0,0 +1,53 @@
+package set_price_list_entry
+
+import (
+	"context"
+
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/pkg/committer"
+)
+
+type Request struct {
+	PriceListID     string
+	ProductID       string
+	Price           *domain.Money
+	ExpectedVersion int64
+}
+
+type Interactor struct {
+	lists    contracts.PriceListRepo
+	products contracts.ProductRepo
+	comm     committer.Committer
+}
+
+func New(lists contracts.PriceListRepo, products contracts.ProductRepo, comm committer.Committer) *Interactor {
+	return &Interactor{lists: lists, products: products, comm: comm}
+}
+
+func (it *Interactor) Execute(ctx context.Context, req Request) error {
+	l, err := it.lists.GetByID(ctx, req.PriceListID)
+	if err != nil {
+		return err
+	}
+	if req.ExpectedVersion != 0 && req.ExpectedVersion != l.Version() {
+		return committer.ErrConcurrentModification
+	}
+	if _, err := it.products.GetByID(ctx, req.ProductID); err != nil {
+		return err
+	}
+
+	e, err := domain.NewPriceListEntry(req.ProductID, req.Price)
+	if err != nil {
+		return err
+	}
+	if err := l.SetEntry(e); err != nil {
+		return err
+	}
+
+	plan := committer.NewPlan()
+
+	plan.Add(it.lists.UpdateMut(l))
+
+	return it.comm.Apply(ctx, plan)
+}
@@ -0,0 +1,140 @@
+package set_price_list_entry
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/app/product/repo"
+	"product-catalog-service/internal/pkg/committer"
+)
+
+type fakeMut struct{}
+
+func (fakeMut) IsMutation() {}
+
+type fakePriceListRepo struct {
+	l       *domain.PriceList
+	insertN int
+	updateN int
+}
+
+func (r *fakePriceListRepo) GetByID(ctx context.Context, id string) (*domain.PriceList, error) {
+	if r.l == nil || r.l.ID() != id {
+		return nil, repo.ErrPriceListNotFound
+	}
+	return r.l, nil
+}
+func (r *fakePriceListRepo) Select(ctx context.Context, sel contracts.PriceListSelector, productIDs []string) ([]*domain.PriceList, error) {
+	return nil, nil
+}
+func (r *fakePriceListRepo) InsertMut(l *domain.PriceList) contracts.Mutation {
+	r.insertN++
+	return fakeMut{}
+}
+func (r *fakePriceListRepo) UpdateMut(l *domain.PriceList) contracts.Mutation {
+	r.updateN++
+	return fakeMut{}
+}
+
+type fakeProductRepo struct{ p *domain.Product }
+
+func (r *fakeProductRepo) GetByID(ctx context.Context, id string) (*domain.Product, error) {
+	if r.p == nil || r.p.ID() != id {
+		return nil, repo.ErrProductNotFound
+	}
+	return r.p, nil
+}
+func (r *fakeProductRepo) SKUOwner(ctx context.Context, sku string) (string, error) {
+	return "", nil
+}
+func (r *fakeProductRepo) GTINOwner(ctx context.Context, gtin domain.GTIN) (string, error) {
+	return "", nil
+}
+func (r *fakeProductRepo) InsertMut(p *domain.Product) contracts.Mutation { return fakeMut{} }
+func (r *fakeProductRepo) UpdateMut(p *domain.Product) contracts.Mutation { return fakeMut{} }
+
+type spyCommitter struct {
+	applied int
+	last    *committer.Plan
+}
+
+func (s *spyCommitter) Apply(ctx context.Context, plan *committer.Plan) error {
+	s.applied++
+	s.last = plan
+	return nil
+}
+
+func newPriceList(entries ...*domain.PriceListEntry) *domain.PriceList {
+	return domain.HydratePriceList("pl1", "Wholesale", "wholesale", 1, time.Time{}, time.Time{}, entries, 3)
+}
+
+func newProduct(t *testing.T) *domain.Product {
+	t.Helper()
+	price, err := domain.NewMoneyFromFraction(10, 1)
+	if err != nil {
+		t.Fatalf("setup money: %v", err)
+	}
+	return domain.HydrateProduct(domain.HydrateProductParams{ID: "p1", Name: "Name", Category: "Cat", BasePrice: price, Status: domain.ProductStatusActive, Version: 1})
+}
+
+func newPrice(t *testing.T) *domain.Money {
+	t.Helper()
+	m, err := domain.NewMoneyFromFraction(8, 1)
+	if err != nil {
+		t.Fatalf("setup money: %v", err)
+	}
+	return m
+}
+
+func TestSetPriceListEntry_CommitsUpdate(t *testing.T) {
+	l := newPriceList()
+	lr := &fakePriceListRepo{l: l}
+	sc := &spyCommitter{}
+
+	if err := New(lr, &fakeProductRepo{p: newProduct(t)}, sc).Execute(context.Background(), Request{PriceListID: "pl1", ProductID: "p1", Price: newPrice(t), ExpectedVersion: 3}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := l.EntriesFor("p1"); len(got) != 1 || got[0].Rat().Cmp(newPrice(t).Rat()) != 0 {
+		t.Fatalf("expected the entry for p1, got %v", got)
+	}
+	if lr.updateN != 1 || sc.applied != 1 {
+		t.Fatalf("expected one update in one commit, got %d updates and %d commits", lr.updateN, sc.applied)
+	}
+}
+
+func TestSetPriceListEntry_NotFound_CommitsNothing(t *testing.T) {
+	for _, c := range []struct {
+		lists    *fakePriceListRepo
+		products *fakeProductRepo
+		want     error
+	}{
+		{&fakePriceListRepo{}, &fakeProductRepo{p: newProduct(t)}, repo.ErrPriceListNotFound},
+		{&fakePriceListRepo{l: newPriceList()}, &fakeProductRepo{}, repo.ErrProductNotFound},
+	} {
+		sc := &spyCommitter{}
+		err := New(c.lists, c.products, sc).Execute(context.Background(), Request{PriceListID: "pl1", ProductID: "p1", Price: newPrice(t)})
+		if !errors.Is(err, c.want) {
+			t.Fatalf("expected %v, got %v", c.want, err)
+		}
+		if sc.applied != 0 {
+			t.Fatalf("expected no commit, got %d", sc.applied)
+		}
+	}
+}
+
+func TestSetPriceListEntry_StaleExpectedVersion_ReturnsConcurrentModification(t *testing.T) {
+	l := newPriceList()
+	sc := &spyCommitter{}
+
+	err := New(&fakePriceListRepo{l: l}, &fakeProductRepo{p: newProduct(t)}, sc).Execute(context.Background(), Request{PriceListID: "pl1", ProductID: "p1", Price: newPrice(t), ExpectedVersion: 2})
+	if !errors.Is(err, committer.ErrConcurrentModification) {
+		t.Fatalf("expected ErrConcurrentModification, got %v", err)
+	}
+	if len(l.Entries()) != 0 || sc.applied != 0 {
+		t.Fatalf("expected nothing changed, got %d commits", sc.applied)
+	}
+}
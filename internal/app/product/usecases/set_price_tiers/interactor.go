@@ -0,0 +1,76 @@
+package set_price_tiers
+
+import (
+	"context"
+	"math/big"
+
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/app/product/events"
+	"product-catalog-service/internal/pkg/clock"
+	"product-catalog-service/internal/pkg/committer"
+)
+
+// Tier is one quantity break; its unit price is taken to be in the
+// product's base currency. MaxQuantity is zero for an open-ended tier.
+type Tier struct {
+	MinQuantity int64
+	MaxQuantity int64
+	UnitPrice   *big.Rat
+}
+
+type Request struct {
+	ProductID string
+	// Tiers replace the product's quantity breaks; none removes them.
+	Tiers           []Tier
+	ExpectedVersion int64
+}
+
+// Interactor needs no price history: the history records the listed
+// prices, and tiers only price orders.
+type Interactor struct {
+	products contracts.ProductRepo
+	outbox   contracts.OutboxRepo
+	comm     committer.Committer
+	clock    clock.Clock
+}
+
+func New(products contracts.ProductRepo, outbox contracts.OutboxRepo, comm committer.Committer, clk clock.Clock) *Interactor {
+	return &Interactor{products: products, outbox: outbox, comm: comm, clock: clk}
+}
+
+func (it *Interactor) Execute(ctx context.Context, req Request) error {
+	p, err := it.products.GetByID(ctx, req.ProductID)
+	if err != nil {
+		return err
+	}
+	if req.ExpectedVersion != 0 && req.ExpectedVersion != p.Version() {
+		return committer.ErrConcurrentModification
+	}
+
+	tiers := make([]*domain.PriceTier, 0, len(req.Tiers))
+	for _, t := range req.Tiers {
+		price, err := domain.NewMoney(t.UnitPrice, p.BasePrice().Currency())
+		if err != nil {
+			return err
+		}
+		tier, err := domain.NewPriceTier(t.MinQuantity, t.MaxQuantity, price)
+		if err != nil {
+			return err
+		}
+		tiers = append(tiers, tier)
+	}
+	if err := p.SetPriceTiers(tiers, it.clock.Now()); err != nil {
+		return err
+	}
+
+	plan := committer.NewPlan()
+
+	plan.Add(it.products.UpdateMut(p))
+
+	if err := events.AppendToPlan(plan, it.outbox, p.DomainEvents()); err != nil {
+		return err
+	}
+
+	return it.comm.Apply(ctx, plan)
+}
@@ -0,0 +1,40 @@
+package set_tax_rate
+
+import (
+	"context"
+	"math/big"
+
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/pkg/committer"
+)
+
+type Request struct {
+	Region   domain.Region
+	TaxClass domain.TaxClass
+	// Rate is the share of the net price, such as 19/100. Setting a rate
+	// again for the same region and class replaces it.
+	Rate *big.Rat
+}
+
+type Interactor struct {
+	taxes contracts.TaxRepo
+	comm  committer.Committer
+}
+
+func New(taxes contracts.TaxRepo, comm committer.Committer) *Interactor {
+	return &Interactor{taxes: taxes, comm: comm}
+}
+
+func (it *Interactor) Execute(ctx context.Context, req Request) error {
+	rate, err := domain.NewTaxRate(req.Region, req.TaxClass, req.Rate)
+	if err != nil {
+		return err
+	}
+
+	plan := committer.NewPlan()
+
+	plan.Add(it.taxes.UpsertRateMut(rate))
+
+	return it.comm.Apply(ctx, plan)
+}
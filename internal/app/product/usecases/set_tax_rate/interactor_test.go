@@ -0,0 +1,90 @@
+package set_tax_rate
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/pkg/committer"
+)
+
+type fakeMut struct{}
+
+func (fakeMut) IsMutation() {}
+
+type fakeTaxRepo struct {
+	rates   []*domain.TaxRate
+	classes map[string]domain.TaxClass
+}
+
+func (r *fakeTaxRepo) Rate(ctx context.Context, region domain.Region, class domain.TaxClass) (*domain.TaxRate, error) {
+	return nil, domain.ErrTaxRateNotFound
+}
+func (r *fakeTaxRepo) CategoryClass(ctx context.Context, category string) (domain.TaxClass, error) {
+	return r.classes[category], nil
+}
+func (r *fakeTaxRepo) UpsertRateMut(rate *domain.TaxRate) contracts.Mutation {
+	r.rates = append(r.rates, rate)
+	return fakeMut{}
+}
+func (r *fakeTaxRepo) AssignCategoryMut(category string, class domain.TaxClass) contracts.Mutation {
+	if r.classes == nil {
+		r.classes = map[string]domain.TaxClass{}
+	}
+	r.classes[category] = class
+	return fakeMut{}
+}
+
+type spyCommitter struct {
+	applied int
+	last    *committer.Plan
+}
+
+func (s *spyCommitter) Apply(ctx context.Context, plan *committer.Plan) error {
+	s.applied++
+	s.last = plan
+	return nil
+}
+
+func TestSetTaxRate_CommitsRate(t *testing.T) {
+	tr := &fakeTaxRepo{}
+	sc := &spyCommitter{}
+
+	if err := New(tr, sc).Execute(context.Background(), Request{Region: "DE", TaxClass: "standard", Rate: big.NewRat(19, 100)}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(tr.rates) != 1 || tr.rates[0].Region() != "DE" || tr.rates[0].Rate().Cmp(big.NewRat(19, 100)) != 0 {
+		t.Fatalf("expected the DE standard rate stored, got %v", tr.rates)
+	}
+	if sc.applied != 1 || len(sc.last.Mutations()) != 1 {
+		t.Fatalf("expected one commit with one mutation, got %d commits", sc.applied)
+	}
+}
+
+func TestSetTaxRate_InvalidRate_CommitsNothing(t *testing.T) {
+	tr := &fakeTaxRepo{}
+	sc := &spyCommitter{}
+
+	err := New(tr, sc).Execute(context.Background(), Request{Region: "DE", TaxClass: "standard", Rate: big.NewRat(3, 2)})
+	if !errors.Is(err, domain.ErrInvalidTaxRate) {
+		t.Fatalf("expected ErrInvalidTaxRate, got %v", err)
+	}
+	if sc.applied != 0 || len(tr.rates) != 0 {
+		t.Fatalf("expected nothing written, got %d commits", sc.applied)
+	}
+}
+
+func TestSetTaxRate_InvalidRegion_CommitsNothing(t *testing.T) {
+	sc := &spyCommitter{}
+
+	err := New(&fakeTaxRepo{}, sc).Execute(context.Background(), Request{Region: "germany", TaxClass: "standard", Rate: big.NewRat(19, 100)})
+	if !errors.Is(err, domain.ErrInvalidRegion) {
+		t.Fatalf("expected ErrInvalidRegion, got %v", err)
+	}
+	if sc.applied != 0 {
+		t.Fatalf("expected no commit, got %d", sc.applied)
+	}
+}
@@ -0,0 +1,47 @@
+package update_price_list
+
+import (
+	"context"
+	"time"
+
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/pkg/committer"
+)
+
+type Request struct {
+	PriceListID     string
+	Name            string
+	Priority        int64
+	ValidFrom       time.Time
+	ValidTo         time.Time
+	ExpectedVersion int64
+}
+
+type Interactor struct {
+	lists contracts.PriceListRepo
+	comm  committer.Committer
+}
+
+func New(lists contracts.PriceListRepo, comm committer.Committer) *Interactor {
+	return &Interactor{lists: lists, comm: comm}
+}
+
+func (it *Interactor) Execute(ctx context.Context, req Request) error {
+	l, err := it.lists.GetByID(ctx, req.PriceListID)
+	if err != nil {
+		return err
+	}
+	if req.ExpectedVersion != 0 && req.ExpectedVersion != l.Version() {
+		return committer.ErrConcurrentModification
+	}
+
+	if err := l.Update(req.Name, req.Priority, req.ValidFrom, req.ValidTo); err != nil {
+		return err
+	}
+
+	plan := committer.NewPlan()
+
+	plan.Add(it.lists.UpdateMut(l))
+
+	return it.comm.Apply(ctx, plan)
+}
@@ -0,0 +1,98 @@
+package update_price_list
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/app/product/repo"
+	"product-catalog-service/internal/pkg/committer"
+)
+
+type fakeMut struct{}
+
+func (fakeMut) IsMutation() {}
+
+type fakePriceListRepo struct {
+	l       *domain.PriceList
+	insertN int
+	updateN int
+}
+
+func (r *fakePriceListRepo) GetByID(ctx context.Context, id string) (*domain.PriceList, error) {
+	if r.l == nil || r.l.ID() != id {
+		return nil, repo.ErrPriceListNotFound
+	}
+	return r.l, nil
+}
+func (r *fakePriceListRepo) Select(ctx context.Context, sel contracts.PriceListSelector, productIDs []string) ([]*domain.PriceList, error) {
+	return nil, nil
+}
+func (r *fakePriceListRepo) InsertMut(l *domain.PriceList) contracts.Mutation {
+	r.insertN++
+	return fakeMut{}
+}
+func (r *fakePriceListRepo) UpdateMut(l *domain.PriceList) contracts.Mutation {
+	r.updateN++
+	return fakeMut{}
+}
+
+type spyCommitter struct {
+	applied int
+	last    *committer.Plan
+}
+
+func (s *spyCommitter) Apply(ctx context.Context, plan *committer.Plan) error {
+	s.applied++
+	s.last = plan
+	return nil
+}
+
+func newPriceList(entries ...*domain.PriceListEntry) *domain.PriceList {
+	return domain.HydratePriceList("pl1", "Wholesale", "wholesale", 1, time.Time{}, time.Time{}, entries, 3)
+}
+
+func TestUpdatePriceList_CommitsUpdate(t *testing.T) {
+	l := newPriceList()
+	lr := &fakePriceListRepo{l: l}
+	sc := &spyCommitter{}
+	to := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	if err := New(lr, sc).Execute(context.Background(), Request{PriceListID: "pl1", Name: "Trade", Priority: 5, ValidTo: to, ExpectedVersion: 3}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if l.Name() != "Trade" || l.Priority() != 5 || !l.ValidTo().Equal(to) {
+		t.Fatalf("expected the list updated, got %q at %d until %s", l.Name(), l.Priority(), l.ValidTo())
+	}
+	if lr.updateN != 1 || sc.applied != 1 {
+		t.Fatalf("expected one update in one commit, got %d updates and %d commits", lr.updateN, sc.applied)
+	}
+}
+
+func TestUpdatePriceList_NotFound_CommitsNothing(t *testing.T) {
+	sc := &spyCommitter{}
+
+	err := New(&fakePriceListRepo{}, sc).Execute(context.Background(), Request{PriceListID: "pl1", Name: "Trade"})
+	if !errors.Is(err, repo.ErrPriceListNotFound) {
+		t.Fatalf("expected ErrPriceListNotFound, got %v", err)
+	}
+	if sc.applied != 0 {
+		t.Fatalf("expected no commit, got %d", sc.applied)
+	}
+}
+
+func TestUpdatePriceList_StaleExpectedVersion_ReturnsConcurrentModification(t *testing.T) {
+	l := newPriceList()
+	sc := &spyCommitter{}
+
+	err := New(&fakePriceListRepo{l: l}, sc).Execute(context.Background(), Request{PriceListID: "pl1", Name: "Trade", ExpectedVersion: 2})
+	if !errors.Is(err, committer.ErrConcurrentModification) {
+		t.Fatalf("expected ErrConcurrentModification, got %v", err)
+	}
+	if l.Name() != "Wholesale" || sc.applied != 0 {
+		t.Fatalf("expected nothing changed, got %d commits", sc.applied)
+	}
+}
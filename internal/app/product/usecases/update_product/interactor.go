@@ -2,21 +2,19 @@ package update_product
 
 import (
 	"context"
-	"encoding/json"
-	"errors"
-
-	"github.com/google/uuid"
 
 	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/events"
 	"product-catalog-service/internal/pkg/clock"
 	"product-catalog-service/internal/pkg/committer"
 )
 
 type Request struct {
-	ProductID   string
-	Name        string
-	Description string
-	Category    string
+	ProductID       string
+	Name            string
+	Description     string
+	Category        string
+	ExpectedVersion int64
 }
 
 type Interactor struct {
@@ -35,6 +33,9 @@ func (it *Interactor) Execute(ctx context.Context, req Request) error {
 	if err != nil {
 		return err
 	}
+	if req.ExpectedVersion != 0 && req.ExpectedVersion != p.Version() {
+		return committer.ErrConcurrentModification
+	}
 
 	if err := p.UpdateDetails(req.Name, req.Description, req.Category, it.clock.Now()); err != nil {
 		return err
@@ -44,16 +45,8 @@ func (it *Interactor) Execute(ctx context.Context, req Request) error {
 
 	plan.Add(it.products.UpdateMut(p))
 
-	for _, e := range p.DomainEvents() {
-		b, err := json.Marshal(e)
-		if err != nil {
-			return err
-		}
-		m := it.outbox.InsertMut(uuid.NewString(), e.EventType(), req.ProductID, b)
-		if m == nil {
-			return errors.New("outbox mutation is nil")
-		}
-		plan.Add(m)
+	if err := events.AppendToPlan(plan, it.outbox, p.DomainEvents()); err != nil {
+		return err
 	}
 
 	return it.comm.Apply(ctx, plan)
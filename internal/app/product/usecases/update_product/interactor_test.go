@@ -2,6 +2,7 @@ package update_product
 
 import (
 	"context"
+	"errors"
 	"math/big"
 	"testing"
 	"time"
@@ -27,6 +28,12 @@ type fakeProductRepo struct {
 func (r *fakeProductRepo) GetByID(ctx context.Context, id string) (*domain.Product, error) {
 	return r.p, nil
 }
+func (r *fakeProductRepo) SKUOwner(ctx context.Context, sku string) (string, error) {
+	return "", nil
+}
+func (r *fakeProductRepo) GTINOwner(ctx context.Context, gtin domain.GTIN) (string, error) {
+	return "", nil
+}
 func (r *fakeProductRepo) InsertMut(p *domain.Product) contracts.Mutation { return fakeMut{} }
 func (r *fakeProductRepo) UpdateMut(p *domain.Product) contracts.Mutation {
 	r.updateN++
@@ -57,7 +64,7 @@ func TestUpdateProduct_Smoke(t *testing.T) {
 	if err != nil {
 		t.Fatalf("setup money: %v", err)
 	}
-	p, err := domain.NewProduct("p1", "Name", "Desc", "Cat", price, now)
+	p, err := domain.NewProduct(domain.NewProductParams{ID: "p1", Name: "Name", Description: "Desc", Category: "Cat", BasePrice: price}, now)
 	if err != nil {
 		t.Fatalf("setup product: %v", err)
 	}
@@ -86,3 +93,31 @@ func TestUpdateProduct_Smoke(t *testing.T) {
 		t.Fatalf("expected plan with at least 1 mutation")
 	}
 }
+
+func TestUpdateProduct_StaleExpectedVersion_ReturnsConcurrentModification(t *testing.T) {
+	now := time.Date(2026, 1, 12, 12, 0, 0, 0, time.UTC)
+
+	price, err := domain.NewMoneyFromRat(big.NewRat(1000, 100))
+	if err != nil {
+		t.Fatalf("setup money: %v", err)
+	}
+	p := domain.HydrateProduct(domain.HydrateProductParams{ID: "p1", Name: "Name", Description: "Desc", Category: "Cat", BasePrice: price, Status: domain.ProductStatusActive, Version: 3})
+
+	pr := &fakeProductRepo{p: p}
+	sc := &spyCommitter{}
+
+	it := New(pr, fakeOutboxRepo{}, sc, fakeClock{t: now})
+
+	err = it.Execute(context.Background(), Request{
+		ProductID:       "p1",
+		Name:            "New Name",
+		Category:        "Cat",
+		ExpectedVersion: 2,
+	})
+	if !errors.Is(err, committer.ErrConcurrentModification) {
+		t.Fatalf("expected ErrConcurrentModification, got %v", err)
+	}
+	if sc.applied != 0 {
+		t.Fatalf("expected Apply not called")
+	}
+}
@@ -0,0 +1,48 @@
+package memstore
+
+import (
+	"context"
+	"fmt"
+
+	"product-catalog-service/internal/pkg/committer"
+)
+
+type Committer struct {
+	store *Store
+}
+
+func NewCommitter(store *Store) *Committer {
+	return &Committer{store: store}
+}
+
+func (c *Committer) Apply(ctx context.Context, plan *committer.Plan) error {
+	if plan == nil || len(plan.Mutations()) == 0 {
+		return nil
+	}
+
+	muts := make([]Mutation, 0, len(plan.Mutations()))
+	for _, m := range plan.Mutations() {
+		switch m := m.(type) {
+		case Mutation:
+			muts = append(muts, m)
+		case Batch:
+			muts = append(muts, m...)
+		default:
+			return fmt.Errorf("unsupported mutation type: %T", m)
+		}
+	}
+	for _, m := range muts {
+		if m.Err != nil {
+			return m.Err
+		}
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return c.store.Atomically(func(*Snapshot) ([]Mutation, error) {
+		return muts, nil
+	})
+}
+
+var _ committer.Committer = (*Committer)(nil)
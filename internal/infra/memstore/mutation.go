@@ -0,0 +1,80 @@
+package memstore
+
+import (
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/pkg/committer"
+)
+
+type Op int
+
+const (
+	OpInsert Op = iota
+	OpUpdate
+	OpInsertOrUpdate
+	OpDelete
+)
+
+type Mutation struct {
+	Op    Op
+	Table string
+	Key   string
+	Row   Row
+	Guard *VersionGuard
+	// Err is set on a mutation a repo could not build; applying a plan
+	// that holds one fails with Err before anything is written.
+	Err error
+}
+
+func (Mutation) IsMutation() {}
+
+// Batch groups mutations that belong to one logical write, such as an
+// aggregate row and its child rows.
+type Batch []Mutation
+
+func (Batch) IsMutation() {}
+
+// VersionGuard is checked against the row the mutation targets before it
+// is applied. A missing or nil column is treated as version 0.
+type VersionGuard struct {
+	Column  string
+	Version int64
+}
+
+func (g *VersionGuard) check(row Row, exists bool) error {
+	if !exists {
+		return committer.ErrConcurrentModification
+	}
+	v, _ := row[g.Column].(int64)
+	if v != g.Version {
+		return committer.ErrConcurrentModification
+	}
+	return nil
+}
+
+// Failed stands in for a mutation that could not be built, such as a row
+// holding an amount too large to store, so the commit fails with err.
+func Failed(err error) Mutation {
+	return Mutation{Err: err}
+}
+
+func Insert(table, key string, row Row) Mutation {
+	return Mutation{Op: OpInsert, Table: table, Key: key, Row: row}
+}
+
+func Update(table, key string, row Row) Mutation {
+	return Mutation{Op: OpUpdate, Table: table, Key: key, Row: row}
+}
+
+func UpdateGuarded(table, key string, row Row, g VersionGuard) Mutation {
+	return Mutation{Op: OpUpdate, Table: table, Key: key, Row: row, Guard: &g}
+}
+
+func InsertOrUpdate(table, key string, row Row) Mutation {
+	return Mutation{Op: OpInsertOrUpdate, Table: table, Key: key, Row: row}
+}
+
+func Delete(table, key string) Mutation {
+	return Mutation{Op: OpDelete, Table: table, Key: key}
+}
+
+var _ contracts.Mutation = Mutation{}
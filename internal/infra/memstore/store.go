@@ -0,0 +1,158 @@
+package memstore
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Errors carry gRPC codes, like Spanner errors do, so callers can treat both
+// backends the same way.
+var (
+	ErrAlreadyExists = status.Error(codes.AlreadyExists, "row already exists")
+	ErrNotFound      = status.Error(codes.NotFound, "row not found")
+)
+
+type Row map[string]interface{}
+
+func (r Row) clone() Row {
+	out := make(Row, len(r))
+	for k, v := range r {
+		out[k] = v
+	}
+	return out
+}
+
+// Key builds a primary key from its parts; composite keys keep their parts
+// apart so ("a", "bc") and ("ab", "c") never collide.
+func Key(parts ...interface{}) string {
+	s := make([]string, len(parts))
+	for i, p := range parts {
+		s[i] = fmt.Sprint(p)
+	}
+	return strings.Join(s, "\x00")
+}
+
+type tables map[string]map[string]Row
+
+// Store is a transactional in-memory table store. Committed state is never
+// modified in place: every write builds new maps for the tables it touches,
+// so a Snapshot stays consistent no matter what is committed after it.
+type Store struct {
+	mu   sync.RWMutex
+	data tables
+}
+
+func NewStore() *Store {
+	return &Store{data: tables{}}
+}
+
+func (s *Store) Snapshot() *Snapshot {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return &Snapshot{data: s.data}
+}
+
+// Atomically runs fn against the latest snapshot and applies the mutations
+// it returns as one unit. Writers are serialised, so nothing can commit
+// between the read and the write.
+func (s *Store) Atomically(fn func(snap *Snapshot) ([]Mutation, error)) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	muts, err := fn(&Snapshot{data: s.data})
+	if err != nil {
+		return err
+	}
+
+	next, err := apply(s.data, muts)
+	if err != nil {
+		return err
+	}
+	s.data = next
+	return nil
+}
+
+func apply(cur tables, muts []Mutation) (tables, error) {
+	next := make(tables, len(cur))
+	for name, t := range cur {
+		next[name] = t
+	}
+	copied := map[string]bool{}
+
+	for _, m := range muts {
+		if !copied[m.Table] {
+			t := make(map[string]Row, len(next[m.Table]))
+			for k, v := range next[m.Table] {
+				t[k] = v
+			}
+			next[m.Table] = t
+			copied[m.Table] = true
+		}
+		t := next[m.Table]
+		existing, ok := t[m.Key]
+
+		if m.Guard != nil {
+			if err := m.Guard.check(existing, ok); err != nil {
+				return nil, err
+			}
+		}
+
+		switch m.Op {
+		case OpInsert:
+			if ok {
+				return nil, ErrAlreadyExists
+			}
+			t[m.Key] = m.Row.clone()
+		case OpUpdate:
+			if !ok {
+				return nil, ErrNotFound
+			}
+			t[m.Key] = merge(existing, m.Row)
+		case OpInsertOrUpdate:
+			if ok {
+				t[m.Key] = merge(existing, m.Row)
+			} else {
+				t[m.Key] = m.Row.clone()
+			}
+		case OpDelete:
+			delete(t, m.Key)
+		default:
+			return nil, fmt.Errorf("unsupported op: %d", m.Op)
+		}
+	}
+	return next, nil
+}
+
+func merge(base, upd Row) Row {
+	out := base.clone()
+	for k, v := range upd {
+		out[k] = v
+	}
+	return out
+}
+
+type Snapshot struct {
+	data tables
+}
+
+func (s *Snapshot) Get(table, key string) (Row, bool) {
+	r, ok := s.data[table][key]
+	if !ok {
+		return nil, false
+	}
+	return r.clone(), true
+}
+
+// Rows returns every row of table in no particular order.
+func (s *Snapshot) Rows(table string) []Row {
+	t := s.data[table]
+	out := make([]Row, 0, len(t))
+	for _, r := range t {
+		out = append(out, r.clone())
+	}
+	return out
+}
@@ -0,0 +1,135 @@
+package memstore
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"product-catalog-service/internal/pkg/committer"
+)
+
+func TestCommitterApply_InsertAndUpdate(t *testing.T) {
+	st := NewStore()
+	c := NewCommitter(st)
+
+	p := committer.NewPlan()
+	p.Add(Insert("t", Key("k1"), Row{"id": "k1", "v": int64(1)}))
+	if err := c.Apply(context.Background(), p); err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+
+	p = committer.NewPlan()
+	p.Add(Update("t", Key("k1"), Row{"v": int64(2)}))
+	if err := c.Apply(context.Background(), p); err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+
+	row, ok := st.Snapshot().Get("t", Key("k1"))
+	if !ok || row["id"] != "k1" || row["v"] != int64(2) {
+		t.Fatalf("expected merged row, got %v", row)
+	}
+}
+
+func TestCommitterApply_FailedMutationRollsBackWholePlan(t *testing.T) {
+	st := NewStore()
+	c := NewCommitter(st)
+
+	p := committer.NewPlan()
+	p.Add(Insert("t", Key("k1"), Row{"v": int64(1)}))
+	if err := c.Apply(context.Background(), p); err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+
+	p = committer.NewPlan()
+	p.Add(Insert("t", Key("k2"), Row{"v": int64(1)}))
+	p.Add(Insert("t", Key("k1"), Row{"v": int64(9)}))
+	if err := c.Apply(context.Background(), p); !errors.Is(err, ErrAlreadyExists) {
+		t.Fatalf("expected ErrAlreadyExists, got %v", err)
+	}
+
+	if _, ok := st.Snapshot().Get("t", Key("k2")); ok {
+		t.Fatalf("expected k2 insert to be rolled back")
+	}
+}
+
+func TestCommitterApply_StaleGuard_ReturnsConcurrentModification(t *testing.T) {
+	st := NewStore()
+	c := NewCommitter(st)
+
+	p := committer.NewPlan()
+	p.Add(Insert("t", Key("k1"), Row{"version": int64(2)}))
+	if err := c.Apply(context.Background(), p); err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+
+	p = committer.NewPlan()
+	p.Add(UpdateGuarded("t", Key("k1"), Row{"version": int64(2)}, VersionGuard{Column: "version", Version: 1}))
+	if err := c.Apply(context.Background(), p); !errors.Is(err, committer.ErrConcurrentModification) {
+		t.Fatalf("expected ErrConcurrentModification, got %v", err)
+	}
+
+	p = committer.NewPlan()
+	p.Add(UpdateGuarded("t", Key("k1"), Row{"version": int64(3)}, VersionGuard{Column: "version", Version: 2}))
+	if err := c.Apply(context.Background(), p); err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+}
+
+func TestSnapshot_IsolatedFromLaterCommits(t *testing.T) {
+	st := NewStore()
+	c := NewCommitter(st)
+
+	p := committer.NewPlan()
+	p.Add(Insert("t", Key("k1"), Row{"v": int64(1)}))
+	if err := c.Apply(context.Background(), p); err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+
+	snap := st.Snapshot()
+
+	p = committer.NewPlan()
+	p.Add(Update("t", Key("k1"), Row{"v": int64(2)}))
+	p.Add(Insert("t", Key("k2"), Row{"v": int64(1)}))
+	if err := c.Apply(context.Background(), p); err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+
+	if row, _ := snap.Get("t", Key("k1")); row["v"] != int64(1) {
+		t.Fatalf("expected snapshot to keep v=1, got %v", row["v"])
+	}
+	if n := len(snap.Rows("t")); n != 1 {
+		t.Fatalf("expected 1 row in snapshot, got %d", n)
+	}
+}
+
+type badMutation struct{}
+
+func (badMutation) IsMutation() {}
+
+func TestCommitterApply_UnsupportedMutationType(t *testing.T) {
+	c := NewCommitter(NewStore())
+
+	p := committer.NewPlan()
+	p.Add(badMutation{})
+
+	if err := c.Apply(context.Background(), p); err == nil {
+		t.Fatalf("expected error")
+	}
+}
+
+func TestCommitterApply_FailedMutation_WritesNothing(t *testing.T) {
+	st := NewStore()
+	c := NewCommitter(st)
+	want := errors.New("boom")
+
+	p := committer.NewPlan()
+	p.Add(Insert("t", Key("k1"), Row{"v": int64(1)}))
+	p.Add(Failed(want))
+	if err := c.Apply(context.Background(), p); !errors.Is(err, want) {
+		t.Fatalf("expected %v, got %v", want, err)
+	}
+
+	if _, ok := st.Snapshot().Get("t", Key("k1")); ok {
+		t.Fatalf("expected k1 insert not to be applied")
+	}
+}
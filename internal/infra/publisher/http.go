@@ -0,0 +1,78 @@
+package publisher
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/events"
+	"product-catalog-service/internal/pkg/cloudevents"
+)
+
+type Mode string
+
+const (
+	ModeStructured Mode = "structured"
+	ModeBinary     Mode = "binary"
+)
+
+// HTTP POSTs each message as a CloudEvent to url. Any non-2xx response is
+// a failed publish and is retried by the relay.
+type HTTP struct {
+	client *http.Client
+	url    string
+	source string
+	mode   Mode
+}
+
+func NewHTTP(client *http.Client, url, source string, mode Mode) (*HTTP, error) {
+	if mode != ModeStructured && mode != ModeBinary {
+		return nil, fmt.Errorf("unknown cloudevents mode %q", mode)
+	}
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &HTTP{client: client, url: url, source: source, mode: mode}, nil
+}
+
+func (p *HTTP) Publish(ctx context.Context, msg contracts.OutboxMessage) error {
+	ce := events.CloudEvent(msg, p.source)
+
+	var (
+		h    http.Header
+		body []byte
+		err  error
+	)
+	if p.mode == ModeBinary {
+		h, body, err = ce.Binary()
+	} else {
+		h = http.Header{"Content-Type": {cloudevents.ContentTypeStructured}}
+		body, err = ce.MarshalStructured()
+	}
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header = h
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("publish %s: %s", msg.EventID, resp.Status)
+	}
+	return nil
+}
+
+var _ contracts.Publisher = (*HTTP)(nil)
@@ -0,0 +1,77 @@
+package publisher
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/pkg/cloudevents"
+)
+
+func serve(t *testing.T, status int, got *cloudevents.Event) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		var (
+			ev  cloudevents.Event
+			err error
+		)
+		if r.Header.Get("Content-Type") == cloudevents.ContentTypeStructured {
+			ev, err = cloudevents.UnmarshalStructured(body)
+		} else {
+			ev, err = cloudevents.FromBinary(r.Header, body)
+		}
+		if err != nil {
+			t.Errorf("decode event: %v", err)
+		}
+		*got = ev
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestHTTP_PublishesInBothModes(t *testing.T) {
+	at := time.Date(2026, 1, 12, 12, 0, 0, 0, time.UTC)
+	msg := contracts.OutboxMessage{EventID: "e1", EventType: "product.created", AggregateID: "p1", Payload: []byte(`{"occurred_at":"2026-01-12T11:00:00Z"}`), CreatedAt: at}
+
+	for _, mode := range []Mode{ModeStructured, ModeBinary} {
+		var got cloudevents.Event
+		srv := serve(t, http.StatusAccepted, &got)
+
+		p, err := NewHTTP(srv.Client(), srv.URL, "/test", mode)
+		if err != nil {
+			t.Fatalf("%s: %v", mode, err)
+		}
+		if err := p.Publish(context.Background(), msg); err != nil {
+			t.Fatalf("%s: expected nil error, got %v", mode, err)
+		}
+		if got.ID != "e1" || got.Type != "product.created" || got.Subject != "p1" || got.DataContentType != cloudevents.ContentTypeJSON {
+			t.Fatalf("%s: unexpected event %+v", mode, got)
+		}
+		if !got.Time.Equal(at.Add(-time.Hour)) {
+			t.Fatalf("%s: expected occurred_at as event time, got %v", mode, got.Time)
+		}
+		if string(got.Data) != string(msg.Payload) {
+			t.Fatalf("%s: unexpected data %s", mode, got.Data)
+		}
+	}
+}
+
+func TestHTTP_Non2xx_ReturnsError(t *testing.T) {
+	var got cloudevents.Event
+	srv := serve(t, http.StatusServiceUnavailable, &got)
+
+	p, err := NewHTTP(srv.Client(), srv.URL, "/test", ModeBinary)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	err = p.Publish(context.Background(), contracts.OutboxMessage{EventID: "e1", EventType: "product.created", AggregateID: "p1", Payload: []byte(`{}`)})
+	if err == nil {
+		t.Fatalf("expected error for 503")
+	}
+}
@@ -0,0 +1,35 @@
+package publisher
+
+import (
+	"context"
+	"io"
+	"sync"
+
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/events"
+)
+
+// JSONL writes one structured-mode CloudEvent per line.
+type JSONL struct {
+	mu     sync.Mutex
+	w      io.Writer
+	source string
+}
+
+func NewJSONL(w io.Writer, source string) *JSONL {
+	return &JSONL{w: w, source: source}
+}
+
+func (p *JSONL) Publish(_ context.Context, msg contracts.OutboxMessage) error {
+	b, err := events.CloudEvent(msg, p.source).MarshalStructured()
+	if err != nil {
+		return err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	_, err = p.w.Write(append(b, '\n'))
+	return err
+}
+
+var _ contracts.Publisher = (*JSONL)(nil)
@@ -0,0 +1,43 @@
+package publisher
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/pkg/cloudevents"
+)
+
+func TestJSONL_WritesOneCloudEventPerLine(t *testing.T) {
+	var buf bytes.Buffer
+	p := NewJSONL(&buf, "/test")
+
+	at := time.Date(2026, 1, 12, 12, 0, 0, 0, time.UTC)
+	for _, id := range []string{"e1", "e2"} {
+		err := p.Publish(context.Background(), contracts.OutboxMessage{
+			EventID: id, EventType: "product.created", AggregateID: "p1", Payload: []byte(`{"product_id":"p1"}`), CreatedAt: at,
+		})
+		if err != nil {
+			t.Fatalf("expected nil error, got %v", err)
+		}
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 lines, got %d", len(lines))
+	}
+
+	ev, err := cloudevents.UnmarshalStructured([]byte(lines[1]))
+	if err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if ev.ID != "e2" || ev.Source != "/test" || ev.Type != "product.created" || ev.Subject != "p1" || !ev.Time.Equal(at) {
+		t.Fatalf("unexpected event %+v", ev)
+	}
+	if string(ev.Data) != `{"product_id":"p1"}` {
+		t.Fatalf("unexpected data %s", ev.Data)
+	}
+}
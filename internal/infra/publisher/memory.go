@@ -0,0 +1,34 @@
+package publisher
+
+import (
+	"context"
+	"sync"
+
+	"product-catalog-service/internal/app/product/contracts"
+)
+
+type Memory struct {
+	mu   sync.Mutex
+	msgs []contracts.OutboxMessage
+}
+
+func NewMemory() *Memory {
+	return &Memory{}
+}
+
+func (m *Memory) Publish(_ context.Context, msg contracts.OutboxMessage) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.msgs = append(m.msgs, msg)
+	return nil
+}
+
+func (m *Memory) Messages() []contracts.OutboxMessage {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	out := make([]contracts.OutboxMessage, len(m.msgs))
+	copy(out, m.msgs)
+	return out
+}
+
+var _ contracts.Publisher = (*Memory)(nil)
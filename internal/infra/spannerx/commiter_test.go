@@ -2,8 +2,11 @@ package spannerx
 
 import (
 	"context"
+	"errors"
 	"testing"
 
+	"cloud.google.com/go/spanner"
+
 	"product-catalog-service/internal/pkg/committer"
 )
 
@@ -35,3 +38,15 @@ func TestCommitterApply_UnsupportedMutationType(t *testing.T) {
 		t.Fatalf("expected error")
 	}
 }
+
+func TestCommitterApply_FailedMutation_ReturnsItsError(t *testing.T) {
+	c := NewCommitter(nil)
+	want := errors.New("boom")
+
+	p := committer.NewPlan()
+	p.Add(Batch{{M: &spanner.Mutation{}}, Failed(want).(Mutation)})
+
+	if err := c.Apply(context.Background(), p); !errors.Is(err, want) {
+		t.Fatalf("expected %v, got %v", want, err)
+	}
+}
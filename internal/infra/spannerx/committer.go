@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"cloud.google.com/go/spanner"
+	"google.golang.org/grpc/codes"
 
 	"product-catalog-service/internal/pkg/committer"
 )
@@ -23,13 +24,27 @@ func (c *Committer) Apply(ctx context.Context, plan *committer.Plan) error {
 	}
 
 	muts := make([]*spanner.Mutation, 0, len(plan.Mutations()))
+	var guards []*VersionGuard
 	for _, m := range plan.Mutations() {
-		sm, ok := m.(Mutation)
-		if !ok {
+		var batch Batch
+		switch m := m.(type) {
+		case Mutation:
+			batch = Batch{m}
+		case Batch:
+			batch = m
+		default:
 			return fmt.Errorf("unsupported mutation type: %T", m)
 		}
-		if sm.M != nil {
-			muts = append(muts, sm.M)
+		for _, sm := range batch {
+			if sm.Err != nil {
+				return sm.Err
+			}
+			if sm.M != nil {
+				muts = append(muts, sm.M)
+			}
+			if sm.Guard != nil {
+				guards = append(guards, sm.Guard)
+			}
 		}
 	}
 
@@ -38,9 +53,33 @@ func (c *Committer) Apply(ctx context.Context, plan *committer.Plan) error {
 	}
 
 	_, err := c.client.ReadWriteTransaction(ctx, func(ctx context.Context, tx *spanner.ReadWriteTransaction) error {
+		for _, g := range guards {
+			if err := checkGuard(ctx, tx, g); err != nil {
+				return err
+			}
+		}
 		return tx.BufferWrite(muts)
 	})
 	return err
 }
 
+func checkGuard(ctx context.Context, tx *spanner.ReadWriteTransaction, g *VersionGuard) error {
+	row, err := tx.ReadRow(ctx, g.Table, g.Key, []string{g.Column})
+	if err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return committer.ErrConcurrentModification
+		}
+		return err
+	}
+
+	var v spanner.NullInt64
+	if err := row.Columns(&v); err != nil {
+		return err
+	}
+	if v.Int64 != g.Version {
+		return committer.ErrConcurrentModification
+	}
+	return nil
+}
+
 var _ committer.Committer = (*Committer)(nil)
@@ -7,14 +7,48 @@ import (
 )
 
 type Mutation struct {
-	M *spanner.Mutation
+	M     *spanner.Mutation
+	Guard *VersionGuard
+	// Err is set on a mutation a repo could not build; applying a plan
+	// that holds one fails with Err before anything is written.
+	Err error
 }
 
 func (Mutation) IsMutation() {}
 
+// Batch groups mutations that belong to one logical write, such as an
+// aggregate row and its child rows, so a repo can still return a single
+// contracts.Mutation.
+type Batch []Mutation
+
+func (Batch) IsMutation() {}
+
+// VersionGuard makes the committer verify, inside the write transaction,
+// that Column of the row at Key still holds Version before M is buffered.
+// A NULL column is treated as version 0.
+type VersionGuard struct {
+	Table   string
+	Key     spanner.Key
+	Column  string
+	Version int64
+}
+
 func Wrap(m *spanner.Mutation) contracts.Mutation {
 	if m == nil {
 		return nil
 	}
 	return Mutation{M: m}
 }
+
+func WrapGuarded(m *spanner.Mutation, g VersionGuard) contracts.Mutation {
+	if m == nil {
+		return nil
+	}
+	return Mutation{M: m, Guard: &g}
+}
+
+// Failed stands in for a mutation that could not be built, such as a row
+// holding an amount too large to store, so the commit fails with err.
+func Failed(err error) contracts.Mutation {
+	return Mutation{Err: err}
+}
@@ -27,3 +27,22 @@ func TestWrap_NonNil(t *testing.T) {
 		t.Fatalf("expected wrapped pointer to match")
 	}
 }
+
+func TestWrapGuarded_CarriesGuard(t *testing.T) {
+	sm := &spanner.Mutation{}
+	got := WrapGuarded(sm, VersionGuard{Table: "products", Key: spanner.Key{"p1"}, Column: "version", Version: 4})
+
+	m, ok := got.(Mutation)
+	if !ok {
+		t.Fatalf("expected spannerx.Mutation, got %T", got)
+	}
+	if m.M != sm {
+		t.Fatalf("expected wrapped pointer to match")
+	}
+	if m.Guard == nil || m.Guard.Version != 4 || m.Guard.Table != "products" {
+		t.Fatalf("expected guard to be carried, got %+v", m.Guard)
+	}
+	if WrapGuarded(nil, VersionGuard{}) != nil {
+		t.Fatalf("expected nil for nil mutation")
+	}
+}
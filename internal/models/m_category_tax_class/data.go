@@ -0,0 +1,13 @@
+package m_category_tax_class
+
+import "cloud.google.com/go/spanner"
+
+type Model struct{}
+
+func (Model) InsertOrUpdateMut(row map[string]interface{}) *spanner.Mutation {
+	return spanner.InsertOrUpdateMap(Table, row)
+}
+
+func (Model) DeleteMut(category string) *spanner.Mutation {
+	return spanner.Delete(Table, spanner.Key{category})
+}
@@ -0,0 +1,9 @@
+package m_category_tax_class
+
+const (
+	Table = "category_tax_classes"
+
+	Category  = "category"
+	TaxClass  = "tax_class"
+	UpdatedAt = "updated_at"
+)
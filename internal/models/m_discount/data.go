@@ -0,0 +1,21 @@
+package m_discount
+
+import "cloud.google.com/go/spanner"
+
+type Model struct{}
+
+func (Model) InsertMut(row map[string]interface{}) *spanner.Mutation {
+	return spanner.InsertMap(Table, row)
+}
+
+func (Model) InsertOrUpdateMut(row map[string]interface{}) *spanner.Mutation {
+	return spanner.InsertOrUpdateMap(Table, row)
+}
+
+func (Model) UpdateMut(row map[string]interface{}) *spanner.Mutation {
+	return spanner.UpdateMap(Table, row)
+}
+
+func (Model) DeleteMut(productID, discountID string) *spanner.Mutation {
+	return spanner.Delete(Table, spanner.Key{productID, discountID})
+}
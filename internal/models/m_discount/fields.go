@@ -0,0 +1,19 @@
+package m_discount
+
+const (
+	Table = "product_discounts"
+
+	ProductID  = "product_id"
+	DiscountID = "discount_id"
+	Kind       = "kind"
+	Percent    = "percent"
+	AmountNum  = "amount_numerator"
+	AmountDen  = "amount_denominator"
+	AmountCur  = "amount_currency"
+	StartDate  = "start_date"
+	EndDate    = "end_date"
+	Status     = "status"
+	Priority   = "priority"
+	Stacking   = "stacking"
+	CreatedAt  = "created_at"
+)
@@ -0,0 +1,9 @@
+package m_exchange_rate
+
+import "cloud.google.com/go/spanner"
+
+type Model struct{}
+
+func (Model) InsertOrUpdateMut(row map[string]interface{}) *spanner.Mutation {
+	return spanner.InsertOrUpdateMap(Table, row)
+}
@@ -0,0 +1,12 @@
+package m_exchange_rate
+
+const (
+	Table = "exchange_rates"
+
+	ToCurrency    = "to_currency"
+	FromCurrency  = "from_currency"
+	EffectiveFrom = "effective_from"
+	RateNum       = "rate_numerator"
+	RateDen       = "rate_denominator"
+	CreatedAt     = "created_at"
+)
@@ -0,0 +1,9 @@
+package m_idempotency
+
+import "cloud.google.com/go/spanner"
+
+type Model struct{}
+
+func (Model) InsertMut(row map[string]interface{}) *spanner.Mutation {
+	return spanner.InsertMap(Table, row)
+}
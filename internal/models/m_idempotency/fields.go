@@ -0,0 +1,11 @@
+package m_idempotency
+
+const (
+	Table = "idempotency_keys"
+
+	Key         = "idempotency_key"
+	Operation   = "operation"
+	RequestHash = "request_hash"
+	Response    = "response"
+	CreatedAt   = "created_at"
+)
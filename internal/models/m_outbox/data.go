@@ -7,3 +7,7 @@ type Model struct{}
 func (Model) InsertMut(row map[string]interface{}) *spanner.Mutation {
 	return spanner.InsertMap(Table, row)
 }
+
+func (Model) UpdateMut(row map[string]interface{}) *spanner.Mutation {
+	return spanner.UpdateMap(Table, row)
+}
@@ -3,11 +3,22 @@ package m_outbox
 const (
 	Table = "outbox_events"
 
-	EventID     = "event_id"
-	EventType   = "event_type"
-	AggregateID = "aggregate_id"
-	Payload     = "payload"
-	Status      = "status"
-	CreatedAt   = "created_at"
-	ProcessedAt = "processed_at"
+	EventID       = "event_id"
+	EventType     = "event_type"
+	AggregateID   = "aggregate_id"
+	Payload       = "payload"
+	Status        = "status"
+	CreatedAt     = "created_at"
+	ProcessedAt   = "processed_at"
+	Attempts      = "attempts"
+	NextAttemptAt = "next_attempt_at"
+	LockedUntil   = "locked_until"
+	LastError     = "last_error"
+)
+
+const (
+	StatusNew        = "NEW"
+	StatusProcessing = "PROCESSING"
+	StatusPublished  = "PUBLISHED"
+	StatusFailed     = "FAILED"
 )
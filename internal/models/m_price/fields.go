@@ -0,0 +1,12 @@
+package m_price
+
+const (
+	Table = "product_prices"
+
+	ProductID = "product_id"
+	Currency  = "currency"
+	PriceNum  = "price_numerator"
+	PriceDen  = "price_denominator"
+	CreatedAt = "created_at"
+	UpdatedAt = "updated_at"
+)
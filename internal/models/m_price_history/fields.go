@@ -0,0 +1,14 @@
+package m_price_history
+
+const (
+	Table = "price_history"
+
+	ProductID    = "product_id"
+	Currency     = "currency"
+	ValidFrom    = "valid_from"
+	BaseNum      = "base_numerator"
+	BaseDen      = "base_denominator"
+	EffectiveNum = "effective_numerator"
+	EffectiveDen = "effective_denominator"
+	RecordedAt   = "recorded_at"
+)
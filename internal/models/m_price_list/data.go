@@ -0,0 +1,13 @@
+package m_price_list
+
+import "cloud.google.com/go/spanner"
+
+type Model struct{}
+
+func (Model) InsertMut(row map[string]interface{}) *spanner.Mutation {
+	return spanner.InsertMap(Table, row)
+}
+
+func (Model) UpdateMut(row map[string]interface{}) *spanner.Mutation {
+	return spanner.UpdateMap(Table, row)
+}
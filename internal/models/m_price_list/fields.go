@@ -0,0 +1,15 @@
+package m_price_list
+
+const (
+	Table = "price_lists"
+
+	PriceListID   = "price_list_id"
+	Name          = "name"
+	CustomerGroup = "customer_group"
+	Priority      = "priority"
+	ValidFrom     = "valid_from"
+	ValidTo       = "valid_to"
+	CreatedAt     = "created_at"
+	UpdatedAt     = "updated_at"
+	Version       = "version"
+)
@@ -0,0 +1,17 @@
+package m_price_list_entry
+
+import "cloud.google.com/go/spanner"
+
+type Model struct{}
+
+func (Model) InsertMut(row map[string]interface{}) *spanner.Mutation {
+	return spanner.InsertMap(Table, row)
+}
+
+func (Model) UpdateMut(row map[string]interface{}) *spanner.Mutation {
+	return spanner.UpdateMap(Table, row)
+}
+
+func (Model) DeleteMut(priceListID, productID, currency string) *spanner.Mutation {
+	return spanner.Delete(Table, spanner.Key{priceListID, productID, currency})
+}
@@ -0,0 +1,13 @@
+package m_price_list_entry
+
+const (
+	Table = "price_list_entries"
+
+	PriceListID = "price_list_id"
+	ProductID   = "product_id"
+	Currency    = "currency"
+	PriceNum    = "price_numerator"
+	PriceDen    = "price_denominator"
+	CreatedAt   = "created_at"
+	UpdatedAt   = "updated_at"
+)
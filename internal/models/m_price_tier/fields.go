@@ -0,0 +1,14 @@
+package m_price_tier
+
+const (
+	Table = "product_price_tiers"
+
+	ProductID   = "product_id"
+	MinQuantity = "min_quantity"
+	MaxQuantity = "max_quantity"
+	Currency    = "currency"
+	PriceNum    = "price_numerator"
+	PriceDen    = "price_denominator"
+	CreatedAt   = "created_at"
+	UpdatedAt   = "updated_at"
+)
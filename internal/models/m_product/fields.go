@@ -7,8 +7,12 @@ const (
 	Name                 = "name"
 	Description          = "description"
 	Category             = "category"
+	SKU                  = "sku"
+	GTIN                 = "gtin"
+	TaxClass             = "tax_class"
 	BasePriceNumerator   = "base_price_numerator"
 	BasePriceDenominator = "base_price_denominator"
+	BasePriceCurrency    = "base_price_currency"
 
 	DiscountPercent   = "discount_percent"
 	DiscountStartDate = "discount_start_date"
@@ -18,4 +22,5 @@ const (
 	CreatedAt  = "created_at"
 	UpdatedAt  = "updated_at"
 	ArchivedAt = "archived_at"
+	Version    = "version"
 )
@@ -0,0 +1,11 @@
+package m_product_sku
+
+const (
+	Table = "product_skus"
+
+	SKU       = "sku"
+	ProductID = "product_id"
+	// VariantID is NULL for the product's own SKU.
+	VariantID = "variant_id"
+	CreatedAt = "created_at"
+)
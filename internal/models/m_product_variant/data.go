@@ -0,0 +1,17 @@
+package m_product_variant
+
+import "cloud.google.com/go/spanner"
+
+type Model struct{}
+
+func (Model) InsertMut(row map[string]interface{}) *spanner.Mutation {
+	return spanner.InsertMap(Table, row)
+}
+
+func (Model) UpdateMut(row map[string]interface{}) *spanner.Mutation {
+	return spanner.UpdateMap(Table, row)
+}
+
+func (Model) DeleteMut(productID, variantID string) *spanner.Mutation {
+	return spanner.Delete(Table, spanner.Key{productID, variantID})
+}
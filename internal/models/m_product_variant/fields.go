@@ -0,0 +1,19 @@
+package m_product_variant
+
+const (
+	Table = "product_variants"
+
+	ProductID = "product_id"
+	VariantID = "variant_id"
+	SKU       = "sku"
+	// Attributes holds a JSON object of attribute names to values.
+	Attributes = "attributes"
+	// Currency and the price columns are NULL for a variant without a
+	// price of its own.
+	Currency  = "currency"
+	PriceNum  = "price_numerator"
+	PriceDen  = "price_denominator"
+	Status    = "status"
+	CreatedAt = "created_at"
+	UpdatedAt = "updated_at"
+)
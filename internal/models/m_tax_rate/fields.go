@@ -0,0 +1,11 @@
+package m_tax_rate
+
+const (
+	Table = "tax_rates"
+
+	Region    = "region"
+	TaxClass  = "tax_class"
+	RateNum   = "rate_numerator"
+	RateDen   = "rate_denominator"
+	UpdatedAt = "updated_at"
+)
@@ -0,0 +1,153 @@
+package cloudevents
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	SpecVersion = "1.0"
+
+	// ContentTypeStructured is the media type of a structured-mode event.
+	ContentTypeStructured = "application/cloudevents+json"
+	ContentTypeJSON       = "application/json"
+
+	headerPrefix = "ce-"
+)
+
+var ErrInvalid = errors.New("invalid cloudevent")
+
+// Event is a CloudEvents 1.0 event with the attributes this service sets.
+type Event struct {
+	ID              string
+	Source          string
+	Type            string
+	Subject         string
+	Time            time.Time
+	DataContentType string
+	Data            []byte
+}
+
+func (e Event) validate() error {
+	if e.ID == "" || e.Source == "" || e.Type == "" {
+		return ErrInvalid
+	}
+	return nil
+}
+
+type structured struct {
+	SpecVersion     string          `json:"specversion"`
+	ID              string          `json:"id"`
+	Source          string          `json:"source"`
+	Type            string          `json:"type"`
+	Subject         string          `json:"subject,omitempty"`
+	Time            *time.Time      `json:"time,omitempty"`
+	DataContentType string          `json:"datacontenttype,omitempty"`
+	Data            json.RawMessage `json:"data,omitempty"`
+	DataBase64      string          `json:"data_base64,omitempty"`
+}
+
+// MarshalStructured encodes e in structured mode. JSON data is embedded as
+// is; anything else is carried base64-encoded in data_base64.
+func (e Event) MarshalStructured() ([]byte, error) {
+	if err := e.validate(); err != nil {
+		return nil, err
+	}
+	s := structured{
+		SpecVersion:     SpecVersion,
+		ID:              e.ID,
+		Source:          e.Source,
+		Type:            e.Type,
+		Subject:         e.Subject,
+		DataContentType: e.DataContentType,
+	}
+	if !e.Time.IsZero() {
+		t := e.Time.UTC()
+		s.Time = &t
+	}
+	if len(e.Data) > 0 {
+		if isJSON(e.DataContentType) {
+			s.Data = json.RawMessage(e.Data)
+		} else {
+			s.DataBase64 = base64.StdEncoding.EncodeToString(e.Data)
+		}
+	}
+	return json.Marshal(s)
+}
+
+func UnmarshalStructured(b []byte) (Event, error) {
+	var s structured
+	if err := json.Unmarshal(b, &s); err != nil {
+		return Event{}, err
+	}
+	if s.SpecVersion != SpecVersion {
+		return Event{}, ErrInvalid
+	}
+	e := Event{ID: s.ID, Source: s.Source, Type: s.Type, Subject: s.Subject, DataContentType: s.DataContentType, Data: []byte(s.Data)}
+	if s.Time != nil {
+		e.Time = *s.Time
+	}
+	if s.DataBase64 != "" {
+		d, err := base64.StdEncoding.DecodeString(s.DataBase64)
+		if err != nil {
+			return Event{}, ErrInvalid
+		}
+		e.Data = d
+	}
+	return e, e.validate()
+}
+
+// Binary returns the headers and body of e in binary mode: attributes travel
+// as ce-* headers and the data is the body.
+func (e Event) Binary() (http.Header, []byte, error) {
+	if err := e.validate(); err != nil {
+		return nil, nil, err
+	}
+	h := http.Header{}
+	h.Set(headerPrefix+"specversion", SpecVersion)
+	h.Set(headerPrefix+"id", e.ID)
+	h.Set(headerPrefix+"source", e.Source)
+	h.Set(headerPrefix+"type", e.Type)
+	if e.Subject != "" {
+		h.Set(headerPrefix+"subject", e.Subject)
+	}
+	if !e.Time.IsZero() {
+		h.Set(headerPrefix+"time", e.Time.UTC().Format(time.RFC3339Nano))
+	}
+	if e.DataContentType != "" {
+		h.Set("Content-Type", e.DataContentType)
+	}
+	return h, e.Data, nil
+}
+
+func FromBinary(h http.Header, body []byte) (Event, error) {
+	if h.Get(headerPrefix+"specversion") != SpecVersion {
+		return Event{}, ErrInvalid
+	}
+	e := Event{
+		ID:              h.Get(headerPrefix + "id"),
+		Source:          h.Get(headerPrefix + "source"),
+		Type:            h.Get(headerPrefix + "type"),
+		Subject:         h.Get(headerPrefix + "subject"),
+		DataContentType: h.Get("Content-Type"),
+		Data:            body,
+	}
+	if v := h.Get(headerPrefix + "time"); v != "" {
+		t, err := time.Parse(time.RFC3339Nano, v)
+		if err != nil {
+			return Event{}, ErrInvalid
+		}
+		e.Time = t
+	}
+	return e, e.validate()
+}
+
+func isJSON(contentType string) bool {
+	ct, _, _ := strings.Cut(contentType, ";")
+	ct = strings.TrimSpace(ct)
+	return ct == ContentTypeJSON || strings.HasSuffix(ct, "+json")
+}
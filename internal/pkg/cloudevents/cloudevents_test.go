@@ -0,0 +1,74 @@
+package cloudevents
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestStructured_JSONDataEmbedded(t *testing.T) {
+	e := Event{ID: "e1", Source: "/s", Type: "t", Time: time.Date(2026, 1, 12, 12, 0, 0, 0, time.UTC), DataContentType: ContentTypeJSON, Data: []byte(`{"a":1}`)}
+	b, err := e.MarshalStructured()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(b, &raw); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if string(raw["specversion"]) != `"1.0"` || string(raw["data"]) != `{"a":1}` {
+		t.Fatalf("unexpected envelope %s", b)
+	}
+
+	got, err := UnmarshalStructured(b)
+	if err != nil {
+		t.Fatalf("round trip: %v", err)
+	}
+	if got.ID != e.ID || !got.Time.Equal(e.Time) || string(got.Data) != string(e.Data) {
+		t.Fatalf("expected %+v, got %+v", e, got)
+	}
+}
+
+func TestStructured_NonJSONDataBase64(t *testing.T) {
+	e := Event{ID: "e1", Source: "/s", Type: "t", DataContentType: "application/octet-stream", Data: []byte{0, 1, 2}}
+	b, err := e.MarshalStructured()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got, err := UnmarshalStructured(b)
+	if err != nil {
+		t.Fatalf("round trip: %v", err)
+	}
+	if string(got.Data) != string(e.Data) {
+		t.Fatalf("expected data %v, got %v", e.Data, got.Data)
+	}
+}
+
+func TestBinary_RoundTrip(t *testing.T) {
+	e := Event{ID: "e1", Source: "/s", Type: "t", Subject: "p1", Time: time.Date(2026, 1, 12, 12, 0, 0, 0, time.UTC), DataContentType: ContentTypeJSON, Data: []byte(`{}`)}
+	h, body, err := e.Binary()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if h.Get("ce-specversion") != SpecVersion || h.Get("Content-Type") != ContentTypeJSON {
+		t.Fatalf("unexpected headers %v", h)
+	}
+	got, err := FromBinary(h, body)
+	if err != nil {
+		t.Fatalf("round trip: %v", err)
+	}
+	if got.Subject != "p1" || !got.Time.Equal(e.Time) {
+		t.Fatalf("expected %+v, got %+v", e, got)
+	}
+}
+
+func TestMissingRequiredAttribute_ReturnsErrInvalid(t *testing.T) {
+	if _, err := (Event{ID: "e1", Type: "t"}).MarshalStructured(); !errors.Is(err, ErrInvalid) {
+		t.Fatalf("expected ErrInvalid, got %v", err)
+	}
+	if _, _, err := (Event{Source: "/s", Type: "t"}).Binary(); !errors.Is(err, ErrInvalid) {
+		t.Fatalf("expected ErrInvalid, got %v", err)
+	}
+}
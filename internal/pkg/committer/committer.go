@@ -2,8 +2,13 @@ package committer
 
 import (
 	"context"
+	"errors"
 )
 
+// ErrConcurrentModification is returned when a plan was built from a stale
+// read of an aggregate that has since been changed by someone else.
+var ErrConcurrentModification = errors.New("concurrent modification")
+
 type Committer interface {
 	Apply(ctx context.Context, plan *Plan) error
 }
@@ -0,0 +1,62 @@
+package pagetoken
+
+import (
+	"testing"
+)
+
+type cursor struct {
+	ID string `json:"id"`
+}
+
+func TestCodec_RoundTrip(t *testing.T) {
+	c := NewCodec([]byte("secret"))
+
+	tok, err := c.Encode(cursor{ID: "p1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var got cursor
+	if err := c.Decode(tok, &got); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.ID != "p1" {
+		t.Fatalf("expected p1, got %s", got.ID)
+	}
+}
+
+func TestCodec_TamperedToken_ReturnsErrInvalid(t *testing.T) {
+	c := NewCodec([]byte("secret"))
+
+	tok, err := c.Encode(cursor{ID: "p1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	b := []byte(tok)
+	if b[len(b)-1] == 'A' {
+		b[len(b)-1] = 'B'
+	} else {
+		b[len(b)-1] = 'A'
+	}
+
+	var got cursor
+	if err := c.Decode(string(b), &got); err != ErrInvalid {
+		t.Fatalf("expected ErrInvalid, got %v", err)
+	}
+	if err := c.Decode("not-a-token", &got); err != ErrInvalid {
+		t.Fatalf("expected ErrInvalid, got %v", err)
+	}
+}
+
+func TestCodec_OtherKey_ReturnsErrInvalid(t *testing.T) {
+	tok, err := NewCodec([]byte("secret")).Encode(cursor{ID: "p1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var got cursor
+	if err := NewCodec([]byte("other")).Decode(tok, &got); err != ErrInvalid {
+		t.Fatalf("expected ErrInvalid, got %v", err)
+	}
+}
@@ -0,0 +1,4 @@
+ALTER TABLE outbox_events ADD COLUMN attempts INT64;
+ALTER TABLE outbox_events ADD COLUMN next_attempt_at TIMESTAMP;
+ALTER TABLE outbox_events ADD COLUMN locked_until TIMESTAMP;
+ALTER TABLE outbox_events ADD COLUMN last_error STRING(MAX);
@@ -0,0 +1 @@
+ALTER TABLE products ADD COLUMN version INT64;
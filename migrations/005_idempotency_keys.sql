@@ -0,0 +1,8 @@
+CREATE TABLE idempotency_keys (
+    idempotency_key STRING(255) NOT NULL,
+    operation STRING(64) NOT NULL,
+    request_hash STRING(64) NOT NULL,
+    response BYTES(MAX),
+    created_at TIMESTAMP NOT NULL,
+) PRIMARY KEY (idempotency_key),
+  ROW DELETION POLICY (OLDER_THAN(created_at, INTERVAL 7 DAY));
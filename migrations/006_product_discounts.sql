@@ -0,0 +1,12 @@
+CREATE TABLE product_discounts (
+    product_id STRING(36) NOT NULL,
+    discount_id STRING(36) NOT NULL,
+    percent NUMERIC NOT NULL,
+    start_date TIMESTAMP NOT NULL,
+    end_date TIMESTAMP NOT NULL,
+    status STRING(20) NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+) PRIMARY KEY (product_id, discount_id),
+  INTERLEAVE IN PARENT products ON DELETE CASCADE;
+
+CREATE INDEX idx_product_discounts_status ON product_discounts(status, start_date);
@@ -0,0 +1,2 @@
+ALTER TABLE product_discounts ADD COLUMN priority INT64;
+ALTER TABLE product_discounts ADD COLUMN stacking STRING(20);
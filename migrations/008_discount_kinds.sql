@@ -0,0 +1,4 @@
+ALTER TABLE product_discounts ADD COLUMN kind STRING(20);
+ALTER TABLE product_discounts ADD COLUMN amount_numerator INT64;
+ALTER TABLE product_discounts ADD COLUMN amount_denominator INT64;
+ALTER TABLE product_discounts ALTER COLUMN percent NUMERIC;
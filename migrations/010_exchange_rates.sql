@@ -0,0 +1,8 @@
+CREATE TABLE exchange_rates (
+    to_currency STRING(3) NOT NULL,
+    from_currency STRING(3) NOT NULL,
+    effective_from TIMESTAMP NOT NULL,
+    rate_numerator INT64 NOT NULL,
+    rate_denominator INT64 NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+) PRIMARY KEY (to_currency, from_currency, effective_from);
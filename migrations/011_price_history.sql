@@ -0,0 +1,11 @@
+CREATE TABLE price_history (
+    product_id STRING(36) NOT NULL,
+    currency STRING(3) NOT NULL,
+    valid_from TIMESTAMP NOT NULL,
+    base_numerator INT64,
+    base_denominator INT64,
+    effective_numerator INT64,
+    effective_denominator INT64,
+    recorded_at TIMESTAMP NOT NULL,
+) PRIMARY KEY (product_id, currency, valid_from),
+  INTERLEAVE IN PARENT products ON DELETE CASCADE;
@@ -0,0 +1,15 @@
+ALTER TABLE products ADD COLUMN tax_class STRING(32);
+
+CREATE TABLE category_tax_classes (
+    category STRING(100) NOT NULL,
+    tax_class STRING(32) NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+) PRIMARY KEY (category);
+
+CREATE TABLE tax_rates (
+    region STRING(6) NOT NULL,
+    tax_class STRING(32) NOT NULL,
+    rate_numerator INT64 NOT NULL,
+    rate_denominator INT64 NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+) PRIMARY KEY (region, tax_class);
@@ -0,0 +1,11 @@
+CREATE TABLE product_price_tiers (
+    product_id STRING(36) NOT NULL,
+    min_quantity INT64 NOT NULL,
+    max_quantity INT64,
+    currency STRING(3) NOT NULL,
+    price_numerator INT64 NOT NULL,
+    price_denominator INT64 NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+) PRIMARY KEY (product_id, min_quantity),
+  INTERLEAVE IN PARENT products ON DELETE CASCADE;
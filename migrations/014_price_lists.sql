@@ -0,0 +1,24 @@
+CREATE TABLE price_lists (
+    price_list_id STRING(36) NOT NULL,
+    name STRING(255) NOT NULL,
+    customer_group STRING(32) NOT NULL,
+    priority INT64 NOT NULL,
+    valid_from TIMESTAMP,
+    valid_to TIMESTAMP,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    version INT64 NOT NULL,
+) PRIMARY KEY (price_list_id);
+
+CREATE INDEX idx_price_lists_customer_group ON price_lists(customer_group);
+
+CREATE TABLE price_list_entries (
+    price_list_id STRING(36) NOT NULL,
+    product_id STRING(36) NOT NULL,
+    currency STRING(3) NOT NULL,
+    price_numerator INT64 NOT NULL,
+    price_denominator INT64 NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+) PRIMARY KEY (price_list_id, product_id, currency),
+  INTERLEAVE IN PARENT price_lists ON DELETE CASCADE;
@@ -0,0 +1,13 @@
+CREATE TABLE product_variants (
+    product_id STRING(36) NOT NULL,
+    variant_id STRING(36) NOT NULL,
+    sku STRING(64) NOT NULL,
+    attributes STRING(MAX) NOT NULL,
+    currency STRING(3),
+    price_numerator INT64,
+    price_denominator INT64,
+    status STRING(16) NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+) PRIMARY KEY (product_id, variant_id),
+  INTERLEAVE IN PARENT products ON DELETE CASCADE;
@@ -0,0 +1,11 @@
+ALTER TABLE products ADD COLUMN sku STRING(64);
+ALTER TABLE products ADD COLUMN gtin STRING(14);
+
+CREATE UNIQUE NULL_FILTERED INDEX idx_products_gtin ON products(gtin);
+
+CREATE TABLE product_skus (
+    sku STRING(64) NOT NULL,
+    product_id STRING(36) NOT NULL,
+    variant_id STRING(36),
+    created_at TIMESTAMP NOT NULL,
+) PRIMARY KEY (sku);
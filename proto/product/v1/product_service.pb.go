@@ -29,8 +29,19 @@ type CreateProductRequest struct {
 	Category             string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
 	BasePriceNumerator   int64                  `protobuf:"varint,4,opt,name=base_price_numerator,json=basePriceNumerator,proto3" json:"base_price_numerator,omitempty"`
 	BasePriceDenominator int64                  `protobuf:"varint,5,opt,name=base_price_denominator,json=basePriceDenominator,proto3" json:"base_price_denominator,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+	// Retries with the same key replay the first reply. May also be sent as
+	// the idempotency-key metadata header.
+	IdempotencyKey string `protobuf:"bytes,6,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	// ISO-4217 code of the base price; empty means EUR.
+	Currency string `protobuf:"bytes,7,opt,name=currency,proto3" json:"currency,omitempty"`
+	// Optional. sku must be unique across products and variants, gtin across
+	// products; either in use fails with ALREADY_EXISTS.
+	Sku string `protobuf:"bytes,8,opt,name=sku,proto3" json:"sku,omitempty"`
+	// GTIN-8, UPC-A, EAN-13 or GTIN-14 with a valid check digit, stored as
+	// 14 digits.
+	Gtin          string `protobuf:"bytes,9,opt,name=gtin,proto3" json:"gtin,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateProductRequest) Reset() {
@@ -98,6 +109,34 @@ func (x *CreateProductRequest) GetBasePriceDenominator() int64 {
 	return 0
 }
 
+func (x *CreateProductRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetGtin() string {
+	if x != nil {
+		return x.Gtin
+	}
+	return ""
+}
+
 type CreateProductReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
@@ -143,13 +182,16 @@ func (x *CreateProductReply) GetProductId() string {
 }
 
 type UpdateProductRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Category      string                 `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ProductId   string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Category    string                 `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`
+	// Version the client last read; 0 skips the check.
+	ExpectedVersion int64  `protobuf:"varint,5,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	IdempotencyKey  string `protobuf:"bytes,6,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *UpdateProductRequest) Reset() {
@@ -210,6 +252,20 @@ func (x *UpdateProductRequest) GetCategory() string {
 	return ""
 }
 
+func (x *UpdateProductRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+func (x *UpdateProductRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
 type UpdateProductReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -247,10 +303,12 @@ func (*UpdateProductReply) Descriptor() ([]byte, []int) {
 }
 
 type ActivateProductRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ProductId       string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ExpectedVersion int64                  `protobuf:"varint,2,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	IdempotencyKey  string                 `protobuf:"bytes,3,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *ActivateProductRequest) Reset() {
@@ -290,6 +348,20 @@ func (x *ActivateProductRequest) GetProductId() string {
 	return ""
 }
 
+func (x *ActivateProductRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+func (x *ActivateProductRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
 type ActivateProductReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -327,10 +399,12 @@ func (*ActivateProductReply) Descriptor() ([]byte, []int) {
 }
 
 type DeactivateProductRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ProductId       string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ExpectedVersion int64                  `protobuf:"varint,2,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	IdempotencyKey  string                 `protobuf:"bytes,3,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *DeactivateProductRequest) Reset() {
@@ -370,6 +444,20 @@ func (x *DeactivateProductRequest) GetProductId() string {
 	return ""
 }
 
+func (x *DeactivateProductRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+func (x *DeactivateProductRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
 type DeactivateProductReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -406,31 +494,29 @@ func (*DeactivateProductReply) Descriptor() ([]byte, []int) {
 	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{7}
 }
 
-type ApplyDiscountRequest struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	ProductId          string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	PercentNumerator   int64                  `protobuf:"varint,2,opt,name=percent_numerator,json=percentNumerator,proto3" json:"percent_numerator,omitempty"`
-	PercentDenominator int64                  `protobuf:"varint,3,opt,name=percent_denominator,json=percentDenominator,proto3" json:"percent_denominator,omitempty"`
-	StartTimestamp     int64                  `protobuf:"varint,4,opt,name=start_timestamp,json=startTimestamp,proto3" json:"start_timestamp,omitempty"`
-	EndTimestamp       int64                  `protobuf:"varint,5,opt,name=end_timestamp,json=endTimestamp,proto3" json:"end_timestamp,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+type ArchiveProductRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ProductId       string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ExpectedVersion int64                  `protobuf:"varint,2,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	IdempotencyKey  string                 `protobuf:"bytes,3,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *ApplyDiscountRequest) Reset() {
-	*x = ApplyDiscountRequest{}
+func (x *ArchiveProductRequest) Reset() {
+	*x = ArchiveProductRequest{}
 	mi := &file_proto_product_v1_product_service_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ApplyDiscountRequest) String() string {
+func (x *ArchiveProductRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApplyDiscountRequest) ProtoMessage() {}
+func (*ArchiveProductRequest) ProtoMessage() {}
 
-func (x *ApplyDiscountRequest) ProtoReflect() protoreflect.Message {
+func (x *ArchiveProductRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_product_v1_product_service_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -442,66 +528,52 @@ func (x *ApplyDiscountRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApplyDiscountRequest.ProtoReflect.Descriptor instead.
-func (*ApplyDiscountRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ArchiveProductRequest.ProtoReflect.Descriptor instead.
+func (*ArchiveProductRequest) Descriptor() ([]byte, []int) {
 	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *ApplyDiscountRequest) GetProductId() string {
+func (x *ArchiveProductRequest) GetProductId() string {
 	if x != nil {
 		return x.ProductId
 	}
 	return ""
 }
 
-func (x *ApplyDiscountRequest) GetPercentNumerator() int64 {
-	if x != nil {
-		return x.PercentNumerator
-	}
-	return 0
-}
-
-func (x *ApplyDiscountRequest) GetPercentDenominator() int64 {
-	if x != nil {
-		return x.PercentDenominator
-	}
-	return 0
-}
-
-func (x *ApplyDiscountRequest) GetStartTimestamp() int64 {
+func (x *ArchiveProductRequest) GetExpectedVersion() int64 {
 	if x != nil {
-		return x.StartTimestamp
+		return x.ExpectedVersion
 	}
 	return 0
 }
 
-func (x *ApplyDiscountRequest) GetEndTimestamp() int64 {
+func (x *ArchiveProductRequest) GetIdempotencyKey() string {
 	if x != nil {
-		return x.EndTimestamp
+		return x.IdempotencyKey
 	}
-	return 0
+	return ""
 }
 
-type ApplyDiscountReply struct {
+type ArchiveProductReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ApplyDiscountReply) Reset() {
-	*x = ApplyDiscountReply{}
+func (x *ArchiveProductReply) Reset() {
+	*x = ArchiveProductReply{}
 	mi := &file_proto_product_v1_product_service_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ApplyDiscountReply) String() string {
+func (x *ArchiveProductReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApplyDiscountReply) ProtoMessage() {}
+func (*ArchiveProductReply) ProtoMessage() {}
 
-func (x *ApplyDiscountReply) ProtoReflect() protoreflect.Message {
+func (x *ArchiveProductReply) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_product_v1_product_service_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -513,32 +585,34 @@ func (x *ApplyDiscountReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApplyDiscountReply.ProtoReflect.Descriptor instead.
-func (*ApplyDiscountReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use ArchiveProductReply.ProtoReflect.Descriptor instead.
+func (*ArchiveProductReply) Descriptor() ([]byte, []int) {
 	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{9}
 }
 
-type RemoveDiscountRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type RestoreProductRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ProductId       string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ExpectedVersion int64                  `protobuf:"varint,2,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	IdempotencyKey  string                 `protobuf:"bytes,3,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *RemoveDiscountRequest) Reset() {
-	*x = RemoveDiscountRequest{}
+func (x *RestoreProductRequest) Reset() {
+	*x = RestoreProductRequest{}
 	mi := &file_proto_product_v1_product_service_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveDiscountRequest) String() string {
+func (x *RestoreProductRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveDiscountRequest) ProtoMessage() {}
+func (*RestoreProductRequest) ProtoMessage() {}
 
-func (x *RemoveDiscountRequest) ProtoReflect() protoreflect.Message {
+func (x *RestoreProductRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_product_v1_product_service_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -550,38 +624,52 @@ func (x *RemoveDiscountRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveDiscountRequest.ProtoReflect.Descriptor instead.
-func (*RemoveDiscountRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RestoreProductRequest.ProtoReflect.Descriptor instead.
+func (*RestoreProductRequest) Descriptor() ([]byte, []int) {
 	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *RemoveDiscountRequest) GetProductId() string {
+func (x *RestoreProductRequest) GetProductId() string {
 	if x != nil {
 		return x.ProductId
 	}
 	return ""
 }
 
-type RemoveDiscountReply struct {
+func (x *RestoreProductRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+func (x *RestoreProductRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type RestoreProductReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveDiscountReply) Reset() {
-	*x = RemoveDiscountReply{}
+func (x *RestoreProductReply) Reset() {
+	*x = RestoreProductReply{}
 	mi := &file_proto_product_v1_product_service_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveDiscountReply) String() string {
+func (x *RestoreProductReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveDiscountReply) ProtoMessage() {}
+func (*RestoreProductReply) ProtoMessage() {}
 
-func (x *RemoveDiscountReply) ProtoReflect() protoreflect.Message {
+func (x *RestoreProductReply) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_product_v1_product_service_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -593,32 +681,49 @@ func (x *RemoveDiscountReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveDiscountReply.ProtoReflect.Descriptor instead.
-func (*RemoveDiscountReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use RestoreProductReply.ProtoReflect.Descriptor instead.
+func (*RestoreProductReply) Descriptor() ([]byte, []int) {
 	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{11}
 }
 
-type GetProductRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ApplyDiscountRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	ProductId          string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	PercentNumerator   int64                  `protobuf:"varint,2,opt,name=percent_numerator,json=percentNumerator,proto3" json:"percent_numerator,omitempty"`
+	PercentDenominator int64                  `protobuf:"varint,3,opt,name=percent_denominator,json=percentDenominator,proto3" json:"percent_denominator,omitempty"`
+	StartTimestamp     int64                  `protobuf:"varint,4,opt,name=start_timestamp,json=startTimestamp,proto3" json:"start_timestamp,omitempty"`
+	EndTimestamp       int64                  `protobuf:"varint,5,opt,name=end_timestamp,json=endTimestamp,proto3" json:"end_timestamp,omitempty"`
+	ExpectedVersion    int64                  `protobuf:"varint,6,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	IdempotencyKey     string                 `protobuf:"bytes,7,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	// Higher priorities are evaluated first.
+	Priority int64 `protobuf:"varint,8,opt,name=priority,proto3" json:"priority,omitempty"`
+	// "best_wins" (default), "additive", "multiplicative" or "exclusive".
+	Stacking string `protobuf:"bytes,9,opt,name=stacking,proto3" json:"stacking,omitempty"`
+	// "percentage" (default) uses percent_*; "fixed_amount" (amount off) and
+	// "fixed_price" (final price) use amount_*.
+	Kind              string `protobuf:"bytes,10,opt,name=kind,proto3" json:"kind,omitempty"`
+	AmountNumerator   int64  `protobuf:"varint,11,opt,name=amount_numerator,json=amountNumerator,proto3" json:"amount_numerator,omitempty"`
+	AmountDenominator int64  `protobuf:"varint,12,opt,name=amount_denominator,json=amountDenominator,proto3" json:"amount_denominator,omitempty"`
+	// Currency of amount_*; empty means the product's base currency.
+	AmountCurrency string `protobuf:"bytes,13,opt,name=amount_currency,json=amountCurrency,proto3" json:"amount_currency,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GetProductRequest) Reset() {
-	*x = GetProductRequest{}
+func (x *ApplyDiscountRequest) Reset() {
+	*x = ApplyDiscountRequest{}
 	mi := &file_proto_product_v1_product_service_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetProductRequest) String() string {
+func (x *ApplyDiscountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProductRequest) ProtoMessage() {}
+func (*ApplyDiscountRequest) ProtoMessage() {}
 
-func (x *GetProductRequest) ProtoReflect() protoreflect.Message {
+func (x *ApplyDiscountRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_product_v1_product_service_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -630,143 +735,4097 @@ func (x *GetProductRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProductRequest.ProtoReflect.Descriptor instead.
-func (*GetProductRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ApplyDiscountRequest.ProtoReflect.Descriptor instead.
+func (*ApplyDiscountRequest) Descriptor() ([]byte, []int) {
 	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *GetProductRequest) GetProductId() string {
+func (x *ApplyDiscountRequest) GetProductId() string {
 	if x != nil {
 		return x.ProductId
 	}
 	return ""
 }
 
-type GetProductReply struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	ProductId            string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	Name                 string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description          string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Category             string                 `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`
-	BasePriceNumerator   int64                  `protobuf:"varint,5,opt,name=base_price_numerator,json=basePriceNumerator,proto3" json:"base_price_numerator,omitempty"`
-	BasePriceDenominator int64                  `protobuf:"varint,6,opt,name=base_price_denominator,json=basePriceDenominator,proto3" json:"base_price_denominator,omitempty"`
-	Status               string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
-	Discount             *Discount              `protobuf:"bytes,8,opt,name=discount,proto3,oneof" json:"discount,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+func (x *ApplyDiscountRequest) GetPercentNumerator() int64 {
+	if x != nil {
+		return x.PercentNumerator
+	}
+	return 0
 }
 
-func (x *GetProductReply) Reset() {
-	*x = GetProductReply{}
-	mi := &file_proto_product_v1_product_service_proto_msgTypes[13]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ApplyDiscountRequest) GetPercentDenominator() int64 {
+	if x != nil {
+		return x.PercentDenominator
+	}
+	return 0
 }
 
-func (x *GetProductReply) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ApplyDiscountRequest) GetStartTimestamp() int64 {
+	if x != nil {
+		return x.StartTimestamp
+	}
+	return 0
 }
 
-func (*GetProductReply) ProtoMessage() {}
-
-func (x *GetProductReply) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_v1_product_service_proto_msgTypes[13]
+func (x *ApplyDiscountRequest) GetEndTimestamp() int64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.EndTimestamp
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use GetProductReply.ProtoReflect.Descriptor instead.
-func (*GetProductReply) Descriptor() ([]byte, []int) {
-	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{13}
+func (x *ApplyDiscountRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
 }
 
-func (x *GetProductReply) GetProductId() string {
+func (x *ApplyDiscountRequest) GetIdempotencyKey() string {
 	if x != nil {
-		return x.ProductId
+		return x.IdempotencyKey
 	}
 	return ""
 }
 
-func (x *GetProductReply) GetName() string {
+func (x *ApplyDiscountRequest) GetPriority() int64 {
 	if x != nil {
-		return x.Name
+		return x.Priority
 	}
-	return ""
+	return 0
 }
 
-func (x *GetProductReply) GetDescription() string {
+func (x *ApplyDiscountRequest) GetStacking() string {
 	if x != nil {
-		return x.Description
+		return x.Stacking
 	}
 	return ""
 }
 
-func (x *GetProductReply) GetCategory() string {
+func (x *ApplyDiscountRequest) GetKind() string {
 	if x != nil {
-		return x.Category
+		return x.Kind
 	}
 	return ""
 }
 
-func (x *GetProductReply) GetBasePriceNumerator() int64 {
+func (x *ApplyDiscountRequest) GetAmountNumerator() int64 {
 	if x != nil {
-		return x.BasePriceNumerator
+		return x.AmountNumerator
 	}
 	return 0
 }
 
-func (x *GetProductReply) GetBasePriceDenominator() int64 {
+func (x *ApplyDiscountRequest) GetAmountDenominator() int64 {
 	if x != nil {
-		return x.BasePriceDenominator
+		return x.AmountDenominator
 	}
 	return 0
 }
 
-func (x *GetProductReply) GetStatus() string {
+func (x *ApplyDiscountRequest) GetAmountCurrency() string {
 	if x != nil {
-		return x.Status
+		return x.AmountCurrency
 	}
 	return ""
 }
 
-func (x *GetProductReply) GetDiscount() *Discount {
-	if x != nil {
-		return x.Discount
-	}
-	return nil
+type ApplyDiscountReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DiscountId    string                 `protobuf:"bytes,1,opt,name=discount_id,json=discountId,proto3" json:"discount_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-type Discount struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	PercentNumerator   int64                  `protobuf:"varint,1,opt,name=percent_numerator,json=percentNumerator,proto3" json:"percent_numerator,omitempty"`
-	PercentDenominator int64                  `protobuf:"varint,2,opt,name=percent_denominator,json=percentDenominator,proto3" json:"percent_denominator,omitempty"`
-	StartTimestamp     int64                  `protobuf:"varint,3,opt,name=start_timestamp,json=startTimestamp,proto3" json:"start_timestamp,omitempty"`
-	EndTimestamp       int64                  `protobuf:"varint,4,opt,name=end_timestamp,json=endTimestamp,proto3" json:"end_timestamp,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+func (x *ApplyDiscountReply) Reset() {
+	*x = ApplyDiscountReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Discount) Reset() {
-	*x = Discount{}
+func (x *ApplyDiscountReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyDiscountReply) ProtoMessage() {}
+
+func (x *ApplyDiscountReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyDiscountReply.ProtoReflect.Descriptor instead.
+func (*ApplyDiscountReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ApplyDiscountReply) GetDiscountId() string {
+	if x != nil {
+		return x.DiscountId
+	}
+	return ""
+}
+
+type RemoveDiscountRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ProductId       string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ExpectedVersion int64                  `protobuf:"varint,2,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	IdempotencyKey  string                 `protobuf:"bytes,3,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	// The discount to remove; empty removes the one in effect now.
+	DiscountId    string `protobuf:"bytes,4,opt,name=discount_id,json=discountId,proto3" json:"discount_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveDiscountRequest) Reset() {
+	*x = RemoveDiscountRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveDiscountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveDiscountRequest) ProtoMessage() {}
+
+func (x *RemoveDiscountRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_product_v1_product_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveDiscountRequest.ProtoReflect.Descriptor instead.
+func (*RemoveDiscountRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RemoveDiscountRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *RemoveDiscountRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+func (x *RemoveDiscountRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *RemoveDiscountRequest) GetDiscountId() string {
+	if x != nil {
+		return x.DiscountId
+	}
+	return ""
+}
+
+type RemoveDiscountReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveDiscountReply) Reset() {
+	*x = RemoveDiscountReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveDiscountReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveDiscountReply) ProtoMessage() {}
+
+func (x *RemoveDiscountReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveDiscountReply.ProtoReflect.Descriptor instead.
+func (*RemoveDiscountReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{15}
+}
+
+// SetProductPriceRequest adds or replaces the price in a currency other than
+// the product's base currency.
+type SetProductPriceRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ProductId        string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Currency         string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	PriceNumerator   int64                  `protobuf:"varint,3,opt,name=price_numerator,json=priceNumerator,proto3" json:"price_numerator,omitempty"`
+	PriceDenominator int64                  `protobuf:"varint,4,opt,name=price_denominator,json=priceDenominator,proto3" json:"price_denominator,omitempty"`
+	ExpectedVersion  int64                  `protobuf:"varint,5,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	IdempotencyKey   string                 `protobuf:"bytes,6,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SetProductPriceRequest) Reset() {
+	*x = SetProductPriceRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetProductPriceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetProductPriceRequest) ProtoMessage() {}
+
+func (x *SetProductPriceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetProductPriceRequest.ProtoReflect.Descriptor instead.
+func (*SetProductPriceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SetProductPriceRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *SetProductPriceRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *SetProductPriceRequest) GetPriceNumerator() int64 {
+	if x != nil {
+		return x.PriceNumerator
+	}
+	return 0
+}
+
+func (x *SetProductPriceRequest) GetPriceDenominator() int64 {
+	if x != nil {
+		return x.PriceDenominator
+	}
+	return 0
+}
+
+func (x *SetProductPriceRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+func (x *SetProductPriceRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type SetProductPriceReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetProductPriceReply) Reset() {
+	*x = SetProductPriceReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetProductPriceReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetProductPriceReply) ProtoMessage() {}
+
+func (x *SetProductPriceReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetProductPriceReply.ProtoReflect.Descriptor instead.
+func (*SetProductPriceReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{17}
+}
+
+type RemoveProductPriceRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ProductId       string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Currency        string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	ExpectedVersion int64                  `protobuf:"varint,3,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	IdempotencyKey  string                 `protobuf:"bytes,4,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RemoveProductPriceRequest) Reset() {
+	*x = RemoveProductPriceRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveProductPriceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveProductPriceRequest) ProtoMessage() {}
+
+func (x *RemoveProductPriceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveProductPriceRequest.ProtoReflect.Descriptor instead.
+func (*RemoveProductPriceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RemoveProductPriceRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *RemoveProductPriceRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *RemoveProductPriceRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+func (x *RemoveProductPriceRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type RemoveProductPriceReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveProductPriceReply) Reset() {
+	*x = RemoveProductPriceReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveProductPriceReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveProductPriceReply) ProtoMessage() {}
+
+func (x *RemoveProductPriceReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveProductPriceReply.ProtoReflect.Descriptor instead.
+func (*RemoveProductPriceReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{19}
+}
+
+type ChangeBasePriceRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// In the product's base currency, which cannot change.
+	BasePriceNumerator   int64  `protobuf:"varint,2,opt,name=base_price_numerator,json=basePriceNumerator,proto3" json:"base_price_numerator,omitempty"`
+	BasePriceDenominator int64  `protobuf:"varint,3,opt,name=base_price_denominator,json=basePriceDenominator,proto3" json:"base_price_denominator,omitempty"`
+	ExpectedVersion      int64  `protobuf:"varint,4,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	IdempotencyKey       string `protobuf:"bytes,5,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ChangeBasePriceRequest) Reset() {
+	*x = ChangeBasePriceRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeBasePriceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeBasePriceRequest) ProtoMessage() {}
+
+func (x *ChangeBasePriceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeBasePriceRequest.ProtoReflect.Descriptor instead.
+func (*ChangeBasePriceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ChangeBasePriceRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ChangeBasePriceRequest) GetBasePriceNumerator() int64 {
+	if x != nil {
+		return x.BasePriceNumerator
+	}
+	return 0
+}
+
+func (x *ChangeBasePriceRequest) GetBasePriceDenominator() int64 {
+	if x != nil {
+		return x.BasePriceDenominator
+	}
+	return 0
+}
+
+func (x *ChangeBasePriceRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+func (x *ChangeBasePriceRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type ChangeBasePriceReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeBasePriceReply) Reset() {
+	*x = ChangeBasePriceReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeBasePriceReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeBasePriceReply) ProtoMessage() {}
+
+func (x *ChangeBasePriceReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeBasePriceReply.ProtoReflect.Descriptor instead.
+func (*ChangeBasePriceReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{21}
+}
+
+type SetProductTaxClassRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// Empty makes the product take its category's class again.
+	TaxClass        string `protobuf:"bytes,2,opt,name=tax_class,json=taxClass,proto3" json:"tax_class,omitempty"`
+	ExpectedVersion int64  `protobuf:"varint,3,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	IdempotencyKey  string `protobuf:"bytes,4,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SetProductTaxClassRequest) Reset() {
+	*x = SetProductTaxClassRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetProductTaxClassRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetProductTaxClassRequest) ProtoMessage() {}
+
+func (x *SetProductTaxClassRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetProductTaxClassRequest.ProtoReflect.Descriptor instead.
+func (*SetProductTaxClassRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SetProductTaxClassRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *SetProductTaxClassRequest) GetTaxClass() string {
+	if x != nil {
+		return x.TaxClass
+	}
+	return ""
+}
+
+func (x *SetProductTaxClassRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+func (x *SetProductTaxClassRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type SetProductTaxClassReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetProductTaxClassReply) Reset() {
+	*x = SetProductTaxClassReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetProductTaxClassReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetProductTaxClassReply) ProtoMessage() {}
+
+func (x *SetProductTaxClassReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetProductTaxClassReply.ProtoReflect.Descriptor instead.
+func (*SetProductTaxClassReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{23}
+}
+
+// SetProductIdentifiersRequest replaces the product's SKU and GTIN, under
+// the rules of CreateProductRequest. Empty clears them.
+type SetProductIdentifiersRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ProductId       string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Sku             string                 `protobuf:"bytes,2,opt,name=sku,proto3" json:"sku,omitempty"`
+	Gtin            string                 `protobuf:"bytes,3,opt,name=gtin,proto3" json:"gtin,omitempty"`
+	ExpectedVersion int64                  `protobuf:"varint,4,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	IdempotencyKey  string                 `protobuf:"bytes,5,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SetProductIdentifiersRequest) Reset() {
+	*x = SetProductIdentifiersRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetProductIdentifiersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetProductIdentifiersRequest) ProtoMessage() {}
+
+func (x *SetProductIdentifiersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetProductIdentifiersRequest.ProtoReflect.Descriptor instead.
+func (*SetProductIdentifiersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SetProductIdentifiersRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *SetProductIdentifiersRequest) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *SetProductIdentifiersRequest) GetGtin() string {
+	if x != nil {
+		return x.Gtin
+	}
+	return ""
+}
+
+func (x *SetProductIdentifiersRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+func (x *SetProductIdentifiersRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type SetProductIdentifiersReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetProductIdentifiersReply) Reset() {
+	*x = SetProductIdentifiersReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetProductIdentifiersReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetProductIdentifiersReply) ProtoMessage() {}
+
+func (x *SetProductIdentifiersReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetProductIdentifiersReply.ProtoReflect.Descriptor instead.
+func (*SetProductIdentifiersReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{25}
+}
+
+// SetPriceTiersRequest replaces the product's quantity breaks. Tiers are in
+// the base currency, must follow on from one another without gaps or
+// overlaps and end with an open tier; the base price covers quantities
+// below the first, and a first tier from 1 replaces it for every order. No
+// tiers removes them all.
+type SetPriceTiersRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ProductId       string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Tiers           []*PriceTier           `protobuf:"bytes,2,rep,name=tiers,proto3" json:"tiers,omitempty"`
+	ExpectedVersion int64                  `protobuf:"varint,3,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	IdempotencyKey  string                 `protobuf:"bytes,4,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SetPriceTiersRequest) Reset() {
+	*x = SetPriceTiersRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPriceTiersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPriceTiersRequest) ProtoMessage() {}
+
+func (x *SetPriceTiersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPriceTiersRequest.ProtoReflect.Descriptor instead.
+func (*SetPriceTiersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SetPriceTiersRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *SetPriceTiersRequest) GetTiers() []*PriceTier {
+	if x != nil {
+		return x.Tiers
+	}
+	return nil
+}
+
+func (x *SetPriceTiersRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+func (x *SetPriceTiersRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type SetPriceTiersReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPriceTiersReply) Reset() {
+	*x = SetPriceTiersReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPriceTiersReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPriceTiersReply) ProtoMessage() {}
+
+func (x *SetPriceTiersReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPriceTiersReply.ProtoReflect.Descriptor instead.
+func (*SetPriceTiersReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{27}
+}
+
+// PriceTier charges unit_price for every unit of an order for min_quantity
+// to max_quantity units; max_quantity is 0 for the last, open-ended tier.
+type PriceTier struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	MinQuantity          int64                  `protobuf:"varint,1,opt,name=min_quantity,json=minQuantity,proto3" json:"min_quantity,omitempty"`
+	MaxQuantity          int64                  `protobuf:"varint,2,opt,name=max_quantity,json=maxQuantity,proto3" json:"max_quantity,omitempty"`
+	UnitPriceNumerator   int64                  `protobuf:"varint,3,opt,name=unit_price_numerator,json=unitPriceNumerator,proto3" json:"unit_price_numerator,omitempty"`
+	UnitPriceDenominator int64                  `protobuf:"varint,4,opt,name=unit_price_denominator,json=unitPriceDenominator,proto3" json:"unit_price_denominator,omitempty"`
+	// Set on reads only, as in Price.
+	Currency      string `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	UnitPrice     string `protobuf:"bytes,6,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceTier) Reset() {
+	*x = PriceTier{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceTier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceTier) ProtoMessage() {}
+
+func (x *PriceTier) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceTier.ProtoReflect.Descriptor instead.
+func (*PriceTier) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *PriceTier) GetMinQuantity() int64 {
+	if x != nil {
+		return x.MinQuantity
+	}
+	return 0
+}
+
+func (x *PriceTier) GetMaxQuantity() int64 {
+	if x != nil {
+		return x.MaxQuantity
+	}
+	return 0
+}
+
+func (x *PriceTier) GetUnitPriceNumerator() int64 {
+	if x != nil {
+		return x.UnitPriceNumerator
+	}
+	return 0
+}
+
+func (x *PriceTier) GetUnitPriceDenominator() int64 {
+	if x != nil {
+		return x.UnitPriceDenominator
+	}
+	return 0
+}
+
+func (x *PriceTier) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *PriceTier) GetUnitPrice() string {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return ""
+}
+
+// AddVariantRequest adds a sellable version of the product, such as a size
+// or color. Its SKU must not be used by any product or variant.
+type AddVariantRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Sku       string                 `protobuf:"bytes,2,opt,name=sku,proto3" json:"sku,omitempty"`
+	// Such as {"color": "red", "size": "M"}; names are lower-case.
+	Attributes map[string]string `protobuf:"bytes,3,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// The variant's own price in the product's base currency; a zero
+	// denominator sells it at the base price.
+	PriceNumerator   int64 `protobuf:"varint,4,opt,name=price_numerator,json=priceNumerator,proto3" json:"price_numerator,omitempty"`
+	PriceDenominator int64 `protobuf:"varint,5,opt,name=price_denominator,json=priceDenominator,proto3" json:"price_denominator,omitempty"`
+	// "active" (default) or "inactive".
+	Status          string `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	ExpectedVersion int64  `protobuf:"varint,7,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	IdempotencyKey  string `protobuf:"bytes,8,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AddVariantRequest) Reset() {
+	*x = AddVariantRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddVariantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddVariantRequest) ProtoMessage() {}
+
+func (x *AddVariantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddVariantRequest.ProtoReflect.Descriptor instead.
+func (*AddVariantRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *AddVariantRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *AddVariantRequest) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *AddVariantRequest) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *AddVariantRequest) GetPriceNumerator() int64 {
+	if x != nil {
+		return x.PriceNumerator
+	}
+	return 0
+}
+
+func (x *AddVariantRequest) GetPriceDenominator() int64 {
+	if x != nil {
+		return x.PriceDenominator
+	}
+	return 0
+}
+
+func (x *AddVariantRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AddVariantRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+func (x *AddVariantRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type AddVariantReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VariantId     string                 `protobuf:"bytes,1,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddVariantReply) Reset() {
+	*x = AddVariantReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddVariantReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddVariantReply) ProtoMessage() {}
+
+func (x *AddVariantReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddVariantReply.ProtoReflect.Descriptor instead.
+func (*AddVariantReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *AddVariantReply) GetVariantId() string {
+	if x != nil {
+		return x.VariantId
+	}
+	return ""
+}
+
+// UpdateVariantRequest replaces every field of the variant, as in
+// AddVariantRequest.
+type UpdateVariantRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ProductId        string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	VariantId        string                 `protobuf:"bytes,2,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	Sku              string                 `protobuf:"bytes,3,opt,name=sku,proto3" json:"sku,omitempty"`
+	Attributes       map[string]string      `protobuf:"bytes,4,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	PriceNumerator   int64                  `protobuf:"varint,5,opt,name=price_numerator,json=priceNumerator,proto3" json:"price_numerator,omitempty"`
+	PriceDenominator int64                  `protobuf:"varint,6,opt,name=price_denominator,json=priceDenominator,proto3" json:"price_denominator,omitempty"`
+	Status           string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	ExpectedVersion  int64                  `protobuf:"varint,8,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	IdempotencyKey   string                 `protobuf:"bytes,9,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UpdateVariantRequest) Reset() {
+	*x = UpdateVariantRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateVariantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateVariantRequest) ProtoMessage() {}
+
+func (x *UpdateVariantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateVariantRequest.ProtoReflect.Descriptor instead.
+func (*UpdateVariantRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *UpdateVariantRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *UpdateVariantRequest) GetVariantId() string {
+	if x != nil {
+		return x.VariantId
+	}
+	return ""
+}
+
+func (x *UpdateVariantRequest) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *UpdateVariantRequest) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *UpdateVariantRequest) GetPriceNumerator() int64 {
+	if x != nil {
+		return x.PriceNumerator
+	}
+	return 0
+}
+
+func (x *UpdateVariantRequest) GetPriceDenominator() int64 {
+	if x != nil {
+		return x.PriceDenominator
+	}
+	return 0
+}
+
+func (x *UpdateVariantRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *UpdateVariantRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+func (x *UpdateVariantRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type UpdateVariantReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateVariantReply) Reset() {
+	*x = UpdateVariantReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateVariantReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateVariantReply) ProtoMessage() {}
+
+func (x *UpdateVariantReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateVariantReply.ProtoReflect.Descriptor instead.
+func (*UpdateVariantReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{32}
+}
+
+type RemoveVariantRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ProductId       string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	VariantId       string                 `protobuf:"bytes,2,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	ExpectedVersion int64                  `protobuf:"varint,3,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	IdempotencyKey  string                 `protobuf:"bytes,4,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RemoveVariantRequest) Reset() {
+	*x = RemoveVariantRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveVariantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveVariantRequest) ProtoMessage() {}
+
+func (x *RemoveVariantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveVariantRequest.ProtoReflect.Descriptor instead.
+func (*RemoveVariantRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *RemoveVariantRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *RemoveVariantRequest) GetVariantId() string {
+	if x != nil {
+		return x.VariantId
+	}
+	return ""
+}
+
+func (x *RemoveVariantRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+func (x *RemoveVariantRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type RemoveVariantReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveVariantReply) Reset() {
+	*x = RemoveVariantReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveVariantReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveVariantReply) ProtoMessage() {}
+
+func (x *RemoveVariantReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveVariantReply.ProtoReflect.Descriptor instead.
+func (*RemoveVariantReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{34}
+}
+
+// Variant is one variant of a product. Without a price of its own its price
+// fields are empty and zero, and it sells at the product's base price.
+type Variant struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	VariantId        string                 `protobuf:"bytes,1,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	Sku              string                 `protobuf:"bytes,2,opt,name=sku,proto3" json:"sku,omitempty"`
+	Attributes       map[string]string      `protobuf:"bytes,3,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Status           string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	PriceNumerator   int64                  `protobuf:"varint,5,opt,name=price_numerator,json=priceNumerator,proto3" json:"price_numerator,omitempty"`
+	PriceDenominator int64                  `protobuf:"varint,6,opt,name=price_denominator,json=priceDenominator,proto3" json:"price_denominator,omitempty"`
+	Currency         string                 `protobuf:"bytes,7,opt,name=currency,proto3" json:"currency,omitempty"`
+	Price            string                 `protobuf:"bytes,8,opt,name=price,proto3" json:"price,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Variant) Reset() {
+	*x = Variant{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Variant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Variant) ProtoMessage() {}
+
+func (x *Variant) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Variant.ProtoReflect.Descriptor instead.
+func (*Variant) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *Variant) GetVariantId() string {
+	if x != nil {
+		return x.VariantId
+	}
+	return ""
+}
+
+func (x *Variant) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *Variant) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *Variant) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Variant) GetPriceNumerator() int64 {
+	if x != nil {
+		return x.PriceNumerator
+	}
+	return 0
+}
+
+func (x *Variant) GetPriceDenominator() int64 {
+	if x != nil {
+		return x.PriceDenominator
+	}
+	return 0
+}
+
+func (x *Variant) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Variant) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+type GetProductRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// Currency to price the product in; empty means its base currency.
+	// Without a price of its own in it the base price is converted at the
+	// latest rate; with no such rate the call fails with FAILED_PRECONDITION.
+	Currency string `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	// Region such as "DE" or "US-CA" to split tax for; empty leaves tax out.
+	Region string `protobuf:"bytes,3,opt,name=region,proto3" json:"region,omitempty"`
+	// Price the product for a customer: price_list_id picks one list,
+	// customer_group the highest-priority list of the group valid now that
+	// prices the product. Empty, or no such list, means the product's own
+	// prices.
+	PriceListId   string `protobuf:"bytes,4,opt,name=price_list_id,json=priceListId,proto3" json:"price_list_id,omitempty"`
+	CustomerGroup string `protobuf:"bytes,5,opt,name=customer_group,json=customerGroup,proto3" json:"customer_group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductRequest) Reset() {
+	*x = GetProductRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductRequest) ProtoMessage() {}
+
+func (x *GetProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductRequest.ProtoReflect.Descriptor instead.
+func (*GetProductRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetProductRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *GetProductRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *GetProductRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *GetProductRequest) GetPriceListId() string {
+	if x != nil {
+		return x.PriceListId
+	}
+	return ""
+}
+
+func (x *GetProductRequest) GetCustomerGroup() string {
+	if x != nil {
+		return x.CustomerGroup
+	}
+	return ""
+}
+
+type GetProductReply struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	ProductId            string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Name                 string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description          string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Category             string                 `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`
+	BasePriceNumerator   int64                  `protobuf:"varint,5,opt,name=base_price_numerator,json=basePriceNumerator,proto3" json:"base_price_numerator,omitempty"`
+	BasePriceDenominator int64                  `protobuf:"varint,6,opt,name=base_price_denominator,json=basePriceDenominator,proto3" json:"base_price_denominator,omitempty"`
+	Status               string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	// The discount in effect now, or else the next scheduled one.
+	Discount *Discount       `protobuf:"bytes,8,opt,name=discount,proto3,oneof" json:"discount,omitempty"`
+	Version  int64           `protobuf:"varint,9,opt,name=version,proto3" json:"version,omitempty"`
+	Price    *PriceBreakdown `protobuf:"bytes,10,opt,name=price,proto3" json:"price,omitempty"`
+	// Every discount that has not yet ended, ordered by start.
+	Discounts []*Discount `protobuf:"bytes,11,rep,name=discounts,proto3" json:"discounts,omitempty"`
+	// Every currency the product is priced in, base first.
+	Prices []*Price `protobuf:"bytes,12,rep,name=prices,proto3" json:"prices,omitempty"`
+	// base_price_numerator/base_price_denominator as a decimal.
+	BasePrice string `protobuf:"bytes,13,opt,name=base_price,json=basePrice,proto3" json:"base_price,omitempty"`
+	// The lowest effective price in price.currency over the preceding
+	// lowest_price_days days; unset without price history for the period,
+	// and when a price list applies.
+	LowestPrice     *Price `protobuf:"bytes,14,opt,name=lowest_price,json=lowestPrice,proto3,oneof" json:"lowest_price,omitempty"`
+	LowestPriceDays int32  `protobuf:"varint,15,opt,name=lowest_price_days,json=lowestPriceDays,proto3" json:"lowest_price_days,omitempty"`
+	// The product's own tax class; empty when it takes its category's.
+	TaxClass string `protobuf:"bytes,16,opt,name=tax_class,json=taxClass,proto3" json:"tax_class,omitempty"`
+	// The effective price split in the requested region; unset without a
+	// region, or when the region has no rate for the product's class.
+	Tax *TaxBreakdown `protobuf:"bytes,17,opt,name=tax,proto3,oneof" json:"tax,omitempty"`
+	// The quantity breaks, in the base currency, ordered by quantity.
+	PriceTiers []*PriceTier `protobuf:"bytes,18,rep,name=price_tiers,json=priceTiers,proto3" json:"price_tiers,omitempty"`
+	// The price list whose prices are shown; empty for the product's own.
+	PriceListId string `protobuf:"bytes,19,opt,name=price_list_id,json=priceListId,proto3" json:"price_list_id,omitempty"`
+	// The product's variants, ordered by SKU.
+	Variants []*Variant `protobuf:"bytes,20,rep,name=variants,proto3" json:"variants,omitempty"`
+	Sku      string     `protobuf:"bytes,21,opt,name=sku,proto3" json:"sku,omitempty"`
+	// 14 digits; empty when the product has none.
+	Gtin          string `protobuf:"bytes,22,opt,name=gtin,proto3" json:"gtin,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductReply) Reset() {
+	*x = GetProductReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductReply) ProtoMessage() {}
+
+func (x *GetProductReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductReply.ProtoReflect.Descriptor instead.
+func (*GetProductReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetProductReply) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *GetProductReply) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetProductReply) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *GetProductReply) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *GetProductReply) GetBasePriceNumerator() int64 {
+	if x != nil {
+		return x.BasePriceNumerator
+	}
+	return 0
+}
+
+func (x *GetProductReply) GetBasePriceDenominator() int64 {
+	if x != nil {
+		return x.BasePriceDenominator
+	}
+	return 0
+}
+
+func (x *GetProductReply) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GetProductReply) GetDiscount() *Discount {
+	if x != nil {
+		return x.Discount
+	}
+	return nil
+}
+
+func (x *GetProductReply) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *GetProductReply) GetPrice() *PriceBreakdown {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *GetProductReply) GetDiscounts() []*Discount {
+	if x != nil {
+		return x.Discounts
+	}
+	return nil
+}
+
+func (x *GetProductReply) GetPrices() []*Price {
+	if x != nil {
+		return x.Prices
+	}
+	return nil
+}
+
+func (x *GetProductReply) GetBasePrice() string {
+	if x != nil {
+		return x.BasePrice
+	}
+	return ""
+}
+
+func (x *GetProductReply) GetLowestPrice() *Price {
+	if x != nil {
+		return x.LowestPrice
+	}
+	return nil
+}
+
+func (x *GetProductReply) GetLowestPriceDays() int32 {
+	if x != nil {
+		return x.LowestPriceDays
+	}
+	return 0
+}
+
+func (x *GetProductReply) GetTaxClass() string {
+	if x != nil {
+		return x.TaxClass
+	}
+	return ""
+}
+
+func (x *GetProductReply) GetTax() *TaxBreakdown {
+	if x != nil {
+		return x.Tax
+	}
+	return nil
+}
+
+func (x *GetProductReply) GetPriceTiers() []*PriceTier {
+	if x != nil {
+		return x.PriceTiers
+	}
+	return nil
+}
+
+func (x *GetProductReply) GetPriceListId() string {
+	if x != nil {
+		return x.PriceListId
+	}
+	return ""
+}
+
+func (x *GetProductReply) GetVariants() []*Variant {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+func (x *GetProductReply) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *GetProductReply) GetGtin() string {
+	if x != nil {
+		return x.Gtin
+	}
+	return ""
+}
+
+// GetProductBySkuRequest finds a product by sku, its own or one of its
+// variants', or else by gtin, in any of the forms CreateProductRequest
+// takes. The other fields are as in GetProductRequest.
+type GetProductBySkuRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sku           string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	Gtin          string                 `protobuf:"bytes,2,opt,name=gtin,proto3" json:"gtin,omitempty"`
+	Currency      string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	Region        string                 `protobuf:"bytes,4,opt,name=region,proto3" json:"region,omitempty"`
+	PriceListId   string                 `protobuf:"bytes,5,opt,name=price_list_id,json=priceListId,proto3" json:"price_list_id,omitempty"`
+	CustomerGroup string                 `protobuf:"bytes,6,opt,name=customer_group,json=customerGroup,proto3" json:"customer_group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductBySkuRequest) Reset() {
+	*x = GetProductBySkuRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductBySkuRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductBySkuRequest) ProtoMessage() {}
+
+func (x *GetProductBySkuRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductBySkuRequest.ProtoReflect.Descriptor instead.
+func (*GetProductBySkuRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetProductBySkuRequest) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *GetProductBySkuRequest) GetGtin() string {
+	if x != nil {
+		return x.Gtin
+	}
+	return ""
+}
+
+func (x *GetProductBySkuRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *GetProductBySkuRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *GetProductBySkuRequest) GetPriceListId() string {
+	if x != nil {
+		return x.PriceListId
+	}
+	return ""
+}
+
+func (x *GetProductBySkuRequest) GetCustomerGroup() string {
+	if x != nil {
+		return x.CustomerGroup
+	}
+	return ""
+}
+
+// BatchGetProductsRequest reads up to 100 products at once, failing with
+// NOT_FOUND if any is missing. The other fields are as in GetProductRequest
+// and apply to every product.
+type BatchGetProductsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductIds    []string               `protobuf:"bytes,1,rep,name=product_ids,json=productIds,proto3" json:"product_ids,omitempty"`
+	Currency      string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	Region        string                 `protobuf:"bytes,3,opt,name=region,proto3" json:"region,omitempty"`
+	PriceListId   string                 `protobuf:"bytes,4,opt,name=price_list_id,json=priceListId,proto3" json:"price_list_id,omitempty"`
+	CustomerGroup string                 `protobuf:"bytes,5,opt,name=customer_group,json=customerGroup,proto3" json:"customer_group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetProductsRequest) Reset() {
+	*x = BatchGetProductsRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetProductsRequest) ProtoMessage() {}
+
+func (x *BatchGetProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetProductsRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetProductsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *BatchGetProductsRequest) GetProductIds() []string {
+	if x != nil {
+		return x.ProductIds
+	}
+	return nil
+}
+
+func (x *BatchGetProductsRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *BatchGetProductsRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *BatchGetProductsRequest) GetPriceListId() string {
+	if x != nil {
+		return x.PriceListId
+	}
+	return ""
+}
+
+func (x *BatchGetProductsRequest) GetCustomerGroup() string {
+	if x != nil {
+		return x.CustomerGroup
+	}
+	return ""
+}
+
+type BatchGetProductsReply struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// In request order.
+	Products      []*GetProductReply `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetProductsReply) Reset() {
+	*x = BatchGetProductsReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetProductsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetProductsReply) ProtoMessage() {}
+
+func (x *BatchGetProductsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetProductsReply.ProtoReflect.Descriptor instead.
+func (*BatchGetProductsReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *BatchGetProductsReply) GetProducts() []*GetProductReply {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+// TaxBreakdown splits a price at the region's rate for tax_class. mode is
+// "net" when stored prices exclude tax and "gross" when they include it;
+// the other side is derived and rounded, and tax is their difference.
+type TaxBreakdown struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Region           string                 `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`
+	TaxClass         string                 `protobuf:"bytes,2,opt,name=tax_class,json=taxClass,proto3" json:"tax_class,omitempty"`
+	Mode             string                 `protobuf:"bytes,3,opt,name=mode,proto3" json:"mode,omitempty"`
+	RateNumerator    int64                  `protobuf:"varint,4,opt,name=rate_numerator,json=rateNumerator,proto3" json:"rate_numerator,omitempty"`
+	RateDenominator  int64                  `protobuf:"varint,5,opt,name=rate_denominator,json=rateDenominator,proto3" json:"rate_denominator,omitempty"`
+	NetNumerator     int64                  `protobuf:"varint,6,opt,name=net_numerator,json=netNumerator,proto3" json:"net_numerator,omitempty"`
+	NetDenominator   int64                  `protobuf:"varint,7,opt,name=net_denominator,json=netDenominator,proto3" json:"net_denominator,omitempty"`
+	TaxNumerator     int64                  `protobuf:"varint,8,opt,name=tax_numerator,json=taxNumerator,proto3" json:"tax_numerator,omitempty"`
+	TaxDenominator   int64                  `protobuf:"varint,9,opt,name=tax_denominator,json=taxDenominator,proto3" json:"tax_denominator,omitempty"`
+	GrossNumerator   int64                  `protobuf:"varint,10,opt,name=gross_numerator,json=grossNumerator,proto3" json:"gross_numerator,omitempty"`
+	GrossDenominator int64                  `protobuf:"varint,11,opt,name=gross_denominator,json=grossDenominator,proto3" json:"gross_denominator,omitempty"`
+	Net              string                 `protobuf:"bytes,12,opt,name=net,proto3" json:"net,omitempty"`
+	Tax              string                 `protobuf:"bytes,13,opt,name=tax,proto3" json:"tax,omitempty"`
+	Gross            string                 `protobuf:"bytes,14,opt,name=gross,proto3" json:"gross,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *TaxBreakdown) Reset() {
+	*x = TaxBreakdown{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaxBreakdown) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaxBreakdown) ProtoMessage() {}
+
+func (x *TaxBreakdown) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaxBreakdown.ProtoReflect.Descriptor instead.
+func (*TaxBreakdown) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *TaxBreakdown) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *TaxBreakdown) GetTaxClass() string {
+	if x != nil {
+		return x.TaxClass
+	}
+	return ""
+}
+
+func (x *TaxBreakdown) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *TaxBreakdown) GetRateNumerator() int64 {
+	if x != nil {
+		return x.RateNumerator
+	}
+	return 0
+}
+
+func (x *TaxBreakdown) GetRateDenominator() int64 {
+	if x != nil {
+		return x.RateDenominator
+	}
+	return 0
+}
+
+func (x *TaxBreakdown) GetNetNumerator() int64 {
+	if x != nil {
+		return x.NetNumerator
+	}
+	return 0
+}
+
+func (x *TaxBreakdown) GetNetDenominator() int64 {
+	if x != nil {
+		return x.NetDenominator
+	}
+	return 0
+}
+
+func (x *TaxBreakdown) GetTaxNumerator() int64 {
+	if x != nil {
+		return x.TaxNumerator
+	}
+	return 0
+}
+
+func (x *TaxBreakdown) GetTaxDenominator() int64 {
+	if x != nil {
+		return x.TaxDenominator
+	}
+	return 0
+}
+
+func (x *TaxBreakdown) GetGrossNumerator() int64 {
+	if x != nil {
+		return x.GrossNumerator
+	}
+	return 0
+}
+
+func (x *TaxBreakdown) GetGrossDenominator() int64 {
+	if x != nil {
+		return x.GrossDenominator
+	}
+	return 0
+}
+
+func (x *TaxBreakdown) GetNet() string {
+	if x != nil {
+		return x.Net
+	}
+	return ""
+}
+
+func (x *TaxBreakdown) GetTax() string {
+	if x != nil {
+		return x.Tax
+	}
+	return ""
+}
+
+func (x *TaxBreakdown) GetGross() string {
+	if x != nil {
+		return x.Gross
+	}
+	return ""
+}
+
+type Price struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Currency    string                 `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	Numerator   int64                  `protobuf:"varint,2,opt,name=numerator,proto3" json:"numerator,omitempty"`
+	Denominator int64                  `protobuf:"varint,3,opt,name=denominator,proto3" json:"denominator,omitempty"`
+	// The same amount as a decimal, such as "17.99".
+	Amount        string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Price) Reset() {
+	*x = Price{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Price) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Price) ProtoMessage() {}
+
+func (x *Price) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Price.ProtoReflect.Descriptor instead.
+func (*Price) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *Price) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Price) GetNumerator() int64 {
+	if x != nil {
+		return x.Numerator
+	}
+	return 0
+}
+
+func (x *Price) GetDenominator() int64 {
+	if x != nil {
+		return x.Denominator
+	}
+	return 0
+}
+
+func (x *Price) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type Discount struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Set for "percentage" discounts only.
+	PercentNumerator   int64 `protobuf:"varint,1,opt,name=percent_numerator,json=percentNumerator,proto3" json:"percent_numerator,omitempty"`
+	PercentDenominator int64 `protobuf:"varint,2,opt,name=percent_denominator,json=percentDenominator,proto3" json:"percent_denominator,omitempty"`
+	StartTimestamp     int64 `protobuf:"varint,3,opt,name=start_timestamp,json=startTimestamp,proto3" json:"start_timestamp,omitempty"`
+	EndTimestamp       int64 `protobuf:"varint,4,opt,name=end_timestamp,json=endTimestamp,proto3" json:"end_timestamp,omitempty"`
+	// Whether the discount reduces the price now; a scheduled discount, or
+	// one outranked under its stacking policy, is still reported.
+	Active     bool   `protobuf:"varint,5,opt,name=active,proto3" json:"active,omitempty"`
+	DiscountId string `protobuf:"bytes,6,opt,name=discount_id,json=discountId,proto3" json:"discount_id,omitempty"`
+	// "scheduled" or "active".
+	Status   string `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	Priority int64  `protobuf:"varint,8,opt,name=priority,proto3" json:"priority,omitempty"`
+	Stacking string `protobuf:"bytes,9,opt,name=stacking,proto3" json:"stacking,omitempty"`
+	Kind     string `protobuf:"bytes,10,opt,name=kind,proto3" json:"kind,omitempty"`
+	// Amount off or final price; set for the fixed kinds only.
+	AmountNumerator   int64  `protobuf:"varint,11,opt,name=amount_numerator,json=amountNumerator,proto3" json:"amount_numerator,omitempty"`
+	AmountDenominator int64  `protobuf:"varint,12,opt,name=amount_denominator,json=amountDenominator,proto3" json:"amount_denominator,omitempty"`
+	AmountCurrency    string `protobuf:"bytes,13,opt,name=amount_currency,json=amountCurrency,proto3" json:"amount_currency,omitempty"`
+	Amount            string `protobuf:"bytes,14,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Discount) Reset() {
+	*x = Discount{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Discount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Discount) ProtoMessage() {}
+
+func (x *Discount) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Discount.ProtoReflect.Descriptor instead.
+func (*Discount) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *Discount) GetPercentNumerator() int64 {
+	if x != nil {
+		return x.PercentNumerator
+	}
+	return 0
+}
+
+func (x *Discount) GetPercentDenominator() int64 {
+	if x != nil {
+		return x.PercentDenominator
+	}
+	return 0
+}
+
+func (x *Discount) GetStartTimestamp() int64 {
+	if x != nil {
+		return x.StartTimestamp
+	}
+	return 0
+}
+
+func (x *Discount) GetEndTimestamp() int64 {
+	if x != nil {
+		return x.EndTimestamp
+	}
+	return 0
+}
+
+func (x *Discount) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *Discount) GetDiscountId() string {
+	if x != nil {
+		return x.DiscountId
+	}
+	return ""
+}
+
+func (x *Discount) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Discount) GetPriority() int64 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *Discount) GetStacking() string {
+	if x != nil {
+		return x.Stacking
+	}
+	return ""
+}
+
+func (x *Discount) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Discount) GetAmountNumerator() int64 {
+	if x != nil {
+		return x.AmountNumerator
+	}
+	return 0
+}
+
+func (x *Discount) GetAmountDenominator() int64 {
+	if x != nil {
+		return x.AmountDenominator
+	}
+	return 0
+}
+
+func (x *Discount) GetAmountCurrency() string {
+	if x != nil {
+		return x.AmountCurrency
+	}
+	return ""
+}
+
+func (x *Discount) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+// PriceBreakdown is the price evaluated now: base minus discount_amount
+// equals effective. Every amount is rounded to the minor unit of currency
+// and given both as a decimal string with exactly that many digits after
+// the point, such as "17.99" or "1800" for JPY, and as a reduced fraction.
+// Prefer the strings: a fraction too large for int64 is sent as 0/0.
+type PriceBreakdown struct {
+	state                     protoimpl.MessageState `protogen:"open.v1"`
+	BaseNumerator             int64                  `protobuf:"varint,1,opt,name=base_numerator,json=baseNumerator,proto3" json:"base_numerator,omitempty"`
+	BaseDenominator           int64                  `protobuf:"varint,2,opt,name=base_denominator,json=baseDenominator,proto3" json:"base_denominator,omitempty"`
+	DiscountAmountNumerator   int64                  `protobuf:"varint,3,opt,name=discount_amount_numerator,json=discountAmountNumerator,proto3" json:"discount_amount_numerator,omitempty"`
+	DiscountAmountDenominator int64                  `protobuf:"varint,4,opt,name=discount_amount_denominator,json=discountAmountDenominator,proto3" json:"discount_amount_denominator,omitempty"`
+	EffectiveNumerator        int64                  `protobuf:"varint,5,opt,name=effective_numerator,json=effectiveNumerator,proto3" json:"effective_numerator,omitempty"`
+	EffectiveDenominator      int64                  `protobuf:"varint,6,opt,name=effective_denominator,json=effectiveDenominator,proto3" json:"effective_denominator,omitempty"`
+	// The currency every amount above is in.
+	Currency       string `protobuf:"bytes,7,opt,name=currency,proto3" json:"currency,omitempty"`
+	Base           string `protobuf:"bytes,8,opt,name=base,proto3" json:"base,omitempty"`
+	DiscountAmount string `protobuf:"bytes,9,opt,name=discount_amount,json=discountAmount,proto3" json:"discount_amount,omitempty"`
+	Effective      string `protobuf:"bytes,10,opt,name=effective,proto3" json:"effective,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PriceBreakdown) Reset() {
+	*x = PriceBreakdown{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceBreakdown) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceBreakdown) ProtoMessage() {}
+
+func (x *PriceBreakdown) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceBreakdown.ProtoReflect.Descriptor instead.
+func (*PriceBreakdown) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *PriceBreakdown) GetBaseNumerator() int64 {
+	if x != nil {
+		return x.BaseNumerator
+	}
+	return 0
+}
+
+func (x *PriceBreakdown) GetBaseDenominator() int64 {
+	if x != nil {
+		return x.BaseDenominator
+	}
+	return 0
+}
+
+func (x *PriceBreakdown) GetDiscountAmountNumerator() int64 {
+	if x != nil {
+		return x.DiscountAmountNumerator
+	}
+	return 0
+}
+
+func (x *PriceBreakdown) GetDiscountAmountDenominator() int64 {
+	if x != nil {
+		return x.DiscountAmountDenominator
+	}
+	return 0
+}
+
+func (x *PriceBreakdown) GetEffectiveNumerator() int64 {
+	if x != nil {
+		return x.EffectiveNumerator
+	}
+	return 0
+}
+
+func (x *PriceBreakdown) GetEffectiveDenominator() int64 {
+	if x != nil {
+		return x.EffectiveDenominator
+	}
+	return 0
+}
+
+func (x *PriceBreakdown) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *PriceBreakdown) GetBase() string {
+	if x != nil {
+		return x.Base
+	}
+	return ""
+}
+
+func (x *PriceBreakdown) GetDiscountAmount() string {
+	if x != nil {
+		return x.DiscountAmount
+	}
+	return ""
+}
+
+func (x *PriceBreakdown) GetEffective() string {
+	if x != nil {
+		return x.Effective
+	}
+	return ""
+}
+
+type ListProductsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Category  string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	PageSize  int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// As in GetProductRequest.
+	Currency      string `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	Region        string `protobuf:"bytes,5,opt,name=region,proto3" json:"region,omitempty"`
+	PriceListId   string `protobuf:"bytes,6,opt,name=price_list_id,json=priceListId,proto3" json:"price_list_id,omitempty"`
+	CustomerGroup string `protobuf:"bytes,7,opt,name=customer_group,json=customerGroup,proto3" json:"customer_group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductsRequest) Reset() {
+	*x = ListProductsRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductsRequest) ProtoMessage() {}
+
+func (x *ListProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductsRequest.ProtoReflect.Descriptor instead.
+func (*ListProductsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ListProductsRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *ListProductsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListProductsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListProductsRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *ListProductsRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *ListProductsRequest) GetPriceListId() string {
+	if x != nil {
+		return x.PriceListId
+	}
+	return ""
+}
+
+func (x *ListProductsRequest) GetCustomerGroup() string {
+	if x != nil {
+		return x.CustomerGroup
+	}
+	return ""
+}
+
+type ListProductsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Products      []*ProductInfo         `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductsReply) Reset() {
+	*x = ListProductsReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductsReply) ProtoMessage() {}
+
+func (x *ListProductsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductsReply.ProtoReflect.Descriptor instead.
+func (*ListProductsReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ListProductsReply) GetProducts() []*ProductInfo {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+func (x *ListProductsReply) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ProductInfo struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Name      string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Category  string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	Status    string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Price     *PriceBreakdown        `protobuf:"bytes,5,opt,name=price,proto3" json:"price,omitempty"`
+	Discount  *Discount              `protobuf:"bytes,6,opt,name=discount,proto3,oneof" json:"discount,omitempty"`
+	Discounts []*Discount            `protobuf:"bytes,7,rep,name=discounts,proto3" json:"discounts,omitempty"`
+	Prices    []*Price               `protobuf:"bytes,8,rep,name=prices,proto3" json:"prices,omitempty"`
+	// As in GetProductReply.
+	LowestPrice     *Price        `protobuf:"bytes,9,opt,name=lowest_price,json=lowestPrice,proto3,oneof" json:"lowest_price,omitempty"`
+	LowestPriceDays int32         `protobuf:"varint,10,opt,name=lowest_price_days,json=lowestPriceDays,proto3" json:"lowest_price_days,omitempty"`
+	TaxClass        string        `protobuf:"bytes,11,opt,name=tax_class,json=taxClass,proto3" json:"tax_class,omitempty"`
+	Tax             *TaxBreakdown `protobuf:"bytes,12,opt,name=tax,proto3,oneof" json:"tax,omitempty"`
+	PriceListId     string        `protobuf:"bytes,13,opt,name=price_list_id,json=priceListId,proto3" json:"price_list_id,omitempty"`
+	Variants        []*Variant    `protobuf:"bytes,14,rep,name=variants,proto3" json:"variants,omitempty"`
+	Sku             string        `protobuf:"bytes,15,opt,name=sku,proto3" json:"sku,omitempty"`
+	Gtin            string        `protobuf:"bytes,16,opt,name=gtin,proto3" json:"gtin,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ProductInfo) Reset() {
+	*x = ProductInfo{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductInfo) ProtoMessage() {}
+
+func (x *ProductInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductInfo.ProtoReflect.Descriptor instead.
+func (*ProductInfo) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ProductInfo) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ProductInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProductInfo) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *ProductInfo) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ProductInfo) GetPrice() *PriceBreakdown {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *ProductInfo) GetDiscount() *Discount {
+	if x != nil {
+		return x.Discount
+	}
+	return nil
+}
+
+func (x *ProductInfo) GetDiscounts() []*Discount {
+	if x != nil {
+		return x.Discounts
+	}
+	return nil
+}
+
+func (x *ProductInfo) GetPrices() []*Price {
+	if x != nil {
+		return x.Prices
+	}
+	return nil
+}
+
+func (x *ProductInfo) GetLowestPrice() *Price {
+	if x != nil {
+		return x.LowestPrice
+	}
+	return nil
+}
+
+func (x *ProductInfo) GetLowestPriceDays() int32 {
+	if x != nil {
+		return x.LowestPriceDays
+	}
+	return 0
+}
+
+func (x *ProductInfo) GetTaxClass() string {
+	if x != nil {
+		return x.TaxClass
+	}
+	return ""
+}
+
+func (x *ProductInfo) GetTax() *TaxBreakdown {
+	if x != nil {
+		return x.Tax
+	}
+	return nil
+}
+
+func (x *ProductInfo) GetPriceListId() string {
+	if x != nil {
+		return x.PriceListId
+	}
+	return ""
+}
+
+func (x *ProductInfo) GetVariants() []*Variant {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+func (x *ProductInfo) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *ProductInfo) GetGtin() string {
+	if x != nil {
+		return x.Gtin
+	}
+	return ""
+}
+
+type QuotePriceRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// Unix seconds to evaluate the price at; 0 means now.
+	AtTimestamp int64 `protobuf:"varint,2,opt,name=at_timestamp,json=atTimestamp,proto3" json:"at_timestamp,omitempty"`
+	// Currency to quote in; empty means the base currency. Without a price
+	// of its own in it the base price is converted at the rate in effect at
+	// at_timestamp.
+	Currency string `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	// Region to split tax for; the quote fails with FAILED_PRECONDITION when
+	// it has no rate for the product's class. Empty leaves tax out.
+	Region string `protobuf:"bytes,4,opt,name=region,proto3" json:"region,omitempty"`
+	// Units bought together, picking the price tier; 0 means one. A price
+	// of the product's own in currency is the same at every quantity.
+	Quantity int64 `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// As in GetProductRequest, with lists picked as valid at at_timestamp. A
+	// list's price stands in for the product's at every quantity.
+	PriceListId   string `protobuf:"bytes,6,opt,name=price_list_id,json=priceListId,proto3" json:"price_list_id,omitempty"`
+	CustomerGroup string `protobuf:"bytes,7,opt,name=customer_group,json=customerGroup,proto3" json:"customer_group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuotePriceRequest) Reset() {
+	*x = QuotePriceRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuotePriceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuotePriceRequest) ProtoMessage() {}
+
+func (x *QuotePriceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuotePriceRequest.ProtoReflect.Descriptor instead.
+func (*QuotePriceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *QuotePriceRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *QuotePriceRequest) GetAtTimestamp() int64 {
+	if x != nil {
+		return x.AtTimestamp
+	}
+	return 0
+}
+
+func (x *QuotePriceRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *QuotePriceRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *QuotePriceRequest) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *QuotePriceRequest) GetPriceListId() string {
+	if x != nil {
+		return x.PriceListId
+	}
+	return ""
+}
+
+func (x *QuotePriceRequest) GetCustomerGroup() string {
+	if x != nil {
+		return x.CustomerGroup
+	}
+	return ""
+}
+
+type QuotePriceReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Quote         *PriceQuote            `protobuf:"bytes,1,opt,name=quote,proto3" json:"quote,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuotePriceReply) Reset() {
+	*x = QuotePriceReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuotePriceReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuotePriceReply) ProtoMessage() {}
+
+func (x *QuotePriceReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuotePriceReply.ProtoReflect.Descriptor instead.
+func (*QuotePriceReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *QuotePriceReply) GetQuote() *PriceQuote {
+	if x != nil {
+		return x.Quote
+	}
+	return nil
+}
+
+type BatchQuotePricesRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ProductIds  []string               `protobuf:"bytes,1,rep,name=product_ids,json=productIds,proto3" json:"product_ids,omitempty"`
+	AtTimestamp int64                  `protobuf:"varint,2,opt,name=at_timestamp,json=atTimestamp,proto3" json:"at_timestamp,omitempty"`
+	Currency    string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	Region      string                 `protobuf:"bytes,4,opt,name=region,proto3" json:"region,omitempty"`
+	// Applies to every product.
+	Quantity      int64  `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	PriceListId   string `protobuf:"bytes,6,opt,name=price_list_id,json=priceListId,proto3" json:"price_list_id,omitempty"`
+	CustomerGroup string `protobuf:"bytes,7,opt,name=customer_group,json=customerGroup,proto3" json:"customer_group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchQuotePricesRequest) Reset() {
+	*x = BatchQuotePricesRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchQuotePricesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchQuotePricesRequest) ProtoMessage() {}
+
+func (x *BatchQuotePricesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchQuotePricesRequest.ProtoReflect.Descriptor instead.
+func (*BatchQuotePricesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *BatchQuotePricesRequest) GetProductIds() []string {
+	if x != nil {
+		return x.ProductIds
+	}
+	return nil
+}
+
+func (x *BatchQuotePricesRequest) GetAtTimestamp() int64 {
+	if x != nil {
+		return x.AtTimestamp
+	}
+	return 0
+}
+
+func (x *BatchQuotePricesRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *BatchQuotePricesRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *BatchQuotePricesRequest) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *BatchQuotePricesRequest) GetPriceListId() string {
+	if x != nil {
+		return x.PriceListId
+	}
+	return ""
+}
+
+func (x *BatchQuotePricesRequest) GetCustomerGroup() string {
+	if x != nil {
+		return x.CustomerGroup
+	}
+	return ""
+}
+
+type BatchQuotePricesReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Quotes        []*PriceQuote          `protobuf:"bytes,1,rep,name=quotes,proto3" json:"quotes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchQuotePricesReply) Reset() {
+	*x = BatchQuotePricesReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchQuotePricesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchQuotePricesReply) ProtoMessage() {}
+
+func (x *BatchQuotePricesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchQuotePricesReply.ProtoReflect.Descriptor instead.
+func (*BatchQuotePricesReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *BatchQuotePricesReply) GetQuotes() []*PriceQuote {
+	if x != nil {
+		return x.Quotes
+	}
+	return nil
+}
+
+type PriceQuote struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ProductId   string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	AtTimestamp int64                  `protobuf:"varint,2,opt,name=at_timestamp,json=atTimestamp,proto3" json:"at_timestamp,omitempty"`
+	// The price of one unit at the tier quantity falls in.
+	Price *PriceBreakdown `protobuf:"bytes,3,opt,name=price,proto3" json:"price,omitempty"`
+	// The highest-priority discount that applied at at_timestamp, if any.
+	AppliedDiscount *Discount `protobuf:"bytes,4,opt,name=applied_discount,json=appliedDiscount,proto3,oneof" json:"applied_discount,omitempty"`
+	// Every discount that applied, in the order applied, with its share of
+	// price.discount_amount.
+	AppliedDiscounts []*AppliedDiscount `protobuf:"bytes,5,rep,name=applied_discounts,json=appliedDiscounts,proto3" json:"applied_discounts,omitempty"`
+	// The rate the base price was converted with; unset when the product has
+	// its own price in the quoted currency.
+	ExchangeRate *ExchangeRate `protobuf:"bytes,6,opt,name=exchange_rate,json=exchangeRate,proto3,oneof" json:"exchange_rate,omitempty"`
+	// Set when a region was asked for; splits line.effective.
+	Tax      *TaxBreakdown `protobuf:"bytes,7,opt,name=tax,proto3,oneof" json:"tax,omitempty"`
+	Quantity int64         `protobuf:"varint,8,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// price times quantity.
+	Line *PriceBreakdown `protobuf:"bytes,9,opt,name=line,proto3" json:"line,omitempty"`
+	// The price list the price came from; empty for the product's own.
+	PriceListId   string `protobuf:"bytes,10,opt,name=price_list_id,json=priceListId,proto3" json:"price_list_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceQuote) Reset() {
+	*x = PriceQuote{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceQuote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceQuote) ProtoMessage() {}
+
+func (x *PriceQuote) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceQuote.ProtoReflect.Descriptor instead.
+func (*PriceQuote) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *PriceQuote) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *PriceQuote) GetAtTimestamp() int64 {
+	if x != nil {
+		return x.AtTimestamp
+	}
+	return 0
+}
+
+func (x *PriceQuote) GetPrice() *PriceBreakdown {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *PriceQuote) GetAppliedDiscount() *Discount {
+	if x != nil {
+		return x.AppliedDiscount
+	}
+	return nil
+}
+
+func (x *PriceQuote) GetAppliedDiscounts() []*AppliedDiscount {
+	if x != nil {
+		return x.AppliedDiscounts
+	}
+	return nil
+}
+
+func (x *PriceQuote) GetExchangeRate() *ExchangeRate {
+	if x != nil {
+		return x.ExchangeRate
+	}
+	return nil
+}
+
+func (x *PriceQuote) GetTax() *TaxBreakdown {
+	if x != nil {
+		return x.Tax
+	}
+	return nil
+}
+
+func (x *PriceQuote) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *PriceQuote) GetLine() *PriceBreakdown {
+	if x != nil {
+		return x.Line
+	}
+	return nil
+}
+
+func (x *PriceQuote) GetPriceListId() string {
+	if x != nil {
+		return x.PriceListId
+	}
+	return ""
+}
+
+type AppliedDiscount struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Discount          *Discount              `protobuf:"bytes,1,opt,name=discount,proto3" json:"discount,omitempty"`
+	AmountNumerator   int64                  `protobuf:"varint,2,opt,name=amount_numerator,json=amountNumerator,proto3" json:"amount_numerator,omitempty"`
+	AmountDenominator int64                  `protobuf:"varint,3,opt,name=amount_denominator,json=amountDenominator,proto3" json:"amount_denominator,omitempty"`
+	Amount            string                 `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *AppliedDiscount) Reset() {
+	*x = AppliedDiscount{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AppliedDiscount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppliedDiscount) ProtoMessage() {}
+
+func (x *AppliedDiscount) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppliedDiscount.ProtoReflect.Descriptor instead.
+func (*AppliedDiscount) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *AppliedDiscount) GetDiscount() *Discount {
+	if x != nil {
+		return x.Discount
+	}
+	return nil
+}
+
+func (x *AppliedDiscount) GetAmountNumerator() int64 {
+	if x != nil {
+		return x.AmountNumerator
+	}
+	return 0
+}
+
+func (x *AppliedDiscount) GetAmountDenominator() int64 {
+	if x != nil {
+		return x.AmountDenominator
+	}
+	return 0
+}
+
+func (x *AppliedDiscount) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type ExchangeRate struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	FromCurrency string                 `protobuf:"bytes,1,opt,name=from_currency,json=fromCurrency,proto3" json:"from_currency,omitempty"`
+	ToCurrency   string                 `protobuf:"bytes,2,opt,name=to_currency,json=toCurrency,proto3" json:"to_currency,omitempty"`
+	// One unit of from_currency is rate_numerator/rate_denominator units of
+	// to_currency.
+	RateNumerator      int64 `protobuf:"varint,3,opt,name=rate_numerator,json=rateNumerator,proto3" json:"rate_numerator,omitempty"`
+	RateDenominator    int64 `protobuf:"varint,4,opt,name=rate_denominator,json=rateDenominator,proto3" json:"rate_denominator,omitempty"`
+	EffectiveTimestamp int64 `protobuf:"varint,5,opt,name=effective_timestamp,json=effectiveTimestamp,proto3" json:"effective_timestamp,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ExchangeRate) Reset() {
+	*x = ExchangeRate{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExchangeRate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExchangeRate) ProtoMessage() {}
+
+func (x *ExchangeRate) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExchangeRate.ProtoReflect.Descriptor instead.
+func (*ExchangeRate) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ExchangeRate) GetFromCurrency() string {
+	if x != nil {
+		return x.FromCurrency
+	}
+	return ""
+}
+
+func (x *ExchangeRate) GetToCurrency() string {
+	if x != nil {
+		return x.ToCurrency
+	}
+	return ""
+}
+
+func (x *ExchangeRate) GetRateNumerator() int64 {
+	if x != nil {
+		return x.RateNumerator
+	}
+	return 0
+}
+
+func (x *ExchangeRate) GetRateDenominator() int64 {
+	if x != nil {
+		return x.RateDenominator
+	}
+	return 0
+}
+
+func (x *ExchangeRate) GetEffectiveTimestamp() int64 {
+	if x != nil {
+		return x.EffectiveTimestamp
+	}
+	return 0
+}
+
+type SetExchangeRateRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	FromCurrency    string                 `protobuf:"bytes,1,opt,name=from_currency,json=fromCurrency,proto3" json:"from_currency,omitempty"`
+	ToCurrency      string                 `protobuf:"bytes,2,opt,name=to_currency,json=toCurrency,proto3" json:"to_currency,omitempty"`
+	RateNumerator   int64                  `protobuf:"varint,3,opt,name=rate_numerator,json=rateNumerator,proto3" json:"rate_numerator,omitempty"`
+	RateDenominator int64                  `protobuf:"varint,4,opt,name=rate_denominator,json=rateDenominator,proto3" json:"rate_denominator,omitempty"`
+	// Unix seconds the rate applies from; 0 means now. Setting a rate for
+	// the same pair and time again replaces it.
+	EffectiveTimestamp int64  `protobuf:"varint,5,opt,name=effective_timestamp,json=effectiveTimestamp,proto3" json:"effective_timestamp,omitempty"`
+	IdempotencyKey     string `protobuf:"bytes,6,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *SetExchangeRateRequest) Reset() {
+	*x = SetExchangeRateRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetExchangeRateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetExchangeRateRequest) ProtoMessage() {}
+
+func (x *SetExchangeRateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetExchangeRateRequest.ProtoReflect.Descriptor instead.
+func (*SetExchangeRateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *SetExchangeRateRequest) GetFromCurrency() string {
+	if x != nil {
+		return x.FromCurrency
+	}
+	return ""
+}
+
+func (x *SetExchangeRateRequest) GetToCurrency() string {
+	if x != nil {
+		return x.ToCurrency
+	}
+	return ""
+}
+
+func (x *SetExchangeRateRequest) GetRateNumerator() int64 {
+	if x != nil {
+		return x.RateNumerator
+	}
+	return 0
+}
+
+func (x *SetExchangeRateRequest) GetRateDenominator() int64 {
+	if x != nil {
+		return x.RateDenominator
+	}
+	return 0
+}
+
+func (x *SetExchangeRateRequest) GetEffectiveTimestamp() int64 {
+	if x != nil {
+		return x.EffectiveTimestamp
+	}
+	return 0
+}
+
+func (x *SetExchangeRateRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type SetExchangeRateReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetExchangeRateReply) Reset() {
+	*x = SetExchangeRateReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetExchangeRateReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetExchangeRateReply) ProtoMessage() {}
+
+func (x *SetExchangeRateReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetExchangeRateReply.ProtoReflect.Descriptor instead.
+func (*SetExchangeRateReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{56}
+}
+
+type SetTaxRateRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Region   string                 `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`
+	TaxClass string                 `protobuf:"bytes,2,opt,name=tax_class,json=taxClass,proto3" json:"tax_class,omitempty"`
+	// Share of the net price, such as 19/100. Setting a rate for the same
+	// region and class again replaces it.
+	RateNumerator   int64  `protobuf:"varint,3,opt,name=rate_numerator,json=rateNumerator,proto3" json:"rate_numerator,omitempty"`
+	RateDenominator int64  `protobuf:"varint,4,opt,name=rate_denominator,json=rateDenominator,proto3" json:"rate_denominator,omitempty"`
+	IdempotencyKey  string `protobuf:"bytes,5,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SetTaxRateRequest) Reset() {
+	*x = SetTaxRateRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTaxRateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTaxRateRequest) ProtoMessage() {}
+
+func (x *SetTaxRateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTaxRateRequest.ProtoReflect.Descriptor instead.
+func (*SetTaxRateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *SetTaxRateRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *SetTaxRateRequest) GetTaxClass() string {
+	if x != nil {
+		return x.TaxClass
+	}
+	return ""
+}
+
+func (x *SetTaxRateRequest) GetRateNumerator() int64 {
+	if x != nil {
+		return x.RateNumerator
+	}
+	return 0
+}
+
+func (x *SetTaxRateRequest) GetRateDenominator() int64 {
+	if x != nil {
+		return x.RateDenominator
+	}
+	return 0
+}
+
+func (x *SetTaxRateRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type SetTaxRateReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTaxRateReply) Reset() {
+	*x = SetTaxRateReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTaxRateReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTaxRateReply) ProtoMessage() {}
+
+func (x *SetTaxRateReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTaxRateReply.ProtoReflect.Descriptor instead.
+func (*SetTaxRateReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{58}
+}
+
+type SetCategoryTaxClassRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Category string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	// Applies to products in category without a class of their own; empty
+	// removes the assignment.
+	TaxClass       string `protobuf:"bytes,2,opt,name=tax_class,json=taxClass,proto3" json:"tax_class,omitempty"`
+	IdempotencyKey string `protobuf:"bytes,3,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SetCategoryTaxClassRequest) Reset() {
+	*x = SetCategoryTaxClassRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCategoryTaxClassRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCategoryTaxClassRequest) ProtoMessage() {}
+
+func (x *SetCategoryTaxClassRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCategoryTaxClassRequest.ProtoReflect.Descriptor instead.
+func (*SetCategoryTaxClassRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *SetCategoryTaxClassRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *SetCategoryTaxClassRequest) GetTaxClass() string {
+	if x != nil {
+		return x.TaxClass
+	}
+	return ""
+}
+
+func (x *SetCategoryTaxClassRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type SetCategoryTaxClassReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCategoryTaxClassReply) Reset() {
+	*x = SetCategoryTaxClassReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCategoryTaxClassReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCategoryTaxClassReply) ProtoMessage() {}
+
+func (x *SetCategoryTaxClassReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCategoryTaxClassReply.ProtoReflect.Descriptor instead.
+func (*SetCategoryTaxClassReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{60}
+}
+
+type CreatePriceListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	CustomerGroup string                 `protobuf:"bytes,2,opt,name=customer_group,json=customerGroup,proto3" json:"customer_group,omitempty"`
+	// Where several valid lists of the group price a product, the highest
+	// priority wins.
+	Priority int64 `protobuf:"varint,3,opt,name=priority,proto3" json:"priority,omitempty"`
+	// Unix seconds the list applies from and until; 0 leaves that end open.
+	ValidFromTimestamp int64  `protobuf:"varint,4,opt,name=valid_from_timestamp,json=validFromTimestamp,proto3" json:"valid_from_timestamp,omitempty"`
+	ValidToTimestamp   int64  `protobuf:"varint,5,opt,name=valid_to_timestamp,json=validToTimestamp,proto3" json:"valid_to_timestamp,omitempty"`
+	IdempotencyKey     string `protobuf:"bytes,6,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CreatePriceListRequest) Reset() {
+	*x = CreatePriceListRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePriceListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePriceListRequest) ProtoMessage() {}
+
+func (x *CreatePriceListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePriceListRequest.ProtoReflect.Descriptor instead.
+func (*CreatePriceListRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *CreatePriceListRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreatePriceListRequest) GetCustomerGroup() string {
+	if x != nil {
+		return x.CustomerGroup
+	}
+	return ""
+}
+
+func (x *CreatePriceListRequest) GetPriority() int64 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *CreatePriceListRequest) GetValidFromTimestamp() int64 {
+	if x != nil {
+		return x.ValidFromTimestamp
+	}
+	return 0
+}
+
+func (x *CreatePriceListRequest) GetValidToTimestamp() int64 {
+	if x != nil {
+		return x.ValidToTimestamp
+	}
+	return 0
+}
+
+func (x *CreatePriceListRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type CreatePriceListReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PriceListId   string                 `protobuf:"bytes,1,opt,name=price_list_id,json=priceListId,proto3" json:"price_list_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePriceListReply) Reset() {
+	*x = CreatePriceListReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePriceListReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePriceListReply) ProtoMessage() {}
+
+func (x *CreatePriceListReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePriceListReply.ProtoReflect.Descriptor instead.
+func (*CreatePriceListReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *CreatePriceListReply) GetPriceListId() string {
+	if x != nil {
+		return x.PriceListId
+	}
+	return ""
+}
+
+// UpdatePriceListRequest replaces the list's name, priority and validity;
+// its customer group cannot change.
+type UpdatePriceListRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	PriceListId        string                 `protobuf:"bytes,1,opt,name=price_list_id,json=priceListId,proto3" json:"price_list_id,omitempty"`
+	Name               string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Priority           int64                  `protobuf:"varint,3,opt,name=priority,proto3" json:"priority,omitempty"`
+	ValidFromTimestamp int64                  `protobuf:"varint,4,opt,name=valid_from_timestamp,json=validFromTimestamp,proto3" json:"valid_from_timestamp,omitempty"`
+	ValidToTimestamp   int64                  `protobuf:"varint,5,opt,name=valid_to_timestamp,json=validToTimestamp,proto3" json:"valid_to_timestamp,omitempty"`
+	ExpectedVersion    int64                  `protobuf:"varint,6,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	IdempotencyKey     string                 `protobuf:"bytes,7,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *UpdatePriceListRequest) Reset() {
+	*x = UpdatePriceListRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePriceListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePriceListRequest) ProtoMessage() {}
+
+func (x *UpdatePriceListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePriceListRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePriceListRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *UpdatePriceListRequest) GetPriceListId() string {
+	if x != nil {
+		return x.PriceListId
+	}
+	return ""
+}
+
+func (x *UpdatePriceListRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdatePriceListRequest) GetPriority() int64 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *UpdatePriceListRequest) GetValidFromTimestamp() int64 {
+	if x != nil {
+		return x.ValidFromTimestamp
+	}
+	return 0
+}
+
+func (x *UpdatePriceListRequest) GetValidToTimestamp() int64 {
+	if x != nil {
+		return x.ValidToTimestamp
+	}
+	return 0
+}
+
+func (x *UpdatePriceListRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+func (x *UpdatePriceListRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type UpdatePriceListReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePriceListReply) Reset() {
+	*x = UpdatePriceListReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePriceListReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePriceListReply) ProtoMessage() {}
+
+func (x *UpdatePriceListReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePriceListReply.ProtoReflect.Descriptor instead.
+func (*UpdatePriceListReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{64}
+}
+
+// SetPriceListEntryRequest adds or replaces the list's price for a product
+// in a currency, in place of the product's own price there.
+type SetPriceListEntryRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	PriceListId      string                 `protobuf:"bytes,1,opt,name=price_list_id,json=priceListId,proto3" json:"price_list_id,omitempty"`
+	ProductId        string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Currency         string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	PriceNumerator   int64                  `protobuf:"varint,4,opt,name=price_numerator,json=priceNumerator,proto3" json:"price_numerator,omitempty"`
+	PriceDenominator int64                  `protobuf:"varint,5,opt,name=price_denominator,json=priceDenominator,proto3" json:"price_denominator,omitempty"`
+	ExpectedVersion  int64                  `protobuf:"varint,6,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	IdempotencyKey   string                 `protobuf:"bytes,7,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SetPriceListEntryRequest) Reset() {
+	*x = SetPriceListEntryRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPriceListEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPriceListEntryRequest) ProtoMessage() {}
+
+func (x *SetPriceListEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPriceListEntryRequest.ProtoReflect.Descriptor instead.
+func (*SetPriceListEntryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *SetPriceListEntryRequest) GetPriceListId() string {
+	if x != nil {
+		return x.PriceListId
+	}
+	return ""
+}
+
+func (x *SetPriceListEntryRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *SetPriceListEntryRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *SetPriceListEntryRequest) GetPriceNumerator() int64 {
+	if x != nil {
+		return x.PriceNumerator
+	}
+	return 0
+}
+
+func (x *SetPriceListEntryRequest) GetPriceDenominator() int64 {
+	if x != nil {
+		return x.PriceDenominator
+	}
+	return 0
+}
+
+func (x *SetPriceListEntryRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+func (x *SetPriceListEntryRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type SetPriceListEntryReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPriceListEntryReply) Reset() {
+	*x = SetPriceListEntryReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Discount) String() string {
+func (x *SetPriceListEntryReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Discount) ProtoMessage() {}
+func (*SetPriceListEntryReply) ProtoMessage() {}
 
-func (x *Discount) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_v1_product_service_proto_msgTypes[14]
+func (x *SetPriceListEntryReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -777,63 +4836,108 @@ func (x *Discount) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Discount.ProtoReflect.Descriptor instead.
-func (*Discount) Descriptor() ([]byte, []int) {
-	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use SetPriceListEntryReply.ProtoReflect.Descriptor instead.
+func (*SetPriceListEntryReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *Discount) GetPercentNumerator() int64 {
+type RemovePriceListEntryRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PriceListId     string                 `protobuf:"bytes,1,opt,name=price_list_id,json=priceListId,proto3" json:"price_list_id,omitempty"`
+	ProductId       string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Currency        string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	ExpectedVersion int64                  `protobuf:"varint,4,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	IdempotencyKey  string                 `protobuf:"bytes,5,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RemovePriceListEntryRequest) Reset() {
+	*x = RemovePriceListEntryRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemovePriceListEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemovePriceListEntryRequest) ProtoMessage() {}
+
+func (x *RemovePriceListEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[67]
 	if x != nil {
-		return x.PercentNumerator
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Discount) GetPercentDenominator() int64 {
+// Deprecated: Use RemovePriceListEntryRequest.ProtoReflect.Descriptor instead.
+func (*RemovePriceListEntryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *RemovePriceListEntryRequest) GetPriceListId() string {
 	if x != nil {
-		return x.PercentDenominator
+		return x.PriceListId
 	}
-	return 0
+	return ""
 }
 
-func (x *Discount) GetStartTimestamp() int64 {
+func (x *RemovePriceListEntryRequest) GetProductId() string {
 	if x != nil {
-		return x.StartTimestamp
+		return x.ProductId
 	}
-	return 0
+	return ""
 }
 
-func (x *Discount) GetEndTimestamp() int64 {
+func (x *RemovePriceListEntryRequest) GetCurrency() string {
 	if x != nil {
-		return x.EndTimestamp
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *RemovePriceListEntryRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
 	}
 	return 0
 }
 
-type ListProductsRequest struct {
+func (x *RemovePriceListEntryRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type RemovePriceListEntryReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Category      string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
-	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListProductsRequest) Reset() {
-	*x = ListProductsRequest{}
-	mi := &file_proto_product_v1_product_service_proto_msgTypes[15]
+func (x *RemovePriceListEntryReply) Reset() {
+	*x = RemovePriceListEntryReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListProductsRequest) String() string {
+func (x *RemovePriceListEntryReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListProductsRequest) ProtoMessage() {}
+func (*RemovePriceListEntryReply) ProtoMessage() {}
 
-func (x *ListProductsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_v1_product_service_proto_msgTypes[15]
+func (x *RemovePriceListEntryReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -844,55 +4948,212 @@ func (x *ListProductsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListProductsRequest.ProtoReflect.Descriptor instead.
-func (*ListProductsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use RemovePriceListEntryReply.ProtoReflect.Descriptor instead.
+func (*RemovePriceListEntryReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *ListProductsRequest) GetCategory() string {
+type ListExchangeRatesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Either may be empty to match any currency.
+	FromCurrency  string `protobuf:"bytes,1,opt,name=from_currency,json=fromCurrency,proto3" json:"from_currency,omitempty"`
+	ToCurrency    string `protobuf:"bytes,2,opt,name=to_currency,json=toCurrency,proto3" json:"to_currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExchangeRatesRequest) Reset() {
+	*x = ListExchangeRatesRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExchangeRatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExchangeRatesRequest) ProtoMessage() {}
+
+func (x *ListExchangeRatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[69]
 	if x != nil {
-		return x.Category
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExchangeRatesRequest.ProtoReflect.Descriptor instead.
+func (*ListExchangeRatesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ListExchangeRatesRequest) GetFromCurrency() string {
+	if x != nil {
+		return x.FromCurrency
 	}
 	return ""
 }
 
-func (x *ListProductsRequest) GetPageSize() int32 {
+func (x *ListExchangeRatesRequest) GetToCurrency() string {
+	if x != nil {
+		return x.ToCurrency
+	}
+	return ""
+}
+
+type ListExchangeRatesReply struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Newest first within each pair.
+	Rates         []*ExchangeRate `protobuf:"bytes,1,rep,name=rates,proto3" json:"rates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExchangeRatesReply) Reset() {
+	*x = ListExchangeRatesReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExchangeRatesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExchangeRatesReply) ProtoMessage() {}
+
+func (x *ListExchangeRatesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExchangeRatesReply.ProtoReflect.Descriptor instead.
+func (*ListExchangeRatesReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ListExchangeRatesReply) GetRates() []*ExchangeRate {
+	if x != nil {
+		return x.Rates
+	}
+	return nil
+}
+
+type ListPriceHistoryRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// Empty lists every currency the product has been priced in.
+	Currency string `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	// Unix seconds; only intervals that had not ended by then are listed, so
+	// the first is the price in effect at that moment. 0 lists them all.
+	SinceTimestamp int64  `protobuf:"varint,3,opt,name=since_timestamp,json=sinceTimestamp,proto3" json:"since_timestamp,omitempty"`
+	PageSize       int32  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken      string `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListPriceHistoryRequest) Reset() {
+	*x = ListPriceHistoryRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPriceHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPriceHistoryRequest) ProtoMessage() {}
+
+func (x *ListPriceHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPriceHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ListPriceHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ListPriceHistoryRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ListPriceHistoryRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *ListPriceHistoryRequest) GetSinceTimestamp() int64 {
+	if x != nil {
+		return x.SinceTimestamp
+	}
+	return 0
+}
+
+func (x *ListPriceHistoryRequest) GetPageSize() int32 {
 	if x != nil {
 		return x.PageSize
 	}
 	return 0
 }
 
-func (x *ListProductsRequest) GetPageToken() string {
+func (x *ListPriceHistoryRequest) GetPageToken() string {
 	if x != nil {
 		return x.PageToken
 	}
 	return ""
 }
 
-type ListProductsReply struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Products      []*ProductInfo         `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
-	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+type ListPriceHistoryReply struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Ordered by currency, then oldest first.
+	Intervals     []*PriceInterval `protobuf:"bytes,1,rep,name=intervals,proto3" json:"intervals,omitempty"`
+	NextPageToken string           `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListProductsReply) Reset() {
-	*x = ListProductsReply{}
-	mi := &file_proto_product_v1_product_service_proto_msgTypes[16]
+func (x *ListPriceHistoryReply) Reset() {
+	*x = ListPriceHistoryReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListProductsReply) String() string {
+func (x *ListPriceHistoryReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListProductsReply) ProtoMessage() {}
+func (*ListPriceHistoryReply) ProtoMessage() {}
 
-func (x *ListProductsReply) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_v1_product_service_proto_msgTypes[16]
+func (x *ListPriceHistoryReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -903,50 +5164,53 @@ func (x *ListProductsReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListProductsReply.ProtoReflect.Descriptor instead.
-func (*ListProductsReply) Descriptor() ([]byte, []int) {
-	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use ListPriceHistoryReply.ProtoReflect.Descriptor instead.
+func (*ListPriceHistoryReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *ListProductsReply) GetProducts() []*ProductInfo {
+func (x *ListPriceHistoryReply) GetIntervals() []*PriceInterval {
 	if x != nil {
-		return x.Products
+		return x.Intervals
 	}
 	return nil
 }
 
-func (x *ListProductsReply) GetNextPageToken() string {
+func (x *ListPriceHistoryReply) GetNextPageToken() string {
 	if x != nil {
 		return x.NextPageToken
 	}
 	return ""
 }
 
-type ProductInfo struct {
+// PriceInterval is a span during which the product's price, with whatever
+// discounts applied, stayed the same.
+type PriceInterval struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Category      string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
-	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Currency      string                 `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	FromTimestamp int64                  `protobuf:"varint,2,opt,name=from_timestamp,json=fromTimestamp,proto3" json:"from_timestamp,omitempty"`
+	// 0 while the price still holds.
+	ToTimestamp   int64           `protobuf:"varint,3,opt,name=to_timestamp,json=toTimestamp,proto3" json:"to_timestamp,omitempty"`
+	Price         *PriceBreakdown `protobuf:"bytes,4,opt,name=price,proto3" json:"price,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProductInfo) Reset() {
-	*x = ProductInfo{}
-	mi := &file_proto_product_v1_product_service_proto_msgTypes[17]
+func (x *PriceInterval) Reset() {
+	*x = PriceInterval{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProductInfo) String() string {
+func (x *PriceInterval) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProductInfo) ProtoMessage() {}
+func (*PriceInterval) ProtoMessage() {}
 
-func (x *ProductInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_v1_product_service_proto_msgTypes[17]
+func (x *PriceInterval) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -957,37 +5221,37 @@ func (x *ProductInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProductInfo.ProtoReflect.Descriptor instead.
-func (*ProductInfo) Descriptor() ([]byte, []int) {
-	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use PriceInterval.ProtoReflect.Descriptor instead.
+func (*PriceInterval) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *ProductInfo) GetProductId() string {
+func (x *PriceInterval) GetCurrency() string {
 	if x != nil {
-		return x.ProductId
+		return x.Currency
 	}
 	return ""
 }
 
-func (x *ProductInfo) GetName() string {
+func (x *PriceInterval) GetFromTimestamp() int64 {
 	if x != nil {
-		return x.Name
+		return x.FromTimestamp
 	}
-	return ""
+	return 0
 }
 
-func (x *ProductInfo) GetCategory() string {
+func (x *PriceInterval) GetToTimestamp() int64 {
 	if x != nil {
-		return x.Category
+		return x.ToTimestamp
 	}
-	return ""
+	return 0
 }
 
-func (x *ProductInfo) GetStatus() string {
+func (x *PriceInterval) GetPrice() *PriceBreakdown {
 	if x != nil {
-		return x.Status
+		return x.Price
 	}
-	return ""
+	return nil
 }
 
 var File_proto_product_v1_product_service_proto protoreflect.FileDescriptor
@@ -995,46 +5259,200 @@ var File_proto_product_v1_product_service_proto protoreflect.FileDescriptor
 const file_proto_product_v1_product_service_proto_rawDesc = "" +
 	"\n" +
 	"&proto/product/v1/product_service.proto\x12\n" +
-	"product.v1\"\xd0\x01\n" +
+	"product.v1\"\xbb\x02\n" +
 	"\x14CreateProductRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x1a\n" +
 	"\bcategory\x18\x03 \x01(\tR\bcategory\x120\n" +
 	"\x14base_price_numerator\x18\x04 \x01(\x03R\x12basePriceNumerator\x124\n" +
-	"\x16base_price_denominator\x18\x05 \x01(\x03R\x14basePriceDenominator\"3\n" +
+	"\x16base_price_denominator\x18\x05 \x01(\x03R\x14basePriceDenominator\x12'\n" +
+	"\x0fidempotency_key\x18\x06 \x01(\tR\x0eidempotencyKey\x12\x1a\n" +
+	"\bcurrency\x18\a \x01(\tR\bcurrency\x12\x10\n" +
+	"\x03sku\x18\b \x01(\tR\x03sku\x12\x12\n" +
+	"\x04gtin\x18\t \x01(\tR\x04gtin\"3\n" +
 	"\x12CreateProductReply\x12\x1d\n" +
 	"\n" +
-	"product_id\x18\x01 \x01(\tR\tproductId\"\x87\x01\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\"\xdb\x01\n" +
 	"\x14UpdateProductRequest\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\tR\tproductId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
 	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1a\n" +
-	"\bcategory\x18\x04 \x01(\tR\bcategory\"\x14\n" +
-	"\x12UpdateProductReply\"7\n" +
+	"\bcategory\x18\x04 \x01(\tR\bcategory\x12)\n" +
+	"\x10expected_version\x18\x05 \x01(\x03R\x0fexpectedVersion\x12'\n" +
+	"\x0fidempotency_key\x18\x06 \x01(\tR\x0eidempotencyKey\"\x14\n" +
+	"\x12UpdateProductReply\"\x8b\x01\n" +
 	"\x16ActivateProductRequest\x12\x1d\n" +
 	"\n" +
-	"product_id\x18\x01 \x01(\tR\tproductId\"\x16\n" +
-	"\x14ActivateProductReply\"9\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12)\n" +
+	"\x10expected_version\x18\x02 \x01(\x03R\x0fexpectedVersion\x12'\n" +
+	"\x0fidempotency_key\x18\x03 \x01(\tR\x0eidempotencyKey\"\x16\n" +
+	"\x14ActivateProductReply\"\x8d\x01\n" +
 	"\x18DeactivateProductRequest\x12\x1d\n" +
 	"\n" +
-	"product_id\x18\x01 \x01(\tR\tproductId\"\x18\n" +
-	"\x16DeactivateProductReply\"\xe1\x01\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12)\n" +
+	"\x10expected_version\x18\x02 \x01(\x03R\x0fexpectedVersion\x12'\n" +
+	"\x0fidempotency_key\x18\x03 \x01(\tR\x0eidempotencyKey\"\x18\n" +
+	"\x16DeactivateProductReply\"\x8a\x01\n" +
+	"\x15ArchiveProductRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12)\n" +
+	"\x10expected_version\x18\x02 \x01(\x03R\x0fexpectedVersion\x12'\n" +
+	"\x0fidempotency_key\x18\x03 \x01(\tR\x0eidempotencyKey\"\x15\n" +
+	"\x13ArchiveProductReply\"\x8a\x01\n" +
+	"\x15RestoreProductRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12)\n" +
+	"\x10expected_version\x18\x02 \x01(\x03R\x0fexpectedVersion\x12'\n" +
+	"\x0fidempotency_key\x18\x03 \x01(\tR\x0eidempotencyKey\"\x15\n" +
+	"\x13RestoreProductReply\"\x84\x04\n" +
 	"\x14ApplyDiscountRequest\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\tR\tproductId\x12+\n" +
 	"\x11percent_numerator\x18\x02 \x01(\x03R\x10percentNumerator\x12/\n" +
 	"\x13percent_denominator\x18\x03 \x01(\x03R\x12percentDenominator\x12'\n" +
 	"\x0fstart_timestamp\x18\x04 \x01(\x03R\x0estartTimestamp\x12#\n" +
-	"\rend_timestamp\x18\x05 \x01(\x03R\fendTimestamp\"\x14\n" +
-	"\x12ApplyDiscountReply\"6\n" +
+	"\rend_timestamp\x18\x05 \x01(\x03R\fendTimestamp\x12)\n" +
+	"\x10expected_version\x18\x06 \x01(\x03R\x0fexpectedVersion\x12'\n" +
+	"\x0fidempotency_key\x18\a \x01(\tR\x0eidempotencyKey\x12\x1a\n" +
+	"\bpriority\x18\b \x01(\x03R\bpriority\x12\x1a\n" +
+	"\bstacking\x18\t \x01(\tR\bstacking\x12\x12\n" +
+	"\x04kind\x18\n" +
+	" \x01(\tR\x04kind\x12)\n" +
+	"\x10amount_numerator\x18\v \x01(\x03R\x0famountNumerator\x12-\n" +
+	"\x12amount_denominator\x18\f \x01(\x03R\x11amountDenominator\x12'\n" +
+	"\x0famount_currency\x18\r \x01(\tR\x0eamountCurrency\"5\n" +
+	"\x12ApplyDiscountReply\x12\x1f\n" +
+	"\vdiscount_id\x18\x01 \x01(\tR\n" +
+	"discountId\"\xab\x01\n" +
 	"\x15RemoveDiscountRequest\x12\x1d\n" +
 	"\n" +
-	"product_id\x18\x01 \x01(\tR\tproductId\"\x15\n" +
-	"\x13RemoveDiscountReply\"2\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12)\n" +
+	"\x10expected_version\x18\x02 \x01(\x03R\x0fexpectedVersion\x12'\n" +
+	"\x0fidempotency_key\x18\x03 \x01(\tR\x0eidempotencyKey\x12\x1f\n" +
+	"\vdiscount_id\x18\x04 \x01(\tR\n" +
+	"discountId\"\x15\n" +
+	"\x13RemoveDiscountReply\"\xfd\x01\n" +
+	"\x16SetProductPriceRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12'\n" +
+	"\x0fprice_numerator\x18\x03 \x01(\x03R\x0epriceNumerator\x12+\n" +
+	"\x11price_denominator\x18\x04 \x01(\x03R\x10priceDenominator\x12)\n" +
+	"\x10expected_version\x18\x05 \x01(\x03R\x0fexpectedVersion\x12'\n" +
+	"\x0fidempotency_key\x18\x06 \x01(\tR\x0eidempotencyKey\"\x16\n" +
+	"\x14SetProductPriceReply\"\xaa\x01\n" +
+	"\x19RemoveProductPriceRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12)\n" +
+	"\x10expected_version\x18\x03 \x01(\x03R\x0fexpectedVersion\x12'\n" +
+	"\x0fidempotency_key\x18\x04 \x01(\tR\x0eidempotencyKey\"\x19\n" +
+	"\x17RemoveProductPriceReply\"\xf3\x01\n" +
+	"\x16ChangeBasePriceRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x120\n" +
+	"\x14base_price_numerator\x18\x02 \x01(\x03R\x12basePriceNumerator\x124\n" +
+	"\x16base_price_denominator\x18\x03 \x01(\x03R\x14basePriceDenominator\x12)\n" +
+	"\x10expected_version\x18\x04 \x01(\x03R\x0fexpectedVersion\x12'\n" +
+	"\x0fidempotency_key\x18\x05 \x01(\tR\x0eidempotencyKey\"\x16\n" +
+	"\x14ChangeBasePriceReply\"\xab\x01\n" +
+	"\x19SetProductTaxClassRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1b\n" +
+	"\ttax_class\x18\x02 \x01(\tR\btaxClass\x12)\n" +
+	"\x10expected_version\x18\x03 \x01(\x03R\x0fexpectedVersion\x12'\n" +
+	"\x0fidempotency_key\x18\x04 \x01(\tR\x0eidempotencyKey\"\x19\n" +
+	"\x17SetProductTaxClassReply\"\xb7\x01\n" +
+	"\x1cSetProductIdentifiersRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x10\n" +
+	"\x03sku\x18\x02 \x01(\tR\x03sku\x12\x12\n" +
+	"\x04gtin\x18\x03 \x01(\tR\x04gtin\x12)\n" +
+	"\x10expected_version\x18\x04 \x01(\x03R\x0fexpectedVersion\x12'\n" +
+	"\x0fidempotency_key\x18\x05 \x01(\tR\x0eidempotencyKey\"\x1c\n" +
+	"\x1aSetProductIdentifiersReply\"\xb6\x01\n" +
+	"\x14SetPriceTiersRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12+\n" +
+	"\x05tiers\x18\x02 \x03(\v2\x15.product.v1.PriceTierR\x05tiers\x12)\n" +
+	"\x10expected_version\x18\x03 \x01(\x03R\x0fexpectedVersion\x12'\n" +
+	"\x0fidempotency_key\x18\x04 \x01(\tR\x0eidempotencyKey\"\x14\n" +
+	"\x12SetPriceTiersReply\"\xf4\x01\n" +
+	"\tPriceTier\x12!\n" +
+	"\fmin_quantity\x18\x01 \x01(\x03R\vminQuantity\x12!\n" +
+	"\fmax_quantity\x18\x02 \x01(\x03R\vmaxQuantity\x120\n" +
+	"\x14unit_price_numerator\x18\x03 \x01(\x03R\x12unitPriceNumerator\x124\n" +
+	"\x16unit_price_denominator\x18\x04 \x01(\x03R\x14unitPriceDenominator\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\x12\x1d\n" +
+	"\n" +
+	"unit_price\x18\x06 \x01(\tR\tunitPrice\"\x94\x03\n" +
+	"\x11AddVariantRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x10\n" +
+	"\x03sku\x18\x02 \x01(\tR\x03sku\x12M\n" +
+	"\n" +
+	"attributes\x18\x03 \x03(\v2-.product.v1.AddVariantRequest.AttributesEntryR\n" +
+	"attributes\x12'\n" +
+	"\x0fprice_numerator\x18\x04 \x01(\x03R\x0epriceNumerator\x12+\n" +
+	"\x11price_denominator\x18\x05 \x01(\x03R\x10priceDenominator\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12)\n" +
+	"\x10expected_version\x18\a \x01(\x03R\x0fexpectedVersion\x12'\n" +
+	"\x0fidempotency_key\x18\b \x01(\tR\x0eidempotencyKey\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"0\n" +
+	"\x0fAddVariantReply\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\x01 \x01(\tR\tvariantId\"\xb9\x03\n" +
+	"\x14UpdateVariantRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\x02 \x01(\tR\tvariantId\x12\x10\n" +
+	"\x03sku\x18\x03 \x01(\tR\x03sku\x12P\n" +
+	"\n" +
+	"attributes\x18\x04 \x03(\v20.product.v1.UpdateVariantRequest.AttributesEntryR\n" +
+	"attributes\x12'\n" +
+	"\x0fprice_numerator\x18\x05 \x01(\x03R\x0epriceNumerator\x12+\n" +
+	"\x11price_denominator\x18\x06 \x01(\x03R\x10priceDenominator\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12)\n" +
+	"\x10expected_version\x18\b \x01(\x03R\x0fexpectedVersion\x12'\n" +
+	"\x0fidempotency_key\x18\t \x01(\tR\x0eidempotencyKey\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x14\n" +
+	"\x12UpdateVariantReply\"\xa8\x01\n" +
+	"\x14RemoveVariantRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\x02 \x01(\tR\tvariantId\x12)\n" +
+	"\x10expected_version\x18\x03 \x01(\x03R\x0fexpectedVersion\x12'\n" +
+	"\x0fidempotency_key\x18\x04 \x01(\tR\x0eidempotencyKey\"\x14\n" +
+	"\x12RemoveVariantReply\"\xde\x02\n" +
+	"\aVariant\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\x01 \x01(\tR\tvariantId\x12\x10\n" +
+	"\x03sku\x18\x02 \x01(\tR\x03sku\x12C\n" +
+	"\n" +
+	"attributes\x18\x03 \x03(\v2#.product.v1.Variant.AttributesEntryR\n" +
+	"attributes\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12'\n" +
+	"\x0fprice_numerator\x18\x05 \x01(\x03R\x0epriceNumerator\x12+\n" +
+	"\x11price_denominator\x18\x06 \x01(\x03R\x10priceDenominator\x12\x1a\n" +
+	"\bcurrency\x18\a \x01(\tR\bcurrency\x12\x14\n" +
+	"\x05price\x18\b \x01(\tR\x05price\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xb1\x01\n" +
 	"\x11GetProductRequest\x12\x1d\n" +
 	"\n" +
-	"product_id\x18\x01 \x01(\tR\tproductId\"\xc6\x02\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12\x16\n" +
+	"\x06region\x18\x03 \x01(\tR\x06region\x12\"\n" +
+	"\rprice_list_id\x18\x04 \x01(\tR\vpriceListId\x12%\n" +
+	"\x0ecustomer_group\x18\x05 \x01(\tR\rcustomerGroup\"\x91\a\n" +
 	"\x0fGetProductReply\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\tR\tproductId\x12\x12\n" +
@@ -1044,37 +5462,294 @@ const file_proto_product_v1_product_service_proto_rawDesc = "" +
 	"\x14base_price_numerator\x18\x05 \x01(\x03R\x12basePriceNumerator\x124\n" +
 	"\x16base_price_denominator\x18\x06 \x01(\x03R\x14basePriceDenominator\x12\x16\n" +
 	"\x06status\x18\a \x01(\tR\x06status\x125\n" +
-	"\bdiscount\x18\b \x01(\v2\x14.product.v1.DiscountH\x00R\bdiscount\x88\x01\x01B\v\n" +
-	"\t_discount\"\xb6\x01\n" +
+	"\bdiscount\x18\b \x01(\v2\x14.product.v1.DiscountH\x00R\bdiscount\x88\x01\x01\x12\x18\n" +
+	"\aversion\x18\t \x01(\x03R\aversion\x120\n" +
+	"\x05price\x18\n" +
+	" \x01(\v2\x1a.product.v1.PriceBreakdownR\x05price\x122\n" +
+	"\tdiscounts\x18\v \x03(\v2\x14.product.v1.DiscountR\tdiscounts\x12)\n" +
+	"\x06prices\x18\f \x03(\v2\x11.product.v1.PriceR\x06prices\x12\x1d\n" +
+	"\n" +
+	"base_price\x18\r \x01(\tR\tbasePrice\x129\n" +
+	"\flowest_price\x18\x0e \x01(\v2\x11.product.v1.PriceH\x01R\vlowestPrice\x88\x01\x01\x12*\n" +
+	"\x11lowest_price_days\x18\x0f \x01(\x05R\x0flowestPriceDays\x12\x1b\n" +
+	"\ttax_class\x18\x10 \x01(\tR\btaxClass\x12/\n" +
+	"\x03tax\x18\x11 \x01(\v2\x18.product.v1.TaxBreakdownH\x02R\x03tax\x88\x01\x01\x126\n" +
+	"\vprice_tiers\x18\x12 \x03(\v2\x15.product.v1.PriceTierR\n" +
+	"priceTiers\x12\"\n" +
+	"\rprice_list_id\x18\x13 \x01(\tR\vpriceListId\x12/\n" +
+	"\bvariants\x18\x14 \x03(\v2\x13.product.v1.VariantR\bvariants\x12\x10\n" +
+	"\x03sku\x18\x15 \x01(\tR\x03sku\x12\x12\n" +
+	"\x04gtin\x18\x16 \x01(\tR\x04gtinB\v\n" +
+	"\t_discountB\x0f\n" +
+	"\r_lowest_priceB\x06\n" +
+	"\x04_tax\"\xbd\x01\n" +
+	"\x16GetProductBySkuRequest\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\tR\x03sku\x12\x12\n" +
+	"\x04gtin\x18\x02 \x01(\tR\x04gtin\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x12\x16\n" +
+	"\x06region\x18\x04 \x01(\tR\x06region\x12\"\n" +
+	"\rprice_list_id\x18\x05 \x01(\tR\vpriceListId\x12%\n" +
+	"\x0ecustomer_group\x18\x06 \x01(\tR\rcustomerGroup\"\xb9\x01\n" +
+	"\x17BatchGetProductsRequest\x12\x1f\n" +
+	"\vproduct_ids\x18\x01 \x03(\tR\n" +
+	"productIds\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12\x16\n" +
+	"\x06region\x18\x03 \x01(\tR\x06region\x12\"\n" +
+	"\rprice_list_id\x18\x04 \x01(\tR\vpriceListId\x12%\n" +
+	"\x0ecustomer_group\x18\x05 \x01(\tR\rcustomerGroup\"P\n" +
+	"\x15BatchGetProductsReply\x127\n" +
+	"\bproducts\x18\x01 \x03(\v2\x1b.product.v1.GetProductReplyR\bproducts\"\xd5\x03\n" +
+	"\fTaxBreakdown\x12\x16\n" +
+	"\x06region\x18\x01 \x01(\tR\x06region\x12\x1b\n" +
+	"\ttax_class\x18\x02 \x01(\tR\btaxClass\x12\x12\n" +
+	"\x04mode\x18\x03 \x01(\tR\x04mode\x12%\n" +
+	"\x0erate_numerator\x18\x04 \x01(\x03R\rrateNumerator\x12)\n" +
+	"\x10rate_denominator\x18\x05 \x01(\x03R\x0frateDenominator\x12#\n" +
+	"\rnet_numerator\x18\x06 \x01(\x03R\fnetNumerator\x12'\n" +
+	"\x0fnet_denominator\x18\a \x01(\x03R\x0enetDenominator\x12#\n" +
+	"\rtax_numerator\x18\b \x01(\x03R\ftaxNumerator\x12'\n" +
+	"\x0ftax_denominator\x18\t \x01(\x03R\x0etaxDenominator\x12'\n" +
+	"\x0fgross_numerator\x18\n" +
+	" \x01(\x03R\x0egrossNumerator\x12+\n" +
+	"\x11gross_denominator\x18\v \x01(\x03R\x10grossDenominator\x12\x10\n" +
+	"\x03net\x18\f \x01(\tR\x03net\x12\x10\n" +
+	"\x03tax\x18\r \x01(\tR\x03tax\x12\x14\n" +
+	"\x05gross\x18\x0e \x01(\tR\x05gross\"{\n" +
+	"\x05Price\x12\x1a\n" +
+	"\bcurrency\x18\x01 \x01(\tR\bcurrency\x12\x1c\n" +
+	"\tnumerator\x18\x02 \x01(\x03R\tnumerator\x12 \n" +
+	"\vdenominator\x18\x03 \x01(\x03R\vdenominator\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\tR\x06amount\"\xee\x03\n" +
 	"\bDiscount\x12+\n" +
 	"\x11percent_numerator\x18\x01 \x01(\x03R\x10percentNumerator\x12/\n" +
 	"\x13percent_denominator\x18\x02 \x01(\x03R\x12percentDenominator\x12'\n" +
 	"\x0fstart_timestamp\x18\x03 \x01(\x03R\x0estartTimestamp\x12#\n" +
-	"\rend_timestamp\x18\x04 \x01(\x03R\fendTimestamp\"m\n" +
+	"\rend_timestamp\x18\x04 \x01(\x03R\fendTimestamp\x12\x16\n" +
+	"\x06active\x18\x05 \x01(\bR\x06active\x12\x1f\n" +
+	"\vdiscount_id\x18\x06 \x01(\tR\n" +
+	"discountId\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12\x1a\n" +
+	"\bpriority\x18\b \x01(\x03R\bpriority\x12\x1a\n" +
+	"\bstacking\x18\t \x01(\tR\bstacking\x12\x12\n" +
+	"\x04kind\x18\n" +
+	" \x01(\tR\x04kind\x12)\n" +
+	"\x10amount_numerator\x18\v \x01(\x03R\x0famountNumerator\x12-\n" +
+	"\x12amount_denominator\x18\f \x01(\x03R\x11amountDenominator\x12'\n" +
+	"\x0famount_currency\x18\r \x01(\tR\x0eamountCurrency\x12\x16\n" +
+	"\x06amount\x18\x0e \x01(\tR\x06amount\"\xbb\x03\n" +
+	"\x0ePriceBreakdown\x12%\n" +
+	"\x0ebase_numerator\x18\x01 \x01(\x03R\rbaseNumerator\x12)\n" +
+	"\x10base_denominator\x18\x02 \x01(\x03R\x0fbaseDenominator\x12:\n" +
+	"\x19discount_amount_numerator\x18\x03 \x01(\x03R\x17discountAmountNumerator\x12>\n" +
+	"\x1bdiscount_amount_denominator\x18\x04 \x01(\x03R\x19discountAmountDenominator\x12/\n" +
+	"\x13effective_numerator\x18\x05 \x01(\x03R\x12effectiveNumerator\x123\n" +
+	"\x15effective_denominator\x18\x06 \x01(\x03R\x14effectiveDenominator\x12\x1a\n" +
+	"\bcurrency\x18\a \x01(\tR\bcurrency\x12\x12\n" +
+	"\x04base\x18\b \x01(\tR\x04base\x12'\n" +
+	"\x0fdiscount_amount\x18\t \x01(\tR\x0ediscountAmount\x12\x1c\n" +
+	"\teffective\x18\n" +
+	" \x01(\tR\teffective\"\xec\x01\n" +
 	"\x13ListProductsRequest\x12\x1a\n" +
 	"\bcategory\x18\x01 \x01(\tR\bcategory\x12\x1b\n" +
 	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
-	"page_token\x18\x03 \x01(\tR\tpageToken\"p\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\x12\x16\n" +
+	"\x06region\x18\x05 \x01(\tR\x06region\x12\"\n" +
+	"\rprice_list_id\x18\x06 \x01(\tR\vpriceListId\x12%\n" +
+	"\x0ecustomer_group\x18\a \x01(\tR\rcustomerGroup\"p\n" +
 	"\x11ListProductsReply\x123\n" +
 	"\bproducts\x18\x01 \x03(\v2\x17.product.v1.ProductInfoR\bproducts\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"t\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x92\x05\n" +
 	"\vProductInfo\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\tR\tproductId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
 	"\bcategory\x18\x03 \x01(\tR\bcategory\x12\x16\n" +
-	"\x06status\x18\x04 \x01(\tR\x06status2\xb1\x05\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x120\n" +
+	"\x05price\x18\x05 \x01(\v2\x1a.product.v1.PriceBreakdownR\x05price\x125\n" +
+	"\bdiscount\x18\x06 \x01(\v2\x14.product.v1.DiscountH\x00R\bdiscount\x88\x01\x01\x122\n" +
+	"\tdiscounts\x18\a \x03(\v2\x14.product.v1.DiscountR\tdiscounts\x12)\n" +
+	"\x06prices\x18\b \x03(\v2\x11.product.v1.PriceR\x06prices\x129\n" +
+	"\flowest_price\x18\t \x01(\v2\x11.product.v1.PriceH\x01R\vlowestPrice\x88\x01\x01\x12*\n" +
+	"\x11lowest_price_days\x18\n" +
+	" \x01(\x05R\x0flowestPriceDays\x12\x1b\n" +
+	"\ttax_class\x18\v \x01(\tR\btaxClass\x12/\n" +
+	"\x03tax\x18\f \x01(\v2\x18.product.v1.TaxBreakdownH\x02R\x03tax\x88\x01\x01\x12\"\n" +
+	"\rprice_list_id\x18\r \x01(\tR\vpriceListId\x12/\n" +
+	"\bvariants\x18\x0e \x03(\v2\x13.product.v1.VariantR\bvariants\x12\x10\n" +
+	"\x03sku\x18\x0f \x01(\tR\x03sku\x12\x12\n" +
+	"\x04gtin\x18\x10 \x01(\tR\x04gtinB\v\n" +
+	"\t_discountB\x0f\n" +
+	"\r_lowest_priceB\x06\n" +
+	"\x04_tax\"\xf0\x01\n" +
+	"\x11QuotePriceRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12!\n" +
+	"\fat_timestamp\x18\x02 \x01(\x03R\vatTimestamp\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x12\x16\n" +
+	"\x06region\x18\x04 \x01(\tR\x06region\x12\x1a\n" +
+	"\bquantity\x18\x05 \x01(\x03R\bquantity\x12\"\n" +
+	"\rprice_list_id\x18\x06 \x01(\tR\vpriceListId\x12%\n" +
+	"\x0ecustomer_group\x18\a \x01(\tR\rcustomerGroup\"?\n" +
+	"\x0fQuotePriceReply\x12,\n" +
+	"\x05quote\x18\x01 \x01(\v2\x16.product.v1.PriceQuoteR\x05quote\"\xf8\x01\n" +
+	"\x17BatchQuotePricesRequest\x12\x1f\n" +
+	"\vproduct_ids\x18\x01 \x03(\tR\n" +
+	"productIds\x12!\n" +
+	"\fat_timestamp\x18\x02 \x01(\x03R\vatTimestamp\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x12\x16\n" +
+	"\x06region\x18\x04 \x01(\tR\x06region\x12\x1a\n" +
+	"\bquantity\x18\x05 \x01(\x03R\bquantity\x12\"\n" +
+	"\rprice_list_id\x18\x06 \x01(\tR\vpriceListId\x12%\n" +
+	"\x0ecustomer_group\x18\a \x01(\tR\rcustomerGroup\"G\n" +
+	"\x15BatchQuotePricesReply\x12.\n" +
+	"\x06quotes\x18\x01 \x03(\v2\x16.product.v1.PriceQuoteR\x06quotes\"\xa4\x04\n" +
+	"\n" +
+	"PriceQuote\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12!\n" +
+	"\fat_timestamp\x18\x02 \x01(\x03R\vatTimestamp\x120\n" +
+	"\x05price\x18\x03 \x01(\v2\x1a.product.v1.PriceBreakdownR\x05price\x12D\n" +
+	"\x10applied_discount\x18\x04 \x01(\v2\x14.product.v1.DiscountH\x00R\x0fappliedDiscount\x88\x01\x01\x12H\n" +
+	"\x11applied_discounts\x18\x05 \x03(\v2\x1b.product.v1.AppliedDiscountR\x10appliedDiscounts\x12B\n" +
+	"\rexchange_rate\x18\x06 \x01(\v2\x18.product.v1.ExchangeRateH\x01R\fexchangeRate\x88\x01\x01\x12/\n" +
+	"\x03tax\x18\a \x01(\v2\x18.product.v1.TaxBreakdownH\x02R\x03tax\x88\x01\x01\x12\x1a\n" +
+	"\bquantity\x18\b \x01(\x03R\bquantity\x12.\n" +
+	"\x04line\x18\t \x01(\v2\x1a.product.v1.PriceBreakdownR\x04line\x12\"\n" +
+	"\rprice_list_id\x18\n" +
+	" \x01(\tR\vpriceListIdB\x13\n" +
+	"\x11_applied_discountB\x10\n" +
+	"\x0e_exchange_rateB\x06\n" +
+	"\x04_tax\"\xb5\x01\n" +
+	"\x0fAppliedDiscount\x120\n" +
+	"\bdiscount\x18\x01 \x01(\v2\x14.product.v1.DiscountR\bdiscount\x12)\n" +
+	"\x10amount_numerator\x18\x02 \x01(\x03R\x0famountNumerator\x12-\n" +
+	"\x12amount_denominator\x18\x03 \x01(\x03R\x11amountDenominator\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\tR\x06amount\"\xd7\x01\n" +
+	"\fExchangeRate\x12#\n" +
+	"\rfrom_currency\x18\x01 \x01(\tR\ffromCurrency\x12\x1f\n" +
+	"\vto_currency\x18\x02 \x01(\tR\n" +
+	"toCurrency\x12%\n" +
+	"\x0erate_numerator\x18\x03 \x01(\x03R\rrateNumerator\x12)\n" +
+	"\x10rate_denominator\x18\x04 \x01(\x03R\x0frateDenominator\x12/\n" +
+	"\x13effective_timestamp\x18\x05 \x01(\x03R\x12effectiveTimestamp\"\x8a\x02\n" +
+	"\x16SetExchangeRateRequest\x12#\n" +
+	"\rfrom_currency\x18\x01 \x01(\tR\ffromCurrency\x12\x1f\n" +
+	"\vto_currency\x18\x02 \x01(\tR\n" +
+	"toCurrency\x12%\n" +
+	"\x0erate_numerator\x18\x03 \x01(\x03R\rrateNumerator\x12)\n" +
+	"\x10rate_denominator\x18\x04 \x01(\x03R\x0frateDenominator\x12/\n" +
+	"\x13effective_timestamp\x18\x05 \x01(\x03R\x12effectiveTimestamp\x12'\n" +
+	"\x0fidempotency_key\x18\x06 \x01(\tR\x0eidempotencyKey\"\x16\n" +
+	"\x14SetExchangeRateReply\"\xc3\x01\n" +
+	"\x11SetTaxRateRequest\x12\x16\n" +
+	"\x06region\x18\x01 \x01(\tR\x06region\x12\x1b\n" +
+	"\ttax_class\x18\x02 \x01(\tR\btaxClass\x12%\n" +
+	"\x0erate_numerator\x18\x03 \x01(\x03R\rrateNumerator\x12)\n" +
+	"\x10rate_denominator\x18\x04 \x01(\x03R\x0frateDenominator\x12'\n" +
+	"\x0fidempotency_key\x18\x05 \x01(\tR\x0eidempotencyKey\"\x11\n" +
+	"\x0fSetTaxRateReply\"~\n" +
+	"\x1aSetCategoryTaxClassRequest\x12\x1a\n" +
+	"\bcategory\x18\x01 \x01(\tR\bcategory\x12\x1b\n" +
+	"\ttax_class\x18\x02 \x01(\tR\btaxClass\x12'\n" +
+	"\x0fidempotency_key\x18\x03 \x01(\tR\x0eidempotencyKey\"\x1a\n" +
+	"\x18SetCategoryTaxClassReply\"\xf8\x01\n" +
+	"\x16CreatePriceListRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12%\n" +
+	"\x0ecustomer_group\x18\x02 \x01(\tR\rcustomerGroup\x12\x1a\n" +
+	"\bpriority\x18\x03 \x01(\x03R\bpriority\x120\n" +
+	"\x14valid_from_timestamp\x18\x04 \x01(\x03R\x12validFromTimestamp\x12,\n" +
+	"\x12valid_to_timestamp\x18\x05 \x01(\x03R\x10validToTimestamp\x12'\n" +
+	"\x0fidempotency_key\x18\x06 \x01(\tR\x0eidempotencyKey\":\n" +
+	"\x14CreatePriceListReply\x12\"\n" +
+	"\rprice_list_id\x18\x01 \x01(\tR\vpriceListId\"\xa0\x02\n" +
+	"\x16UpdatePriceListRequest\x12\"\n" +
+	"\rprice_list_id\x18\x01 \x01(\tR\vpriceListId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\bpriority\x18\x03 \x01(\x03R\bpriority\x120\n" +
+	"\x14valid_from_timestamp\x18\x04 \x01(\x03R\x12validFromTimestamp\x12,\n" +
+	"\x12valid_to_timestamp\x18\x05 \x01(\x03R\x10validToTimestamp\x12)\n" +
+	"\x10expected_version\x18\x06 \x01(\x03R\x0fexpectedVersion\x12'\n" +
+	"\x0fidempotency_key\x18\a \x01(\tR\x0eidempotencyKey\"\x16\n" +
+	"\x14UpdatePriceListReply\"\xa3\x02\n" +
+	"\x18SetPriceListEntryRequest\x12\"\n" +
+	"\rprice_list_id\x18\x01 \x01(\tR\vpriceListId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x12'\n" +
+	"\x0fprice_numerator\x18\x04 \x01(\x03R\x0epriceNumerator\x12+\n" +
+	"\x11price_denominator\x18\x05 \x01(\x03R\x10priceDenominator\x12)\n" +
+	"\x10expected_version\x18\x06 \x01(\x03R\x0fexpectedVersion\x12'\n" +
+	"\x0fidempotency_key\x18\a \x01(\tR\x0eidempotencyKey\"\x18\n" +
+	"\x16SetPriceListEntryReply\"\xd0\x01\n" +
+	"\x1bRemovePriceListEntryRequest\x12\"\n" +
+	"\rprice_list_id\x18\x01 \x01(\tR\vpriceListId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x12)\n" +
+	"\x10expected_version\x18\x04 \x01(\x03R\x0fexpectedVersion\x12'\n" +
+	"\x0fidempotency_key\x18\x05 \x01(\tR\x0eidempotencyKey\"\x1b\n" +
+	"\x19RemovePriceListEntryReply\"`\n" +
+	"\x18ListExchangeRatesRequest\x12#\n" +
+	"\rfrom_currency\x18\x01 \x01(\tR\ffromCurrency\x12\x1f\n" +
+	"\vto_currency\x18\x02 \x01(\tR\n" +
+	"toCurrency\"H\n" +
+	"\x16ListExchangeRatesReply\x12.\n" +
+	"\x05rates\x18\x01 \x03(\v2\x18.product.v1.ExchangeRateR\x05rates\"\xb9\x01\n" +
+	"\x17ListPriceHistoryRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12'\n" +
+	"\x0fsince_timestamp\x18\x03 \x01(\x03R\x0esinceTimestamp\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x05 \x01(\tR\tpageToken\"x\n" +
+	"\x15ListPriceHistoryReply\x127\n" +
+	"\tintervals\x18\x01 \x03(\v2\x19.product.v1.PriceIntervalR\tintervals\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xa7\x01\n" +
+	"\rPriceInterval\x12\x1a\n" +
+	"\bcurrency\x18\x01 \x01(\tR\bcurrency\x12%\n" +
+	"\x0efrom_timestamp\x18\x02 \x01(\x03R\rfromTimestamp\x12!\n" +
+	"\fto_timestamp\x18\x03 \x01(\x03R\vtoTimestamp\x120\n" +
+	"\x05price\x18\x04 \x01(\v2\x1a.product.v1.PriceBreakdownR\x05price2\x93\x16\n" +
 	"\x0eProductService\x12Q\n" +
 	"\rCreateProduct\x12 .product.v1.CreateProductRequest\x1a\x1e.product.v1.CreateProductReply\x12Q\n" +
 	"\rUpdateProduct\x12 .product.v1.UpdateProductRequest\x1a\x1e.product.v1.UpdateProductReply\x12W\n" +
 	"\x0fActivateProduct\x12\".product.v1.ActivateProductRequest\x1a .product.v1.ActivateProductReply\x12]\n" +
-	"\x11DeactivateProduct\x12$.product.v1.DeactivateProductRequest\x1a\".product.v1.DeactivateProductReply\x12Q\n" +
+	"\x11DeactivateProduct\x12$.product.v1.DeactivateProductRequest\x1a\".product.v1.DeactivateProductReply\x12T\n" +
+	"\x0eArchiveProduct\x12!.product.v1.ArchiveProductRequest\x1a\x1f.product.v1.ArchiveProductReply\x12T\n" +
+	"\x0eRestoreProduct\x12!.product.v1.RestoreProductRequest\x1a\x1f.product.v1.RestoreProductReply\x12Q\n" +
 	"\rApplyDiscount\x12 .product.v1.ApplyDiscountRequest\x1a\x1e.product.v1.ApplyDiscountReply\x12T\n" +
-	"\x0eRemoveDiscount\x12!.product.v1.RemoveDiscountRequest\x1a\x1f.product.v1.RemoveDiscountReply\x12H\n" +
+	"\x0eRemoveDiscount\x12!.product.v1.RemoveDiscountRequest\x1a\x1f.product.v1.RemoveDiscountReply\x12W\n" +
+	"\x0fSetProductPrice\x12\".product.v1.SetProductPriceRequest\x1a .product.v1.SetProductPriceReply\x12`\n" +
+	"\x12RemoveProductPrice\x12%.product.v1.RemoveProductPriceRequest\x1a#.product.v1.RemoveProductPriceReply\x12W\n" +
+	"\x0fChangeBasePrice\x12\".product.v1.ChangeBasePriceRequest\x1a .product.v1.ChangeBasePriceReply\x12`\n" +
+	"\x12SetProductTaxClass\x12%.product.v1.SetProductTaxClassRequest\x1a#.product.v1.SetProductTaxClassReply\x12i\n" +
+	"\x15SetProductIdentifiers\x12(.product.v1.SetProductIdentifiersRequest\x1a&.product.v1.SetProductIdentifiersReply\x12Q\n" +
+	"\rSetPriceTiers\x12 .product.v1.SetPriceTiersRequest\x1a\x1e.product.v1.SetPriceTiersReply\x12H\n" +
+	"\n" +
+	"AddVariant\x12\x1d.product.v1.AddVariantRequest\x1a\x1b.product.v1.AddVariantReply\x12Q\n" +
+	"\rUpdateVariant\x12 .product.v1.UpdateVariantRequest\x1a\x1e.product.v1.UpdateVariantReply\x12Q\n" +
+	"\rRemoveVariant\x12 .product.v1.RemoveVariantRequest\x1a\x1e.product.v1.RemoveVariantReply\x12H\n" +
+	"\n" +
+	"GetProduct\x12\x1d.product.v1.GetProductRequest\x1a\x1b.product.v1.GetProductReply\x12R\n" +
+	"\x0fGetProductBySku\x12\".product.v1.GetProductBySkuRequest\x1a\x1b.product.v1.GetProductReply\x12Z\n" +
+	"\x10BatchGetProducts\x12#.product.v1.BatchGetProductsRequest\x1a!.product.v1.BatchGetProductsReply\x12N\n" +
+	"\fListProducts\x12\x1f.product.v1.ListProductsRequest\x1a\x1d.product.v1.ListProductsReply\x12H\n" +
+	"\n" +
+	"QuotePrice\x12\x1d.product.v1.QuotePriceRequest\x1a\x1b.product.v1.QuotePriceReply\x12Z\n" +
+	"\x10BatchQuotePrices\x12#.product.v1.BatchQuotePricesRequest\x1a!.product.v1.BatchQuotePricesReply\x12Z\n" +
+	"\x10ListPriceHistory\x12#.product.v1.ListPriceHistoryRequest\x1a!.product.v1.ListPriceHistoryReply\x12W\n" +
+	"\x0fSetExchangeRate\x12\".product.v1.SetExchangeRateRequest\x1a .product.v1.SetExchangeRateReply\x12]\n" +
+	"\x11ListExchangeRates\x12$.product.v1.ListExchangeRatesRequest\x1a\".product.v1.ListExchangeRatesReply\x12H\n" +
 	"\n" +
-	"GetProduct\x12\x1d.product.v1.GetProductRequest\x1a\x1b.product.v1.GetProductReply\x12N\n" +
-	"\fListProducts\x12\x1f.product.v1.ListProductsRequest\x1a\x1d.product.v1.ListProductsReplyB4Z2product-catalog-service/proto/product/v1;productpbb\x06proto3"
+	"SetTaxRate\x12\x1d.product.v1.SetTaxRateRequest\x1a\x1b.product.v1.SetTaxRateReply\x12c\n" +
+	"\x13SetCategoryTaxClass\x12&.product.v1.SetCategoryTaxClassRequest\x1a$.product.v1.SetCategoryTaxClassReply\x12W\n" +
+	"\x0fCreatePriceList\x12\".product.v1.CreatePriceListRequest\x1a .product.v1.CreatePriceListReply\x12W\n" +
+	"\x0fUpdatePriceList\x12\".product.v1.UpdatePriceListRequest\x1a .product.v1.UpdatePriceListReply\x12]\n" +
+	"\x11SetPriceListEntry\x12$.product.v1.SetPriceListEntryRequest\x1a\".product.v1.SetPriceListEntryReply\x12f\n" +
+	"\x14RemovePriceListEntry\x12'.product.v1.RemovePriceListEntryRequest\x1a%.product.v1.RemovePriceListEntryReplyB4Z2product-catalog-service/proto/product/v1;productpbb\x06proto3"
 
 var (
 	file_proto_product_v1_product_service_proto_rawDescOnce sync.Once
@@ -1088,51 +5763,189 @@ func file_proto_product_v1_product_service_proto_rawDescGZIP() []byte {
 	return file_proto_product_v1_product_service_proto_rawDescData
 }
 
-var file_proto_product_v1_product_service_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_proto_product_v1_product_service_proto_msgTypes = make([]protoimpl.MessageInfo, 77)
 var file_proto_product_v1_product_service_proto_goTypes = []any{
-	(*CreateProductRequest)(nil),     // 0: product.v1.CreateProductRequest
-	(*CreateProductReply)(nil),       // 1: product.v1.CreateProductReply
-	(*UpdateProductRequest)(nil),     // 2: product.v1.UpdateProductRequest
-	(*UpdateProductReply)(nil),       // 3: product.v1.UpdateProductReply
-	(*ActivateProductRequest)(nil),   // 4: product.v1.ActivateProductRequest
-	(*ActivateProductReply)(nil),     // 5: product.v1.ActivateProductReply
-	(*DeactivateProductRequest)(nil), // 6: product.v1.DeactivateProductRequest
-	(*DeactivateProductReply)(nil),   // 7: product.v1.DeactivateProductReply
-	(*ApplyDiscountRequest)(nil),     // 8: product.v1.ApplyDiscountRequest
-	(*ApplyDiscountReply)(nil),       // 9: product.v1.ApplyDiscountReply
-	(*RemoveDiscountRequest)(nil),    // 10: product.v1.RemoveDiscountRequest
-	(*RemoveDiscountReply)(nil),      // 11: product.v1.RemoveDiscountReply
-	(*GetProductRequest)(nil),        // 12: product.v1.GetProductRequest
-	(*GetProductReply)(nil),          // 13: product.v1.GetProductReply
-	(*Discount)(nil),                 // 14: product.v1.Discount
-	(*ListProductsRequest)(nil),      // 15: product.v1.ListProductsRequest
-	(*ListProductsReply)(nil),        // 16: product.v1.ListProductsReply
-	(*ProductInfo)(nil),              // 17: product.v1.ProductInfo
+	(*CreateProductRequest)(nil),         // 0: product.v1.CreateProductRequest
+	(*CreateProductReply)(nil),           // 1: product.v1.CreateProductReply
+	(*UpdateProductRequest)(nil),         // 2: product.v1.UpdateProductRequest
+	(*UpdateProductReply)(nil),           // 3: product.v1.UpdateProductReply
+	(*ActivateProductRequest)(nil),       // 4: product.v1.ActivateProductRequest
+	(*ActivateProductReply)(nil),         // 5: product.v1.ActivateProductReply
+	(*DeactivateProductRequest)(nil),     // 6: product.v1.DeactivateProductRequest
+	(*DeactivateProductReply)(nil),       // 7: product.v1.DeactivateProductReply
+	(*ArchiveProductRequest)(nil),        // 8: product.v1.ArchiveProductRequest
+	(*ArchiveProductReply)(nil),          // 9: product.v1.ArchiveProductReply
+	(*RestoreProductRequest)(nil),        // 10: product.v1.RestoreProductRequest
+	(*RestoreProductReply)(nil),          // 11: product.v1.RestoreProductReply
+	(*ApplyDiscountRequest)(nil),         // 12: product.v1.ApplyDiscountRequest
+	(*ApplyDiscountReply)(nil),           // 13: product.v1.ApplyDiscountReply
+	(*RemoveDiscountRequest)(nil),        // 14: product.v1.RemoveDiscountRequest
+	(*RemoveDiscountReply)(nil),          // 15: product.v1.RemoveDiscountReply
+	(*SetProductPriceRequest)(nil),       // 16: product.v1.SetProductPriceRequest
+	(*SetProductPriceReply)(nil),         // 17: product.v1.SetProductPriceReply
+	(*RemoveProductPriceRequest)(nil),    // 18: product.v1.RemoveProductPriceRequest
+	(*RemoveProductPriceReply)(nil),      // 19: product.v1.RemoveProductPriceReply
+	(*ChangeBasePriceRequest)(nil),       // 20: product.v1.ChangeBasePriceRequest
+	(*ChangeBasePriceReply)(nil),         // 21: product.v1.ChangeBasePriceReply
+	(*SetProductTaxClassRequest)(nil),    // 22: product.v1.SetProductTaxClassRequest
+	(*SetProductTaxClassReply)(nil),      // 23: product.v1.SetProductTaxClassReply
+	(*SetProductIdentifiersRequest)(nil), // 24: product.v1.SetProductIdentifiersRequest
+	(*SetProductIdentifiersReply)(nil),   // 25: product.v1.SetProductIdentifiersReply
+	(*SetPriceTiersRequest)(nil),         // 26: product.v1.SetPriceTiersRequest
+	(*SetPriceTiersReply)(nil),           // 27: product.v1.SetPriceTiersReply
+	(*PriceTier)(nil),                    // 28: product.v1.PriceTier
+	(*AddVariantRequest)(nil),            // 29: product.v1.AddVariantRequest
+	(*AddVariantReply)(nil),              // 30: product.v1.AddVariantReply
+	(*UpdateVariantRequest)(nil),         // 31: product.v1.UpdateVariantRequest
+	(*UpdateVariantReply)(nil),           // 32: product.v1.UpdateVariantReply
+	(*RemoveVariantRequest)(nil),         // 33: product.v1.RemoveVariantRequest
+	(*RemoveVariantReply)(nil),           // 34: product.v1.RemoveVariantReply
+	(*Variant)(nil),                      // 35: product.v1.Variant
+	(*GetProductRequest)(nil),            // 36: product.v1.GetProductRequest
+	(*GetProductReply)(nil),              // 37: product.v1.GetProductReply
+	(*GetProductBySkuRequest)(nil),       // 38: product.v1.GetProductBySkuRequest
+	(*BatchGetProductsRequest)(nil),      // 39: product.v1.BatchGetProductsRequest
+	(*BatchGetProductsReply)(nil),        // 40: product.v1.BatchGetProductsReply
+	(*TaxBreakdown)(nil),                 // 41: product.v1.TaxBreakdown
+	(*Price)(nil),                        // 42: product.v1.Price
+	(*Discount)(nil),                     // 43: product.v1.Discount
+	(*PriceBreakdown)(nil),               // 44: product.v1.PriceBreakdown
+	(*ListProductsRequest)(nil),          // 45: product.v1.ListProductsRequest
+	(*ListProductsReply)(nil),            // 46: product.v1.ListProductsReply
+	(*ProductInfo)(nil),                  // 47: product.v1.ProductInfo
+	(*QuotePriceRequest)(nil),            // 48: product.v1.QuotePriceRequest
+	(*QuotePriceReply)(nil),              // 49: product.v1.QuotePriceReply
+	(*BatchQuotePricesRequest)(nil),      // 50: product.v1.BatchQuotePricesRequest
+	(*BatchQuotePricesReply)(nil),        // 51: product.v1.BatchQuotePricesReply
+	(*PriceQuote)(nil),                   // 52: product.v1.PriceQuote
+	(*AppliedDiscount)(nil),              // 53: product.v1.AppliedDiscount
+	(*ExchangeRate)(nil),                 // 54: product.v1.ExchangeRate
+	(*SetExchangeRateRequest)(nil),       // 55: product.v1.SetExchangeRateRequest
+	(*SetExchangeRateReply)(nil),         // 56: product.v1.SetExchangeRateReply
+	(*SetTaxRateRequest)(nil),            // 57: product.v1.SetTaxRateRequest
+	(*SetTaxRateReply)(nil),              // 58: product.v1.SetTaxRateReply
+	(*SetCategoryTaxClassRequest)(nil),   // 59: product.v1.SetCategoryTaxClassRequest
+	(*SetCategoryTaxClassReply)(nil),     // 60: product.v1.SetCategoryTaxClassReply
+	(*CreatePriceListRequest)(nil),       // 61: product.v1.CreatePriceListRequest
+	(*CreatePriceListReply)(nil),         // 62: product.v1.CreatePriceListReply
+	(*UpdatePriceListRequest)(nil),       // 63: product.v1.UpdatePriceListRequest
+	(*UpdatePriceListReply)(nil),         // 64: product.v1.UpdatePriceListReply
+	(*SetPriceListEntryRequest)(nil),     // 65: product.v1.SetPriceListEntryRequest
+	(*SetPriceListEntryReply)(nil),       // 66: product.v1.SetPriceListEntryReply
+	(*RemovePriceListEntryRequest)(nil),  // 67: product.v1.RemovePriceListEntryRequest
+	(*RemovePriceListEntryReply)(nil),    // 68: product.v1.RemovePriceListEntryReply
+	(*ListExchangeRatesRequest)(nil),     // 69: product.v1.ListExchangeRatesRequest
+	(*ListExchangeRatesReply)(nil),       // 70: product.v1.ListExchangeRatesReply
+	(*ListPriceHistoryRequest)(nil),      // 71: product.v1.ListPriceHistoryRequest
+	(*ListPriceHistoryReply)(nil),        // 72: product.v1.ListPriceHistoryReply
+	(*PriceInterval)(nil),                // 73: product.v1.PriceInterval
+	nil,                                  // 74: product.v1.AddVariantRequest.AttributesEntry
+	nil,                                  // 75: product.v1.UpdateVariantRequest.AttributesEntry
+	nil,                                  // 76: product.v1.Variant.AttributesEntry
 }
 var file_proto_product_v1_product_service_proto_depIdxs = []int32{
-	14, // 0: product.v1.GetProductReply.discount:type_name -> product.v1.Discount
-	17, // 1: product.v1.ListProductsReply.products:type_name -> product.v1.ProductInfo
-	0,  // 2: product.v1.ProductService.CreateProduct:input_type -> product.v1.CreateProductRequest
-	2,  // 3: product.v1.ProductService.UpdateProduct:input_type -> product.v1.UpdateProductRequest
-	4,  // 4: product.v1.ProductService.ActivateProduct:input_type -> product.v1.ActivateProductRequest
-	6,  // 5: product.v1.ProductService.DeactivateProduct:input_type -> product.v1.DeactivateProductRequest
-	8,  // 6: product.v1.ProductService.ApplyDiscount:input_type -> product.v1.ApplyDiscountRequest
-	10, // 7: product.v1.ProductService.RemoveDiscount:input_type -> product.v1.RemoveDiscountRequest
-	12, // 8: product.v1.ProductService.GetProduct:input_type -> product.v1.GetProductRequest
-	15, // 9: product.v1.ProductService.ListProducts:input_type -> product.v1.ListProductsRequest
-	1,  // 10: product.v1.ProductService.CreateProduct:output_type -> product.v1.CreateProductReply
-	3,  // 11: product.v1.ProductService.UpdateProduct:output_type -> product.v1.UpdateProductReply
-	5,  // 12: product.v1.ProductService.ActivateProduct:output_type -> product.v1.ActivateProductReply
-	7,  // 13: product.v1.ProductService.DeactivateProduct:output_type -> product.v1.DeactivateProductReply
-	9,  // 14: product.v1.ProductService.ApplyDiscount:output_type -> product.v1.ApplyDiscountReply
-	11, // 15: product.v1.ProductService.RemoveDiscount:output_type -> product.v1.RemoveDiscountReply
-	13, // 16: product.v1.ProductService.GetProduct:output_type -> product.v1.GetProductReply
-	16, // 17: product.v1.ProductService.ListProducts:output_type -> product.v1.ListProductsReply
-	10, // [10:18] is the sub-list for method output_type
-	2,  // [2:10] is the sub-list for method input_type
-	2,  // [2:2] is the sub-list for extension type_name
-	2,  // [2:2] is the sub-list for extension extendee
-	0,  // [0:2] is the sub-list for field type_name
+	28, // 0: product.v1.SetPriceTiersRequest.tiers:type_name -> product.v1.PriceTier
+	74, // 1: product.v1.AddVariantRequest.attributes:type_name -> product.v1.AddVariantRequest.AttributesEntry
+	75, // 2: product.v1.UpdateVariantRequest.attributes:type_name -> product.v1.UpdateVariantRequest.AttributesEntry
+	76, // 3: product.v1.Variant.attributes:type_name -> product.v1.Variant.AttributesEntry
+	43, // 4: product.v1.GetProductReply.discount:type_name -> product.v1.Discount
+	44, // 5: product.v1.GetProductReply.price:type_name -> product.v1.PriceBreakdown
+	43, // 6: product.v1.GetProductReply.discounts:type_name -> product.v1.Discount
+	42, // 7: product.v1.GetProductReply.prices:type_name -> product.v1.Price
+	42, // 8: product.v1.GetProductReply.lowest_price:type_name -> product.v1.Price
+	41, // 9: product.v1.GetProductReply.tax:type_name -> product.v1.TaxBreakdown
+	28, // 10: product.v1.GetProductReply.price_tiers:type_name -> product.v1.PriceTier
+	35, // 11: product.v1.GetProductReply.variants:type_name -> product.v1.Variant
+	37, // 12: product.v1.BatchGetProductsReply.products:type_name -> product.v1.GetProductReply
+	47, // 13: product.v1.ListProductsReply.products:type_name -> product.v1.ProductInfo
+	44, // 14: product.v1.ProductInfo.price:type_name -> product.v1.PriceBreakdown
+	43, // 15: product.v1.ProductInfo.discount:type_name -> product.v1.Discount
+	43, // 16: product.v1.ProductInfo.discounts:type_name -> product.v1.Discount
+	42, // 17: product.v1.ProductInfo.prices:type_name -> product.v1.Price
+	42, // 18: product.v1.ProductInfo.lowest_price:type_name -> product.v1.Price
+	41, // 19: product.v1.ProductInfo.tax:type_name -> product.v1.TaxBreakdown
+	35, // 20: product.v1.ProductInfo.variants:type_name -> product.v1.Variant
+	52, // 21: product.v1.QuotePriceReply.quote:type_name -> product.v1.PriceQuote
+	52, // 22: product.v1.BatchQuotePricesReply.quotes:type_name -> product.v1.PriceQuote
+	44, // 23: product.v1.PriceQuote.price:type_name -> product.v1.PriceBreakdown
+	43, // 24: product.v1.PriceQuote.applied_discount:type_name -> product.v1.Discount
+	53, // 25: product.v1.PriceQuote.applied_discounts:type_name -> product.v1.AppliedDiscount
+	54, // 26: product.v1.PriceQuote.exchange_rate:type_name -> product.v1.ExchangeRate
+	41, // 27: product.v1.PriceQuote.tax:type_name -> product.v1.TaxBreakdown
+	44, // 28: product.v1.PriceQuote.line:type_name -> product.v1.PriceBreakdown
+	43, // 29: product.v1.AppliedDiscount.discount:type_name -> product.v1.Discount
+	54, // 30: product.v1.ListExchangeRatesReply.rates:type_name -> product.v1.ExchangeRate
+	73, // 31: product.v1.ListPriceHistoryReply.intervals:type_name -> product.v1.PriceInterval
+	44, // 32: product.v1.PriceInterval.price:type_name -> product.v1.PriceBreakdown
+	0,  // 33: product.v1.ProductService.CreateProduct:input_type -> product.v1.CreateProductRequest
+	2,  // 34: product.v1.ProductService.UpdateProduct:input_type -> product.v1.UpdateProductRequest
+	4,  // 35: product.v1.ProductService.ActivateProduct:input_type -> product.v1.ActivateProductRequest
+	6,  // 36: product.v1.ProductService.DeactivateProduct:input_type -> product.v1.DeactivateProductRequest
+	8,  // 37: product.v1.ProductService.ArchiveProduct:input_type -> product.v1.ArchiveProductRequest
+	10, // 38: product.v1.ProductService.RestoreProduct:input_type -> product.v1.RestoreProductRequest
+	12, // 39: product.v1.ProductService.ApplyDiscount:input_type -> product.v1.ApplyDiscountRequest
+	14, // 40: product.v1.ProductService.RemoveDiscount:input_type -> product.v1.RemoveDiscountRequest
+	16, // 41: product.v1.ProductService.SetProductPrice:input_type -> product.v1.SetProductPriceRequest
+	18, // 42: product.v1.ProductService.RemoveProductPrice:input_type -> product.v1.RemoveProductPriceRequest
+	20, // 43: product.v1.ProductService.ChangeBasePrice:input_type -> product.v1.ChangeBasePriceRequest
+	22, // 44: product.v1.ProductService.SetProductTaxClass:input_type -> product.v1.SetProductTaxClassRequest
+	24, // 45: product.v1.ProductService.SetProductIdentifiers:input_type -> product.v1.SetProductIdentifiersRequest
+	26, // 46: product.v1.ProductService.SetPriceTiers:input_type -> product.v1.SetPriceTiersRequest
+	29, // 47: product.v1.ProductService.AddVariant:input_type -> product.v1.AddVariantRequest
+	31, // 48: product.v1.ProductService.UpdateVariant:input_type -> product.v1.UpdateVariantRequest
+	33, // 49: product.v1.ProductService.RemoveVariant:input_type -> product.v1.RemoveVariantRequest
+	36, // 50: product.v1.ProductService.GetProduct:input_type -> product.v1.GetProductRequest
+	38, // 51: product.v1.ProductService.GetProductBySku:input_type -> product.v1.GetProductBySkuRequest
+	39, // 52: product.v1.ProductService.BatchGetProducts:input_type -> product.v1.BatchGetProductsRequest
+	45, // 53: product.v1.ProductService.ListProducts:input_type -> product.v1.ListProductsRequest
+	48, // 54: product.v1.ProductService.QuotePrice:input_type -> product.v1.QuotePriceRequest
+	50, // 55: product.v1.ProductService.BatchQuotePrices:input_type -> product.v1.BatchQuotePricesRequest
+	71, // 56: product.v1.ProductService.ListPriceHistory:input_type -> product.v1.ListPriceHistoryRequest
+	55, // 57: product.v1.ProductService.SetExchangeRate:input_type -> product.v1.SetExchangeRateRequest
+	69, // 58: product.v1.ProductService.ListExchangeRates:input_type -> product.v1.ListExchangeRatesRequest
+	57, // 59: product.v1.ProductService.SetTaxRate:input_type -> product.v1.SetTaxRateRequest
+	59, // 60: product.v1.ProductService.SetCategoryTaxClass:input_type -> product.v1.SetCategoryTaxClassRequest
+	61, // 61: product.v1.ProductService.CreatePriceList:input_type -> product.v1.CreatePriceListRequest
+	63, // 62: product.v1.ProductService.UpdatePriceList:input_type -> product.v1.UpdatePriceListRequest
+	65, // 63: product.v1.ProductService.SetPriceListEntry:input_type -> product.v1.SetPriceListEntryRequest
+	67, // 64: product.v1.ProductService.RemovePriceListEntry:input_type -> product.v1.RemovePriceListEntryRequest
+	1,  // 65: product.v1.ProductService.CreateProduct:output_type -> product.v1.CreateProductReply
+	3,  // 66: product.v1.ProductService.UpdateProduct:output_type -> product.v1.UpdateProductReply
+	5,  // 67: product.v1.ProductService.ActivateProduct:output_type -> product.v1.ActivateProductReply
+	7,  // 68: product.v1.ProductService.DeactivateProduct:output_type -> product.v1.DeactivateProductReply
+	9,  // 69: product.v1.ProductService.ArchiveProduct:output_type -> product.v1.ArchiveProductReply
+	11, // 70: product.v1.ProductService.RestoreProduct:output_type -> product.v1.RestoreProductReply
+	13, // 71: product.v1.ProductService.ApplyDiscount:output_type -> product.v1.ApplyDiscountReply
+	15, // 72: product.v1.ProductService.RemoveDiscount:output_type -> product.v1.RemoveDiscountReply
+	17, // 73: product.v1.ProductService.SetProductPrice:output_type -> product.v1.SetProductPriceReply
+	19, // 74: product.v1.ProductService.RemoveProductPrice:output_type -> product.v1.RemoveProductPriceReply
+	21, // 75: product.v1.ProductService.ChangeBasePrice:output_type -> product.v1.ChangeBasePriceReply
+	23, // 76: product.v1.ProductService.SetProductTaxClass:output_type -> product.v1.SetProductTaxClassReply
+	25, // 77: product.v1.ProductService.SetProductIdentifiers:output_type -> product.v1.SetProductIdentifiersReply
+	27, // 78: product.v1.ProductService.SetPriceTiers:output_type -> product.v1.SetPriceTiersReply
+	30, // 79: product.v1.ProductService.AddVariant:output_type -> product.v1.AddVariantReply
+	32, // 80: product.v1.ProductService.UpdateVariant:output_type -> product.v1.UpdateVariantReply
+	34, // 81: product.v1.ProductService.RemoveVariant:output_type -> product.v1.RemoveVariantReply
+	37, // 82: product.v1.ProductService.GetProduct:output_type -> product.v1.GetProductReply
+	37, // 83: product.v1.ProductService.GetProductBySku:output_type -> product.v1.GetProductReply
+	40, // 84: product.v1.ProductService.BatchGetProducts:output_type -> product.v1.BatchGetProductsReply
+	46, // 85: product.v1.ProductService.ListProducts:output_type -> product.v1.ListProductsReply
+	49, // 86: product.v1.ProductService.QuotePrice:output_type -> product.v1.QuotePriceReply
+	51, // 87: product.v1.ProductService.BatchQuotePrices:output_type -> product.v1.BatchQuotePricesReply
+	72, // 88: product.v1.ProductService.ListPriceHistory:output_type -> product.v1.ListPriceHistoryReply
+	56, // 89: product.v1.ProductService.SetExchangeRate:output_type -> product.v1.SetExchangeRateReply
+	70, // 90: product.v1.ProductService.ListExchangeRates:output_type -> product.v1.ListExchangeRatesReply
+	58, // 91: product.v1.ProductService.SetTaxRate:output_type -> product.v1.SetTaxRateReply
+	60, // 92: product.v1.ProductService.SetCategoryTaxClass:output_type -> product.v1.SetCategoryTaxClassReply
+	62, // 93: product.v1.ProductService.CreatePriceList:output_type -> product.v1.CreatePriceListReply
+	64, // 94: product.v1.ProductService.UpdatePriceList:output_type -> product.v1.UpdatePriceListReply
+	66, // 95: product.v1.ProductService.SetPriceListEntry:output_type -> product.v1.SetPriceListEntryReply
+	68, // 96: product.v1.ProductService.RemovePriceListEntry:output_type -> product.v1.RemovePriceListEntryReply
+	65, // [65:97] is the sub-list for method output_type
+	33, // [33:65] is the sub-list for method input_type
+	33, // [33:33] is the sub-list for extension type_name
+	33, // [33:33] is the sub-list for extension extendee
+	0,  // [0:33] is the sub-list for field type_name
 }
 
 func init() { file_proto_product_v1_product_service_proto_init() }
@@ -1140,14 +5953,16 @@ func file_proto_product_v1_product_service_proto_init() {
 	if File_proto_product_v1_product_service_proto != nil {
 		return
 	}
-	file_proto_product_v1_product_service_proto_msgTypes[13].OneofWrappers = []any{}
+	file_proto_product_v1_product_service_proto_msgTypes[37].OneofWrappers = []any{}
+	file_proto_product_v1_product_service_proto_msgTypes[47].OneofWrappers = []any{}
+	file_proto_product_v1_product_service_proto_msgTypes[52].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_product_v1_product_service_proto_rawDesc), len(file_proto_product_v1_product_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   18,
+			NumMessages:   77,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
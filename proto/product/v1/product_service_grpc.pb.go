@@ -20,14 +20,38 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ProductService_CreateProduct_FullMethodName     = "/product.v1.ProductService/CreateProduct"
-	ProductService_UpdateProduct_FullMethodName     = "/product.v1.ProductService/UpdateProduct"
-	ProductService_ActivateProduct_FullMethodName   = "/product.v1.ProductService/ActivateProduct"
-	ProductService_DeactivateProduct_FullMethodName = "/product.v1.ProductService/DeactivateProduct"
-	ProductService_ApplyDiscount_FullMethodName     = "/product.v1.ProductService/ApplyDiscount"
-	ProductService_RemoveDiscount_FullMethodName    = "/product.v1.ProductService/RemoveDiscount"
-	ProductService_GetProduct_FullMethodName        = "/product.v1.ProductService/GetProduct"
-	ProductService_ListProducts_FullMethodName      = "/product.v1.ProductService/ListProducts"
+	ProductService_CreateProduct_FullMethodName         = "/product.v1.ProductService/CreateProduct"
+	ProductService_UpdateProduct_FullMethodName         = "/product.v1.ProductService/UpdateProduct"
+	ProductService_ActivateProduct_FullMethodName       = "/product.v1.ProductService/ActivateProduct"
+	ProductService_DeactivateProduct_FullMethodName     = "/product.v1.ProductService/DeactivateProduct"
+	ProductService_ArchiveProduct_FullMethodName        = "/product.v1.ProductService/ArchiveProduct"
+	ProductService_RestoreProduct_FullMethodName        = "/product.v1.ProductService/RestoreProduct"
+	ProductService_ApplyDiscount_FullMethodName         = "/product.v1.ProductService/ApplyDiscount"
+	ProductService_RemoveDiscount_FullMethodName        = "/product.v1.ProductService/RemoveDiscount"
+	ProductService_SetProductPrice_FullMethodName       = "/product.v1.ProductService/SetProductPrice"
+	ProductService_RemoveProductPrice_FullMethodName    = "/product.v1.ProductService/RemoveProductPrice"
+	ProductService_ChangeBasePrice_FullMethodName       = "/product.v1.ProductService/ChangeBasePrice"
+	ProductService_SetProductTaxClass_FullMethodName    = "/product.v1.ProductService/SetProductTaxClass"
+	ProductService_SetProductIdentifiers_FullMethodName = "/product.v1.ProductService/SetProductIdentifiers"
+	ProductService_SetPriceTiers_FullMethodName         = "/product.v1.ProductService/SetPriceTiers"
+	ProductService_AddVariant_FullMethodName            = "/product.v1.ProductService/AddVariant"
+	ProductService_UpdateVariant_FullMethodName         = "/product.v1.ProductService/UpdateVariant"
+	ProductService_RemoveVariant_FullMethodName         = "/product.v1.ProductService/RemoveVariant"
+	ProductService_GetProduct_FullMethodName            = "/product.v1.ProductService/GetProduct"
+	ProductService_GetProductBySku_FullMethodName       = "/product.v1.ProductService/GetProductBySku"
+	ProductService_BatchGetProducts_FullMethodName      = "/product.v1.ProductService/BatchGetProducts"
+	ProductService_ListProducts_FullMethodName          = "/product.v1.ProductService/ListProducts"
+	ProductService_QuotePrice_FullMethodName            = "/product.v1.ProductService/QuotePrice"
+	ProductService_BatchQuotePrices_FullMethodName      = "/product.v1.ProductService/BatchQuotePrices"
+	ProductService_ListPriceHistory_FullMethodName      = "/product.v1.ProductService/ListPriceHistory"
+	ProductService_SetExchangeRate_FullMethodName       = "/product.v1.ProductService/SetExchangeRate"
+	ProductService_ListExchangeRates_FullMethodName     = "/product.v1.ProductService/ListExchangeRates"
+	ProductService_SetTaxRate_FullMethodName            = "/product.v1.ProductService/SetTaxRate"
+	ProductService_SetCategoryTaxClass_FullMethodName   = "/product.v1.ProductService/SetCategoryTaxClass"
+	ProductService_CreatePriceList_FullMethodName       = "/product.v1.ProductService/CreatePriceList"
+	ProductService_UpdatePriceList_FullMethodName       = "/product.v1.ProductService/UpdatePriceList"
+	ProductService_SetPriceListEntry_FullMethodName     = "/product.v1.ProductService/SetPriceListEntry"
+	ProductService_RemovePriceListEntry_FullMethodName  = "/product.v1.ProductService/RemovePriceListEntry"
 )
 
 // ProductServiceClient is the client API for ProductService service.
@@ -38,10 +62,38 @@ type ProductServiceClient interface {
 	UpdateProduct(ctx context.Context, in *UpdateProductRequest, opts ...grpc.CallOption) (*UpdateProductReply, error)
 	ActivateProduct(ctx context.Context, in *ActivateProductRequest, opts ...grpc.CallOption) (*ActivateProductReply, error)
 	DeactivateProduct(ctx context.Context, in *DeactivateProductRequest, opts ...grpc.CallOption) (*DeactivateProductReply, error)
+	ArchiveProduct(ctx context.Context, in *ArchiveProductRequest, opts ...grpc.CallOption) (*ArchiveProductReply, error)
+	RestoreProduct(ctx context.Context, in *RestoreProductRequest, opts ...grpc.CallOption) (*RestoreProductReply, error)
 	ApplyDiscount(ctx context.Context, in *ApplyDiscountRequest, opts ...grpc.CallOption) (*ApplyDiscountReply, error)
 	RemoveDiscount(ctx context.Context, in *RemoveDiscountRequest, opts ...grpc.CallOption) (*RemoveDiscountReply, error)
+	SetProductPrice(ctx context.Context, in *SetProductPriceRequest, opts ...grpc.CallOption) (*SetProductPriceReply, error)
+	RemoveProductPrice(ctx context.Context, in *RemoveProductPriceRequest, opts ...grpc.CallOption) (*RemoveProductPriceReply, error)
+	ChangeBasePrice(ctx context.Context, in *ChangeBasePriceRequest, opts ...grpc.CallOption) (*ChangeBasePriceReply, error)
+	SetProductTaxClass(ctx context.Context, in *SetProductTaxClassRequest, opts ...grpc.CallOption) (*SetProductTaxClassReply, error)
+	SetProductIdentifiers(ctx context.Context, in *SetProductIdentifiersRequest, opts ...grpc.CallOption) (*SetProductIdentifiersReply, error)
+	SetPriceTiers(ctx context.Context, in *SetPriceTiersRequest, opts ...grpc.CallOption) (*SetPriceTiersReply, error)
+	AddVariant(ctx context.Context, in *AddVariantRequest, opts ...grpc.CallOption) (*AddVariantReply, error)
+	UpdateVariant(ctx context.Context, in *UpdateVariantRequest, opts ...grpc.CallOption) (*UpdateVariantReply, error)
+	RemoveVariant(ctx context.Context, in *RemoveVariantRequest, opts ...grpc.CallOption) (*RemoveVariantReply, error)
 	GetProduct(ctx context.Context, in *GetProductRequest, opts ...grpc.CallOption) (*GetProductReply, error)
+	GetProductBySku(ctx context.Context, in *GetProductBySkuRequest, opts ...grpc.CallOption) (*GetProductReply, error)
+	BatchGetProducts(ctx context.Context, in *BatchGetProductsRequest, opts ...grpc.CallOption) (*BatchGetProductsReply, error)
 	ListProducts(ctx context.Context, in *ListProductsRequest, opts ...grpc.CallOption) (*ListProductsReply, error)
+	QuotePrice(ctx context.Context, in *QuotePriceRequest, opts ...grpc.CallOption) (*QuotePriceReply, error)
+	BatchQuotePrices(ctx context.Context, in *BatchQuotePricesRequest, opts ...grpc.CallOption) (*BatchQuotePricesReply, error)
+	ListPriceHistory(ctx context.Context, in *ListPriceHistoryRequest, opts ...grpc.CallOption) (*ListPriceHistoryReply, error)
+	// Admin: exchange rates used to derive prices in currencies a product has
+	// no price of its own in.
+	SetExchangeRate(ctx context.Context, in *SetExchangeRateRequest, opts ...grpc.CallOption) (*SetExchangeRateReply, error)
+	ListExchangeRates(ctx context.Context, in *ListExchangeRatesRequest, opts ...grpc.CallOption) (*ListExchangeRatesReply, error)
+	// Admin: tax rates per region and class, and the classes of categories.
+	SetTaxRate(ctx context.Context, in *SetTaxRateRequest, opts ...grpc.CallOption) (*SetTaxRateReply, error)
+	SetCategoryTaxClass(ctx context.Context, in *SetCategoryTaxClassRequest, opts ...grpc.CallOption) (*SetCategoryTaxClassReply, error)
+	// Admin: price lists overriding product prices for a customer group.
+	CreatePriceList(ctx context.Context, in *CreatePriceListRequest, opts ...grpc.CallOption) (*CreatePriceListReply, error)
+	UpdatePriceList(ctx context.Context, in *UpdatePriceListRequest, opts ...grpc.CallOption) (*UpdatePriceListReply, error)
+	SetPriceListEntry(ctx context.Context, in *SetPriceListEntryRequest, opts ...grpc.CallOption) (*SetPriceListEntryReply, error)
+	RemovePriceListEntry(ctx context.Context, in *RemovePriceListEntryRequest, opts ...grpc.CallOption) (*RemovePriceListEntryReply, error)
 }
 
 type productServiceClient struct {
@@ -92,6 +144,26 @@ func (c *productServiceClient) DeactivateProduct(ctx context.Context, in *Deacti
 	return out, nil
 }
 
+func (c *productServiceClient) ArchiveProduct(ctx context.Context, in *ArchiveProductRequest, opts ...grpc.CallOption) (*ArchiveProductReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ArchiveProductReply)
+	err := c.cc.Invoke(ctx, ProductService_ArchiveProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) RestoreProduct(ctx context.Context, in *RestoreProductRequest, opts ...grpc.CallOption) (*RestoreProductReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreProductReply)
+	err := c.cc.Invoke(ctx, ProductService_RestoreProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *productServiceClient) ApplyDiscount(ctx context.Context, in *ApplyDiscountRequest, opts ...grpc.CallOption) (*ApplyDiscountReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ApplyDiscountReply)
@@ -112,6 +184,96 @@ func (c *productServiceClient) RemoveDiscount(ctx context.Context, in *RemoveDis
 	return out, nil
 }
 
+func (c *productServiceClient) SetProductPrice(ctx context.Context, in *SetProductPriceRequest, opts ...grpc.CallOption) (*SetProductPriceReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetProductPriceReply)
+	err := c.cc.Invoke(ctx, ProductService_SetProductPrice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) RemoveProductPrice(ctx context.Context, in *RemoveProductPriceRequest, opts ...grpc.CallOption) (*RemoveProductPriceReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveProductPriceReply)
+	err := c.cc.Invoke(ctx, ProductService_RemoveProductPrice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ChangeBasePrice(ctx context.Context, in *ChangeBasePriceRequest, opts ...grpc.CallOption) (*ChangeBasePriceReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangeBasePriceReply)
+	err := c.cc.Invoke(ctx, ProductService_ChangeBasePrice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SetProductTaxClass(ctx context.Context, in *SetProductTaxClassRequest, opts ...grpc.CallOption) (*SetProductTaxClassReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetProductTaxClassReply)
+	err := c.cc.Invoke(ctx, ProductService_SetProductTaxClass_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SetProductIdentifiers(ctx context.Context, in *SetProductIdentifiersRequest, opts ...grpc.CallOption) (*SetProductIdentifiersReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetProductIdentifiersReply)
+	err := c.cc.Invoke(ctx, ProductService_SetProductIdentifiers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SetPriceTiers(ctx context.Context, in *SetPriceTiersRequest, opts ...grpc.CallOption) (*SetPriceTiersReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetPriceTiersReply)
+	err := c.cc.Invoke(ctx, ProductService_SetPriceTiers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) AddVariant(ctx context.Context, in *AddVariantRequest, opts ...grpc.CallOption) (*AddVariantReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddVariantReply)
+	err := c.cc.Invoke(ctx, ProductService_AddVariant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) UpdateVariant(ctx context.Context, in *UpdateVariantRequest, opts ...grpc.CallOption) (*UpdateVariantReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateVariantReply)
+	err := c.cc.Invoke(ctx, ProductService_UpdateVariant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) RemoveVariant(ctx context.Context, in *RemoveVariantRequest, opts ...grpc.CallOption) (*RemoveVariantReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveVariantReply)
+	err := c.cc.Invoke(ctx, ProductService_RemoveVariant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *productServiceClient) GetProduct(ctx context.Context, in *GetProductRequest, opts ...grpc.CallOption) (*GetProductReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetProductReply)
@@ -122,6 +284,26 @@ func (c *productServiceClient) GetProduct(ctx context.Context, in *GetProductReq
 	return out, nil
 }
 
+func (c *productServiceClient) GetProductBySku(ctx context.Context, in *GetProductBySkuRequest, opts ...grpc.CallOption) (*GetProductReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetProductReply)
+	err := c.cc.Invoke(ctx, ProductService_GetProductBySku_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) BatchGetProducts(ctx context.Context, in *BatchGetProductsRequest, opts ...grpc.CallOption) (*BatchGetProductsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchGetProductsReply)
+	err := c.cc.Invoke(ctx, ProductService_BatchGetProducts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *productServiceClient) ListProducts(ctx context.Context, in *ListProductsRequest, opts ...grpc.CallOption) (*ListProductsReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListProductsReply)
@@ -132,6 +314,116 @@ func (c *productServiceClient) ListProducts(ctx context.Context, in *ListProduct
 	return out, nil
 }
 
+func (c *productServiceClient) QuotePrice(ctx context.Context, in *QuotePriceRequest, opts ...grpc.CallOption) (*QuotePriceReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuotePriceReply)
+	err := c.cc.Invoke(ctx, ProductService_QuotePrice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) BatchQuotePrices(ctx context.Context, in *BatchQuotePricesRequest, opts ...grpc.CallOption) (*BatchQuotePricesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchQuotePricesReply)
+	err := c.cc.Invoke(ctx, ProductService_BatchQuotePrices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ListPriceHistory(ctx context.Context, in *ListPriceHistoryRequest, opts ...grpc.CallOption) (*ListPriceHistoryReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPriceHistoryReply)
+	err := c.cc.Invoke(ctx, ProductService_ListPriceHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SetExchangeRate(ctx context.Context, in *SetExchangeRateRequest, opts ...grpc.CallOption) (*SetExchangeRateReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetExchangeRateReply)
+	err := c.cc.Invoke(ctx, ProductService_SetExchangeRate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ListExchangeRates(ctx context.Context, in *ListExchangeRatesRequest, opts ...grpc.CallOption) (*ListExchangeRatesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListExchangeRatesReply)
+	err := c.cc.Invoke(ctx, ProductService_ListExchangeRates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SetTaxRate(ctx context.Context, in *SetTaxRateRequest, opts ...grpc.CallOption) (*SetTaxRateReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetTaxRateReply)
+	err := c.cc.Invoke(ctx, ProductService_SetTaxRate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SetCategoryTaxClass(ctx context.Context, in *SetCategoryTaxClassRequest, opts ...grpc.CallOption) (*SetCategoryTaxClassReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetCategoryTaxClassReply)
+	err := c.cc.Invoke(ctx, ProductService_SetCategoryTaxClass_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) CreatePriceList(ctx context.Context, in *CreatePriceListRequest, opts ...grpc.CallOption) (*CreatePriceListReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePriceListReply)
+	err := c.cc.Invoke(ctx, ProductService_CreatePriceList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) UpdatePriceList(ctx context.Context, in *UpdatePriceListRequest, opts ...grpc.CallOption) (*UpdatePriceListReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatePriceListReply)
+	err := c.cc.Invoke(ctx, ProductService_UpdatePriceList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SetPriceListEntry(ctx context.Context, in *SetPriceListEntryRequest, opts ...grpc.CallOption) (*SetPriceListEntryReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetPriceListEntryReply)
+	err := c.cc.Invoke(ctx, ProductService_SetPriceListEntry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) RemovePriceListEntry(ctx context.Context, in *RemovePriceListEntryRequest, opts ...grpc.CallOption) (*RemovePriceListEntryReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemovePriceListEntryReply)
+	err := c.cc.Invoke(ctx, ProductService_RemovePriceListEntry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProductServiceServer is the server API for ProductService service.
 // All implementations must embed UnimplementedProductServiceServer
 // for forward compatibility.
@@ -140,10 +432,38 @@ type ProductServiceServer interface {
 	UpdateProduct(context.Context, *UpdateProductRequest) (*UpdateProductReply, error)
 	ActivateProduct(context.Context, *ActivateProductRequest) (*ActivateProductReply, error)
 	DeactivateProduct(context.Context, *DeactivateProductRequest) (*DeactivateProductReply, error)
+	ArchiveProduct(context.Context, *ArchiveProductRequest) (*ArchiveProductReply, error)
+	RestoreProduct(context.Context, *RestoreProductRequest) (*RestoreProductReply, error)
 	ApplyDiscount(context.Context, *ApplyDiscountRequest) (*ApplyDiscountReply, error)
 	RemoveDiscount(context.Context, *RemoveDiscountRequest) (*RemoveDiscountReply, error)
+	SetProductPrice(context.Context, *SetProductPriceRequest) (*SetProductPriceReply, error)
+	RemoveProductPrice(context.Context, *RemoveProductPriceRequest) (*RemoveProductPriceReply, error)
+	ChangeBasePrice(context.Context, *ChangeBasePriceRequest) (*ChangeBasePriceReply, error)
+	SetProductTaxClass(context.Context, *SetProductTaxClassRequest) (*SetProductTaxClassReply, error)
+	SetProductIdentifiers(context.Context, *SetProductIdentifiersRequest) (*SetProductIdentifiersReply, error)
+	SetPriceTiers(context.Context, *SetPriceTiersRequest) (*SetPriceTiersReply, error)
+	AddVariant(context.Context, *AddVariantRequest) (*AddVariantReply, error)
+	UpdateVariant(context.Context, *UpdateVariantRequest) (*UpdateVariantReply, error)
+	RemoveVariant(context.Context, *RemoveVariantRequest) (*RemoveVariantReply, error)
 	GetProduct(context.Context, *GetProductRequest) (*GetProductReply, error)
+	GetProductBySku(context.Context, *GetProductBySkuRequest) (*GetProductReply, error)
+	BatchGetProducts(context.Context, *BatchGetProductsRequest) (*BatchGetProductsReply, error)
 	ListProducts(context.Context, *ListProductsRequest) (*ListProductsReply, error)
+	QuotePrice(context.Context, *QuotePriceRequest) (*QuotePriceReply, error)
+	BatchQuotePrices(context.Context, *BatchQuotePricesRequest) (*BatchQuotePricesReply, error)
+	ListPriceHistory(context.Context, *ListPriceHistoryRequest) (*ListPriceHistoryReply, error)
+	// Admin: exchange rates used to derive prices in currencies a product has
+	// no price of its own in.
+	SetExchangeRate(context.Context, *SetExchangeRateRequest) (*SetExchangeRateReply, error)
+	ListExchangeRates(context.Context, *ListExchangeRatesRequest) (*ListExchangeRatesReply, error)
+	// Admin: tax rates per region and class, and the classes of categories.
+	SetTaxRate(context.Context, *SetTaxRateRequest) (*SetTaxRateReply, error)
+	SetCategoryTaxClass(context.Context, *SetCategoryTaxClassRequest) (*SetCategoryTaxClassReply, error)
+	// Admin: price lists overriding product prices for a customer group.
+	CreatePriceList(context.Context, *CreatePriceListRequest) (*CreatePriceListReply, error)
+	UpdatePriceList(context.Context, *UpdatePriceListRequest) (*UpdatePriceListReply, error)
+	SetPriceListEntry(context.Context, *SetPriceListEntryRequest) (*SetPriceListEntryReply, error)
+	RemovePriceListEntry(context.Context, *RemovePriceListEntryRequest) (*RemovePriceListEntryReply, error)
 	mustEmbedUnimplementedProductServiceServer()
 }
 
@@ -166,18 +486,90 @@ func (UnimplementedProductServiceServer) ActivateProduct(context.Context, *Activ
 func (UnimplementedProductServiceServer) DeactivateProduct(context.Context, *DeactivateProductRequest) (*DeactivateProductReply, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeactivateProduct not implemented")
 }
+func (UnimplementedProductServiceServer) ArchiveProduct(context.Context, *ArchiveProductRequest) (*ArchiveProductReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ArchiveProduct not implemented")
+}
+func (UnimplementedProductServiceServer) RestoreProduct(context.Context, *RestoreProductRequest) (*RestoreProductReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method RestoreProduct not implemented")
+}
 func (UnimplementedProductServiceServer) ApplyDiscount(context.Context, *ApplyDiscountRequest) (*ApplyDiscountReply, error) {
 	return nil, status.Error(codes.Unimplemented, "method ApplyDiscount not implemented")
 }
 func (UnimplementedProductServiceServer) RemoveDiscount(context.Context, *RemoveDiscountRequest) (*RemoveDiscountReply, error) {
 	return nil, status.Error(codes.Unimplemented, "method RemoveDiscount not implemented")
 }
+func (UnimplementedProductServiceServer) SetProductPrice(context.Context, *SetProductPriceRequest) (*SetProductPriceReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetProductPrice not implemented")
+}
+func (UnimplementedProductServiceServer) RemoveProductPrice(context.Context, *RemoveProductPriceRequest) (*RemoveProductPriceReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveProductPrice not implemented")
+}
+func (UnimplementedProductServiceServer) ChangeBasePrice(context.Context, *ChangeBasePriceRequest) (*ChangeBasePriceReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ChangeBasePrice not implemented")
+}
+func (UnimplementedProductServiceServer) SetProductTaxClass(context.Context, *SetProductTaxClassRequest) (*SetProductTaxClassReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetProductTaxClass not implemented")
+}
+func (UnimplementedProductServiceServer) SetProductIdentifiers(context.Context, *SetProductIdentifiersRequest) (*SetProductIdentifiersReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetProductIdentifiers not implemented")
+}
+func (UnimplementedProductServiceServer) SetPriceTiers(context.Context, *SetPriceTiersRequest) (*SetPriceTiersReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetPriceTiers not implemented")
+}
+func (UnimplementedProductServiceServer) AddVariant(context.Context, *AddVariantRequest) (*AddVariantReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddVariant not implemented")
+}
+func (UnimplementedProductServiceServer) UpdateVariant(context.Context, *UpdateVariantRequest) (*UpdateVariantReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateVariant not implemented")
+}
+func (UnimplementedProductServiceServer) RemoveVariant(context.Context, *RemoveVariantRequest) (*RemoveVariantReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveVariant not implemented")
+}
 func (UnimplementedProductServiceServer) GetProduct(context.Context, *GetProductRequest) (*GetProductReply, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetProduct not implemented")
 }
+func (UnimplementedProductServiceServer) GetProductBySku(context.Context, *GetProductBySkuRequest) (*GetProductReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetProductBySku not implemented")
+}
+func (UnimplementedProductServiceServer) BatchGetProducts(context.Context, *BatchGetProductsRequest) (*BatchGetProductsReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method BatchGetProducts not implemented")
+}
 func (UnimplementedProductServiceServer) ListProducts(context.Context, *ListProductsRequest) (*ListProductsReply, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListProducts not implemented")
 }
+func (UnimplementedProductServiceServer) QuotePrice(context.Context, *QuotePriceRequest) (*QuotePriceReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method QuotePrice not implemented")
+}
+func (UnimplementedProductServiceServer) BatchQuotePrices(context.Context, *BatchQuotePricesRequest) (*BatchQuotePricesReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method BatchQuotePrices not implemented")
+}
+func (UnimplementedProductServiceServer) ListPriceHistory(context.Context, *ListPriceHistoryRequest) (*ListPriceHistoryReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListPriceHistory not implemented")
+}
+func (UnimplementedProductServiceServer) SetExchangeRate(context.Context, *SetExchangeRateRequest) (*SetExchangeRateReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetExchangeRate not implemented")
+}
+func (UnimplementedProductServiceServer) ListExchangeRates(context.Context, *ListExchangeRatesRequest) (*ListExchangeRatesReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListExchangeRates not implemented")
+}
+func (UnimplementedProductServiceServer) SetTaxRate(context.Context, *SetTaxRateRequest) (*SetTaxRateReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetTaxRate not implemented")
+}
+func (UnimplementedProductServiceServer) SetCategoryTaxClass(context.Context, *SetCategoryTaxClassRequest) (*SetCategoryTaxClassReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetCategoryTaxClass not implemented")
+}
+func (UnimplementedProductServiceServer) CreatePriceList(context.Context, *CreatePriceListRequest) (*CreatePriceListReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreatePriceList not implemented")
+}
+func (UnimplementedProductServiceServer) UpdatePriceList(context.Context, *UpdatePriceListRequest) (*UpdatePriceListReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdatePriceList not implemented")
+}
+func (UnimplementedProductServiceServer) SetPriceListEntry(context.Context, *SetPriceListEntryRequest) (*SetPriceListEntryReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetPriceListEntry not implemented")
+}
+func (UnimplementedProductServiceServer) RemovePriceListEntry(context.Context, *RemovePriceListEntryRequest) (*RemovePriceListEntryReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemovePriceListEntry not implemented")
+}
 func (UnimplementedProductServiceServer) mustEmbedUnimplementedProductServiceServer() {}
 func (UnimplementedProductServiceServer) testEmbeddedByValue()                        {}
 
@@ -271,6 +663,42 @@ func _ProductService_DeactivateProduct_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProductService_ArchiveProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ArchiveProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ArchiveProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ArchiveProduct(ctx, req.(*ArchiveProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_RestoreProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).RestoreProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_RestoreProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).RestoreProduct(ctx, req.(*RestoreProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ProductService_ApplyDiscount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ApplyDiscountRequest)
 	if err := dec(in); err != nil {
@@ -307,81 +735,573 @@ func _ProductService_RemoveDiscount_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_GetProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetProductRequest)
+func _ProductService_SetProductPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetProductPriceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).GetProduct(ctx, in)
+		return srv.(ProductServiceServer).SetProductPrice(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_GetProduct_FullMethodName,
+		FullMethod: ProductService_SetProductPrice_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).GetProduct(ctx, req.(*GetProductRequest))
+		return srv.(ProductServiceServer).SetProductPrice(ctx, req.(*SetProductPriceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_ListProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListProductsRequest)
+func _ProductService_RemoveProductPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveProductPriceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).ListProducts(ctx, in)
+		return srv.(ProductServiceServer).RemoveProductPrice(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_ListProducts_FullMethodName,
+		FullMethod: ProductService_RemoveProductPrice_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).ListProducts(ctx, req.(*ListProductsRequest))
+		return srv.(ProductServiceServer).RemoveProductPrice(ctx, req.(*RemoveProductPriceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// ProductService_ServiceDesc is the grpc.ServiceDesc for ProductService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var ProductService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "product.v1.ProductService",
-	HandlerType: (*ProductServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateProduct",
-			Handler:    _ProductService_CreateProduct_Handler,
-		},
-		{
-			MethodName: "UpdateProduct",
-			Handler:    _ProductService_UpdateProduct_Handler,
-		},
-		{
-			MethodName: "ActivateProduct",
-			Handler:    _ProductService_ActivateProduct_Handler,
-		},
-		{
-			MethodName: "DeactivateProduct",
-			Handler:    _ProductService_DeactivateProduct_Handler,
-		},
-		{
-			MethodName: "ApplyDiscount",
-			Handler:    _ProductService_ApplyDiscount_Handler,
-		},
+func _ProductService_ChangeBasePrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeBasePriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ChangeBasePrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ChangeBasePrice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ChangeBasePrice(ctx, req.(*ChangeBasePriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SetProductTaxClass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetProductTaxClassRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SetProductTaxClass(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SetProductTaxClass_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SetProductTaxClass(ctx, req.(*SetProductTaxClassRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SetProductIdentifiers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetProductIdentifiersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SetProductIdentifiers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SetProductIdentifiers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SetProductIdentifiers(ctx, req.(*SetProductIdentifiersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SetPriceTiers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPriceTiersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SetPriceTiers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SetPriceTiers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SetPriceTiers(ctx, req.(*SetPriceTiersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_AddVariant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddVariantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).AddVariant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_AddVariant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).AddVariant(ctx, req.(*AddVariantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_UpdateVariant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateVariantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).UpdateVariant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_UpdateVariant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).UpdateVariant(ctx, req.(*UpdateVariantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_RemoveVariant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveVariantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).RemoveVariant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_RemoveVariant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).RemoveVariant(ctx, req.(*RemoveVariantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetProduct(ctx, req.(*GetProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetProductBySku_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProductBySkuRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetProductBySku(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetProductBySku_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetProductBySku(ctx, req.(*GetProductBySkuRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_BatchGetProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).BatchGetProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_BatchGetProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).BatchGetProducts(ctx, req.(*BatchGetProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ListProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ListProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListProducts(ctx, req.(*ListProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_QuotePrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuotePriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).QuotePrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_QuotePrice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).QuotePrice(ctx, req.(*QuotePriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_BatchQuotePrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchQuotePricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).BatchQuotePrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_BatchQuotePrices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).BatchQuotePrices(ctx, req.(*BatchQuotePricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ListPriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPriceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListPriceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ListPriceHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListPriceHistory(ctx, req.(*ListPriceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SetExchangeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetExchangeRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SetExchangeRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SetExchangeRate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SetExchangeRate(ctx, req.(*SetExchangeRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ListExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExchangeRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListExchangeRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ListExchangeRates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListExchangeRates(ctx, req.(*ListExchangeRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SetTaxRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTaxRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SetTaxRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SetTaxRate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SetTaxRate(ctx, req.(*SetTaxRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SetCategoryTaxClass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCategoryTaxClassRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SetCategoryTaxClass(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SetCategoryTaxClass_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SetCategoryTaxClass(ctx, req.(*SetCategoryTaxClassRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_CreatePriceList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePriceListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).CreatePriceList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_CreatePriceList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).CreatePriceList(ctx, req.(*CreatePriceListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_UpdatePriceList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePriceListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).UpdatePriceList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_UpdatePriceList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).UpdatePriceList(ctx, req.(*UpdatePriceListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SetPriceListEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPriceListEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SetPriceListEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SetPriceListEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SetPriceListEntry(ctx, req.(*SetPriceListEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_RemovePriceListEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemovePriceListEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).RemovePriceListEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_RemovePriceListEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).RemovePriceListEntry(ctx, req.(*RemovePriceListEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ProductService_ServiceDesc is the grpc.ServiceDesc for ProductService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ProductService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "product.v1.ProductService",
+	HandlerType: (*ProductServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateProduct",
+			Handler:    _ProductService_CreateProduct_Handler,
+		},
+		{
+			MethodName: "UpdateProduct",
+			Handler:    _ProductService_UpdateProduct_Handler,
+		},
+		{
+			MethodName: "ActivateProduct",
+			Handler:    _ProductService_ActivateProduct_Handler,
+		},
+		{
+			MethodName: "DeactivateProduct",
+			Handler:    _ProductService_DeactivateProduct_Handler,
+		},
+		{
+			MethodName: "ArchiveProduct",
+			Handler:    _ProductService_ArchiveProduct_Handler,
+		},
+		{
+			MethodName: "RestoreProduct",
+			Handler:    _ProductService_RestoreProduct_Handler,
+		},
+		{
+			MethodName: "ApplyDiscount",
+			Handler:    _ProductService_ApplyDiscount_Handler,
+		},
 		{
 			MethodName: "RemoveDiscount",
 			Handler:    _ProductService_RemoveDiscount_Handler,
 		},
+		{
+			MethodName: "SetProductPrice",
+			Handler:    _ProductService_SetProductPrice_Handler,
+		},
+		{
+			MethodName: "RemoveProductPrice",
+			Handler:    _ProductService_RemoveProductPrice_Handler,
+		},
+		{
+			MethodName: "ChangeBasePrice",
+			Handler:    _ProductService_ChangeBasePrice_Handler,
+		},
+		{
+			MethodName: "SetProductTaxClass",
+			Handler:    _ProductService_SetProductTaxClass_Handler,
+		},
+		{
+			MethodName: "SetProductIdentifiers",
+			Handler:    _ProductService_SetProductIdentifiers_Handler,
+		},
+		{
+			MethodName: "SetPriceTiers",
+			Handler:    _ProductService_SetPriceTiers_Handler,
+		},
+		{
+			MethodName: "AddVariant",
+			Handler:    _ProductService_AddVariant_Handler,
+		},
+		{
+			MethodName: "UpdateVariant",
+			Handler:    _ProductService_UpdateVariant_Handler,
+		},
+		{
+			MethodName: "RemoveVariant",
+			Handler:    _ProductService_RemoveVariant_Handler,
+		},
 		{
 			MethodName: "GetProduct",
 			Handler:    _ProductService_GetProduct_Handler,
 		},
+		{
+			MethodName: "GetProductBySku",
+			Handler:    _ProductService_GetProductBySku_Handler,
+		},
+		{
+			MethodName: "BatchGetProducts",
+			Handler:    _ProductService_BatchGetProducts_Handler,
+		},
 		{
 			MethodName: "ListProducts",
 			Handler:    _ProductService_ListProducts_Handler,
 		},
+		{
+			MethodName: "QuotePrice",
+			Handler:    _ProductService_QuotePrice_Handler,
+		},
+		{
+			MethodName: "BatchQuotePrices",
+			Handler:    _ProductService_BatchQuotePrices_Handler,
+		},
+		{
+			MethodName: "ListPriceHistory",
+			Handler:    _ProductService_ListPriceHistory_Handler,
+		},
+		{
+			MethodName: "SetExchangeRate",
+			Handler:    _ProductService_SetExchangeRate_Handler,
+		},
+		{
+			MethodName: "ListExchangeRates",
+			Handler:    _ProductService_ListExchangeRates_Handler,
+		},
+		{
+			MethodName: "SetTaxRate",
+			Handler:    _ProductService_SetTaxRate_Handler,
+		},
+		{
+			MethodName: "SetCategoryTaxClass",
+			Handler:    _ProductService_SetCategoryTaxClass_Handler,
+		},
+		{
+			MethodName: "CreatePriceList",
+			Handler:    _ProductService_CreatePriceList_Handler,
+		},
+		{
+			MethodName: "UpdatePriceList",
+			Handler:    _ProductService_UpdatePriceList_Handler,
+		},
+		{
+			MethodName: "SetPriceListEntry",
+			Handler:    _ProductService_SetPriceListEntry_Handler,
+		},
+		{
+			MethodName: "RemovePriceListEntry",
+			Handler:    _ProductService_RemovePriceListEntry_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/product/v1/product_service.proto",
@@ -7,51 +7,122 @@ import (
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/app/product/domain/services"
+	"product-catalog-service/internal/app/product/idempotency"
 	"product-catalog-service/internal/app/product/queries/get_product"
+	"product-catalog-service/internal/app/product/queries/list_exchange_rates"
+	"product-catalog-service/internal/app/product/queries/list_price_history"
 	"product-catalog-service/internal/app/product/queries/list_products"
+	"product-catalog-service/internal/app/product/queries/quote_price"
 	"product-catalog-service/internal/app/product/repo"
 	"product-catalog-service/internal/app/product/transport/grpc/product"
 	"product-catalog-service/internal/app/product/usecases/activate_product"
+	"product-catalog-service/internal/app/product/usecases/add_variant"
 	"product-catalog-service/internal/app/product/usecases/apply_discount"
 	"product-catalog-service/internal/app/product/usecases/archive_product"
+	"product-catalog-service/internal/app/product/usecases/change_base_price"
+	"product-catalog-service/internal/app/product/usecases/create_price_list"
 	"product-catalog-service/internal/app/product/usecases/create_product"
 	"product-catalog-service/internal/app/product/usecases/deactivate_product"
 	"product-catalog-service/internal/app/product/usecases/remove_discount"
+	"product-catalog-service/internal/app/product/usecases/remove_price"
+	"product-catalog-service/internal/app/product/usecases/remove_price_list_entry"
+	"product-catalog-service/internal/app/product/usecases/remove_variant"
+	"product-catalog-service/internal/app/product/usecases/restore_product"
+	"product-catalog-service/internal/app/product/usecases/set_category_tax_class"
+	"product-catalog-service/internal/app/product/usecases/set_exchange_rate"
+	"product-catalog-service/internal/app/product/usecases/set_identifiers"
+	"product-catalog-service/internal/app/product/usecases/set_price"
+	"product-catalog-service/internal/app/product/usecases/set_price_list_entry"
+	"product-catalog-service/internal/app/product/usecases/set_price_tiers"
+	"product-catalog-service/internal/app/product/usecases/set_tax_class"
+	"product-catalog-service/internal/app/product/usecases/set_tax_rate"
+	"product-catalog-service/internal/app/product/usecases/update_price_list"
 	"product-catalog-service/internal/app/product/usecases/update_product"
+	"product-catalog-service/internal/app/product/usecases/update_variant"
+	"product-catalog-service/internal/infra/spannerx"
+	"product-catalog-service/internal/pkg/pagetoken"
 	pb "product-catalog-service/proto/product/v1"
 )
 
-func TestGRPC_CreateProduct(t *testing.T) {
-	ctx := context.Background()
+// grpcEnv serves the product service over gRPC on the shared Spanner
+// fake, with a clock of its own.
+type grpcEnv struct {
+	client pb.ProductServiceClient
+	clock  *testClock
+}
+
+func newGRPCEnv(ctx context.Context, t *testing.T) *grpcEnv {
 	client := getSpannerClient(ctx, t)
-	defer client.Close()
+	t.Cleanup(client.Close)
 
 	// Dependencies
 	clk := &testClock{now: time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC)}
-	comm := &spannerCommitter{client: client}
+	idem := idempotency.NewGuard(repo.NewIdempotencyRepo(client), clk)
+	comm := idem.Committer(spannerx.NewCommitter(client))
 	productRepo := repo.NewProductRepo(client, clk)
 	outboxRepo := repo.NewOutboxRepo(clk)
+	historyRepo := repo.NewPriceHistoryRepo(client, clk)
 
-	createUC := create_product.New(productRepo, outboxRepo, comm, clk)
+	createUC := create_product.New(productRepo, outboxRepo, historyRepo, comm, clk)
 	updateUC := update_product.New(productRepo, outboxRepo, comm, clk)
 	activateUC := activate_product.New(productRepo, outboxRepo, comm, clk)
 	deactivateUC := deactivate_product.New(productRepo, outboxRepo, comm, clk)
 	archiveUC := archive_product.New(productRepo, outboxRepo, comm, clk)
-	applyDiscUC := apply_discount.New(productRepo, outboxRepo, comm, clk)
-	removeDiscUC := remove_discount.New(productRepo, outboxRepo, comm, clk)
+	restoreUC := restore_product.New(productRepo, outboxRepo, comm, clk, 30*24*time.Hour)
+	applyDiscUC := apply_discount.New(productRepo, outboxRepo, historyRepo, comm, clk)
+	removeDiscUC := remove_discount.New(productRepo, outboxRepo, historyRepo, comm, clk)
 
-	readModel := repo.NewSpannerReadModel(client, clk)
+	conv := services.NewCurrencyConverter(domain.DefaultRounding)
+	rateRepo := repo.NewExchangeRateRepo(client, clk)
+	taxRepo := repo.NewTaxRepo(client, clk)
+	listRepo := repo.NewPriceListRepo(client, clk)
+	tokens := pagetoken.NewCodec([]byte("e2e"))
+	readModel := repo.NewSpannerReadModel(client, clk, tokens, conv, 30, domain.TaxModeNet)
 	getProdQ := get_product.New(readModel)
 	listProdsQ := list_products.New(readModel)
 
-	handler := product.NewHandler(
-		createUC, updateUC, activateUC, deactivateUC, archiveUC,
-		applyDiscUC, removeDiscUC, getProdQ, listProdsQ,
-	)
+	handler := product.NewHandler(product.Deps{
+		CreateProduct:        createUC,
+		UpdateProduct:        updateUC,
+		ActivateProduct:      activateUC,
+		DeactivateProduct:    deactivateUC,
+		ArchiveProduct:       archiveUC,
+		RestoreProduct:       restoreUC,
+		ApplyDiscount:        applyDiscUC,
+		RemoveDiscount:       removeDiscUC,
+		SetPrice:             set_price.New(productRepo, outboxRepo, historyRepo, comm, clk),
+		RemovePrice:          remove_price.New(productRepo, outboxRepo, historyRepo, comm, clk),
+		ChangeBasePrice:      change_base_price.New(productRepo, outboxRepo, historyRepo, comm, clk),
+		SetTaxClass:          set_tax_class.New(productRepo, outboxRepo, comm, clk),
+		SetIdentifiers:       set_identifiers.New(productRepo, outboxRepo, comm, clk),
+		SetPriceTiers:        set_price_tiers.New(productRepo, outboxRepo, comm, clk),
+		AddVariant:           add_variant.New(productRepo, outboxRepo, comm, clk),
+		UpdateVariant:        update_variant.New(productRepo, outboxRepo, comm, clk),
+		RemoveVariant:        remove_variant.New(productRepo, outboxRepo, comm, clk),
+		SetExchangeRate:      set_exchange_rate.New(rateRepo, comm, clk),
+		SetTaxRate:           set_tax_rate.New(taxRepo, comm),
+		SetCategoryTaxClass:  set_category_tax_class.New(taxRepo, comm),
+		CreatePriceList:      create_price_list.New(listRepo, comm),
+		UpdatePriceList:      update_price_list.New(listRepo, comm),
+		SetPriceListEntry:    set_price_list_entry.New(listRepo, productRepo, comm),
+		RemovePriceListEntry: remove_price_list_entry.New(listRepo, comm),
+		GetProduct:           getProdQ,
+		ListProducts:         listProdsQ,
+		QuotePrice:           quote_price.New(productRepo, listRepo, rateRepo, taxRepo, conv, domain.TaxModeNet, clk),
+		ListPriceHistory:     list_price_history.New(historyRepo, tokens, domain.DefaultRounding),
+		ListExchangeRates:    list_exchange_rates.New(rateRepo),
+		Idempotency:          idem,
+	})
 
 	// Start gRPC server on random port
 	lis, err := net.Listen("tcp", "localhost:0")
@@ -65,17 +136,33 @@ func TestGRPC_CreateProduct(t *testing.T) {
 			fmt.Printf("gRPC server error: %v\n", err)
 		}
 	}()
-	defer grpcServer.Stop()
+	t.Cleanup(grpcServer.Stop)
 
 	// gRPC Client
 	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
 	require.NoError(t, err)
-	defer conn.Close()
+	t.Cleanup(func() { conn.Close() })
 
-	grpcClient := pb.NewProductServiceClient(conn)
+	return &grpcEnv{client: pb.NewProductServiceClient(conn), clock: clk}
+}
 
-	// Test Create
-	resp, err := grpcClient.CreateProduct(ctx, &pb.CreateProductRequest{
+// createProduct creates a product through the service and returns its ID.
+func (e *grpcEnv) createProduct(ctx context.Context, t *testing.T, category string, num, den int64) string {
+	resp, err := e.client.CreateProduct(ctx, &pb.CreateProductRequest{
+		Name:                 "GRPC Test Product",
+		Category:             category,
+		BasePriceNumerator:   num,
+		BasePriceDenominator: den,
+	})
+	require.NoError(t, err)
+	return resp.ProductId
+}
+
+func TestGRPC_CreateProduct(t *testing.T) {
+	ctx := context.Background()
+	e := newGRPCEnv(ctx, t)
+
+	resp, err := e.client.CreateProduct(ctx, &pb.CreateProductRequest{
 		Name:                 "GRPC Test Product",
 		Description:          "Test Description",
 		Category:             "electronics",
@@ -86,8 +173,393 @@ func TestGRPC_CreateProduct(t *testing.T) {
 	require.NotEmpty(t, resp.ProductId)
 
 	// Verify via gRPC GetProduct
-	getResp, err := grpcClient.GetProduct(ctx, &pb.GetProductRequest{ProductId: resp.ProductId})
+	getResp, err := e.client.GetProduct(ctx, &pb.GetProductRequest{ProductId: resp.ProductId})
 	require.NoError(t, err)
 	require.Equal(t, "GRPC Test Product", getResp.Name)
 	require.Equal(t, "inactive", getResp.Status)
+	require.Equal(t, int64(500), getResp.Price.EffectiveNumerator)
+	require.Equal(t, int64(0), getResp.Price.DiscountAmountNumerator)
+	require.Equal(t, "500.00", getResp.Price.Effective)
+	require.Equal(t, "500.00", getResp.BasePrice)
+}
+
+func TestGRPC_CreateProduct_Idempotent(t *testing.T) {
+	ctx := context.Background()
+	e := newGRPCEnv(ctx, t)
+
+	// Retries with the same idempotency key return the first product
+	key := "create-" + uuid.NewString()
+	createReq := &pb.CreateProductRequest{
+		Name:                 "Idempotent Product",
+		Category:             "electronics",
+		BasePriceNumerator:   100,
+		BasePriceDenominator: 1,
+		IdempotencyKey:       key,
+	}
+	first, err := e.client.CreateProduct(ctx, createReq)
+	require.NoError(t, err)
+	retry, err := e.client.CreateProduct(ctx, createReq)
+	require.NoError(t, err)
+	require.Equal(t, first.ProductId, retry.ProductId)
+
+	// The key may also travel as metadata
+	createReq.IdempotencyKey = ""
+	mdCtx := metadata.AppendToOutgoingContext(ctx, "idempotency-key", key)
+	retry, err = e.client.CreateProduct(mdCtx, createReq)
+	require.NoError(t, err)
+	require.Equal(t, first.ProductId, retry.ProductId)
+
+	// Reusing the key for a different payload is rejected
+	createReq.Name = "Other Product"
+	_, err = e.client.CreateProduct(mdCtx, createReq)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGRPC_ExchangeRates(t *testing.T) {
+	ctx := context.Background()
+	e := newGRPCEnv(ctx, t)
+	productID := e.createProduct(ctx, t, "electronics", 500, 1)
+
+	// Derived prices: 500 EUR converted to JPY at a rate set through the admin RPC
+	_, err := e.client.SetExchangeRate(ctx, &pb.SetExchangeRateRequest{
+		FromCurrency:       "EUR",
+		ToCurrency:         "JPY",
+		RateNumerator:      16275,
+		RateDenominator:    100,
+		EffectiveTimestamp: e.clock.now.Add(-time.Hour).Unix(),
+	})
+	require.NoError(t, err)
+
+	rates, err := e.client.ListExchangeRates(ctx, &pb.ListExchangeRatesRequest{ToCurrency: "jpy"})
+	require.NoError(t, err)
+	require.Len(t, rates.Rates, 1)
+	require.Equal(t, int64(651), rates.Rates[0].RateNumerator)
+	require.Equal(t, int64(4), rates.Rates[0].RateDenominator)
+
+	quote, err := e.client.QuotePrice(ctx, &pb.QuotePriceRequest{ProductId: productID, Currency: "JPY"})
+	require.NoError(t, err)
+	require.Equal(t, "JPY", quote.Quote.Price.Currency)
+	require.Equal(t, int64(81375), quote.Quote.Price.EffectiveNumerator)
+	require.Equal(t, int64(1), quote.Quote.Price.EffectiveDenominator)
+	require.NotNil(t, quote.Quote.ExchangeRate)
+	require.Equal(t, "EUR", quote.Quote.ExchangeRate.FromCurrency)
+
+	getResp, err := e.client.GetProduct(ctx, &pb.GetProductRequest{ProductId: productID, Currency: "JPY"})
+	require.NoError(t, err)
+	require.Equal(t, "JPY", getResp.Price.Currency)
+	require.Equal(t, int64(81375), getResp.Price.BaseNumerator)
+	require.Equal(t, "81375", getResp.Price.Base)
+	require.Equal(t, "500.00", getResp.Prices[0].Amount)
+
+	// Without a rate neither the quote nor the product can be priced
+	_, err = e.client.QuotePrice(ctx, &pb.QuotePriceRequest{ProductId: productID, Currency: "CHF"})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = e.client.GetProduct(ctx, &pb.GetProductRequest{ProductId: productID, Currency: "CHF"})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestGRPC_PriceHistory(t *testing.T) {
+	ctx := context.Background()
+	e := newGRPCEnv(ctx, t)
+	productID := e.createProduct(ctx, t, "electronics", 500, 1)
+
+	// Changing the base price closes the interval it opened at creation
+	created := e.clock.now
+	e.clock.now = e.clock.now.Add(time.Hour)
+	_, err := e.client.ChangeBasePrice(ctx, &pb.ChangeBasePriceRequest{ProductId: productID, BasePriceNumerator: 44999, BasePriceDenominator: 100})
+	require.NoError(t, err)
+
+	history, err := e.client.ListPriceHistory(ctx, &pb.ListPriceHistoryRequest{ProductId: productID})
+	require.NoError(t, err)
+	require.Len(t, history.Intervals, 2)
+	require.Equal(t, "500.00", history.Intervals[0].Price.Effective)
+	require.Equal(t, created.Unix(), history.Intervals[0].FromTimestamp)
+	require.Equal(t, e.clock.now.Unix(), history.Intervals[0].ToTimestamp)
+	require.Equal(t, "449.99", history.Intervals[1].Price.Base)
+	require.Equal(t, int64(0), history.Intervals[1].ToTimestamp)
+
+	// Pages end where the next one picks up
+	first, err := e.client.ListPriceHistory(ctx, &pb.ListPriceHistoryRequest{ProductId: productID, PageSize: 1})
+	require.NoError(t, err)
+	require.Len(t, first.Intervals, 1)
+	require.Equal(t, e.clock.now.Unix(), first.Intervals[0].ToTimestamp)
+	require.NotEmpty(t, first.NextPageToken)
+	second, err := e.client.ListPriceHistory(ctx, &pb.ListPriceHistoryRequest{ProductId: productID, PageSize: 1, PageToken: first.NextPageToken})
+	require.NoError(t, err)
+	require.Len(t, second.Intervals, 1)
+	require.Equal(t, "449.99", second.Intervals[0].Price.Base)
+	require.Empty(t, second.NextPageToken)
+	_, err = e.client.ListPriceHistory(ctx, &pb.ListPriceHistoryRequest{ProductId: productID, PageToken: "bogus"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Since starts at the interval in effect then
+	recent, err := e.client.ListPriceHistory(ctx, &pb.ListPriceHistoryRequest{ProductId: productID, SinceTimestamp: created.Add(30 * time.Minute).Unix()})
+	require.NoError(t, err)
+	require.Len(t, recent.Intervals, 2)
+	require.Equal(t, created.Unix(), recent.Intervals[0].FromTimestamp)
+	recent, err = e.client.ListPriceHistory(ctx, &pb.ListPriceHistoryRequest{ProductId: productID, Currency: "EUR", SinceTimestamp: e.clock.now.Unix()})
+	require.NoError(t, err)
+	require.Len(t, recent.Intervals, 1)
+	require.Equal(t, "449.99", recent.Intervals[0].Price.Base)
+
+	// The lowest price looks back over the preceding days only
+	getResp, err := e.client.GetProduct(ctx, &pb.GetProductRequest{ProductId: productID})
+	require.NoError(t, err)
+	require.Equal(t, "500.00", getResp.LowestPrice.Amount)
+	require.Equal(t, int32(30), getResp.LowestPriceDays)
+	e.clock.now = e.clock.now.Add(time.Minute)
+	getResp, err = e.client.GetProduct(ctx, &pb.GetProductRequest{ProductId: productID})
+	require.NoError(t, err)
+	require.Equal(t, "449.99", getResp.LowestPrice.Amount)
+
+	// Long after the last change, the price in effect when the window opened
+	// still counts
+	e.clock.now = e.clock.now.AddDate(0, 0, 40)
+	getResp, err = e.client.GetProduct(ctx, &pb.GetProductRequest{ProductId: productID})
+	require.NoError(t, err)
+	require.Equal(t, "449.99", getResp.LowestPrice.Amount)
+
+	_, err = e.client.ChangeBasePrice(ctx, &pb.ChangeBasePriceRequest{ProductId: productID, BasePriceNumerator: 1})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGRPC_Tax(t *testing.T) {
+	ctx := context.Background()
+	e := newGRPCEnv(ctx, t)
+	productID := e.createProduct(ctx, t, "lighting", 44999, 100)
+
+	// Tax follows the category's class until the product gets its own
+	_, err := e.client.SetTaxRate(ctx, &pb.SetTaxRateRequest{Region: "DE", TaxClass: "standard", RateNumerator: 19, RateDenominator: 100})
+	require.NoError(t, err)
+	_, err = e.client.SetTaxRate(ctx, &pb.SetTaxRateRequest{Region: "DE", TaxClass: "reduced", RateNumerator: 7, RateDenominator: 100})
+	require.NoError(t, err)
+	_, err = e.client.SetCategoryTaxClass(ctx, &pb.SetCategoryTaxClassRequest{Category: "lighting", TaxClass: "reduced"})
+	require.NoError(t, err)
+
+	getResp, err := e.client.GetProduct(ctx, &pb.GetProductRequest{ProductId: productID, Region: "de"})
+	require.NoError(t, err)
+	require.Equal(t, "reduced", getResp.Tax.TaxClass)
+	require.Equal(t, "net", getResp.Tax.Mode)
+	require.Equal(t, "449.99", getResp.Tax.Net)
+	require.Equal(t, "31.50", getResp.Tax.Tax)
+	require.Equal(t, "481.49", getResp.Tax.Gross)
+
+	_, err = e.client.SetProductTaxClass(ctx, &pb.SetProductTaxClassRequest{ProductId: productID, TaxClass: "standard"})
+	require.NoError(t, err)
+	quote, err := e.client.QuotePrice(ctx, &pb.QuotePriceRequest{ProductId: productID, Region: "DE"})
+	require.NoError(t, err)
+	require.Equal(t, "535.49", quote.Quote.Tax.Gross)
+	require.Equal(t, "85.50", quote.Quote.Tax.Tax)
+
+	getResp, err = e.client.GetProduct(ctx, &pb.GetProductRequest{ProductId: productID, Region: "FR"})
+	require.NoError(t, err)
+	require.Equal(t, "standard", getResp.TaxClass)
+	require.Nil(t, getResp.Tax)
+	_, err = e.client.QuotePrice(ctx, &pb.QuotePriceRequest{ProductId: productID, Region: "FR"})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = e.client.GetProduct(ctx, &pb.GetProductRequest{ProductId: productID, Region: "Germany"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGRPC_PriceTiers(t *testing.T) {
+	ctx := context.Background()
+	e := newGRPCEnv(ctx, t)
+	productID := e.createProduct(ctx, t, "electronics", 44999, 100)
+
+	_, err := e.client.SetTaxRate(ctx, &pb.SetTaxRateRequest{Region: "DE", TaxClass: "standard", RateNumerator: 19, RateDenominator: 100})
+	require.NoError(t, err)
+	_, err = e.client.SetProductTaxClass(ctx, &pb.SetProductTaxClassRequest{ProductId: productID, TaxClass: "standard"})
+	require.NoError(t, err)
+
+	// Quantity breaks price the whole order line
+	_, err = e.client.SetPriceTiers(ctx, &pb.SetPriceTiersRequest{ProductId: productID, Tiers: []*pb.PriceTier{
+		{MinQuantity: 10, MaxQuantity: 49, UnitPriceNumerator: 420, UnitPriceDenominator: 1},
+		{MinQuantity: 50, UnitPriceNumerator: 399, UnitPriceDenominator: 1},
+	}})
+	require.NoError(t, err)
+	quote, err := e.client.QuotePrice(ctx, &pb.QuotePriceRequest{ProductId: productID, Region: "DE", Quantity: 20})
+	require.NoError(t, err)
+	require.Equal(t, int64(20), quote.Quote.Quantity)
+	require.Equal(t, "420.00", quote.Quote.Price.Effective)
+	require.Equal(t, "8400.00", quote.Quote.Line.Effective)
+	require.Equal(t, "1596.00", quote.Quote.Tax.Tax)
+	quote, err = e.client.QuotePrice(ctx, &pb.QuotePriceRequest{ProductId: productID})
+	require.NoError(t, err)
+	require.Equal(t, "449.99", quote.Quote.Line.Effective)
+
+	_, err = e.client.SetPriceTiers(ctx, &pb.SetPriceTiersRequest{ProductId: productID, Tiers: []*pb.PriceTier{
+		{MinQuantity: 100, UnitPriceNumerator: 380, UnitPriceDenominator: 1},
+	}})
+	require.NoError(t, err)
+	getResp, err := e.client.GetProduct(ctx, &pb.GetProductRequest{ProductId: productID})
+	require.NoError(t, err)
+	require.Len(t, getResp.PriceTiers, 1)
+	require.Equal(t, int64(100), getResp.PriceTiers[0].MinQuantity)
+	require.Equal(t, int64(0), getResp.PriceTiers[0].MaxQuantity)
+	require.Equal(t, "380.00", getResp.PriceTiers[0].UnitPrice)
+
+	_, err = e.client.SetPriceTiers(ctx, &pb.SetPriceTiersRequest{ProductId: productID, Tiers: []*pb.PriceTier{
+		{MinQuantity: 10, MaxQuantity: 49, UnitPriceNumerator: 420, UnitPriceDenominator: 1},
+		{MinQuantity: 60, UnitPriceNumerator: 399, UnitPriceDenominator: 1},
+	}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = e.client.QuotePrice(ctx, &pb.QuotePriceRequest{ProductId: productID, Quantity: -1})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGRPC_PriceLists(t *testing.T) {
+	ctx := context.Background()
+	e := newGRPCEnv(ctx, t)
+	productID := e.createProduct(ctx, t, "electronics", 44999, 100)
+	e.clock.now = e.clock.now.Add(time.Minute)
+
+	// Customer-group price lists stand in for the product's own prices
+	lo, err := e.client.CreatePriceList(ctx, &pb.CreatePriceListRequest{Name: "Wholesale", CustomerGroup: "wholesale", Priority: 1})
+	require.NoError(t, err)
+	hi, err := e.client.CreatePriceList(ctx, &pb.CreatePriceListRequest{Name: "Wholesale promo", CustomerGroup: "wholesale", Priority: 2})
+	require.NoError(t, err)
+	for id, num := range map[string]int64{lo.PriceListId: 430, hi.PriceListId: 410} {
+		_, err = e.client.SetPriceListEntry(ctx, &pb.SetPriceListEntryRequest{PriceListId: id, ProductId: productID, Currency: "EUR", PriceNumerator: num, PriceDenominator: 1})
+		require.NoError(t, err)
+	}
+	getResp, err := e.client.GetProduct(ctx, &pb.GetProductRequest{ProductId: productID, CustomerGroup: "wholesale"})
+	require.NoError(t, err)
+	require.Equal(t, hi.PriceListId, getResp.PriceListId)
+	require.Equal(t, "410.00", getResp.Price.Effective)
+	require.Nil(t, getResp.LowestPrice)
+	quote, err := e.client.QuotePrice(ctx, &pb.QuotePriceRequest{ProductId: productID, CustomerGroup: "wholesale", Quantity: 2})
+	require.NoError(t, err)
+	require.Equal(t, hi.PriceListId, quote.Quote.PriceListId)
+	require.Equal(t, "820.00", quote.Quote.Line.Effective)
+	batch, err := e.client.BatchQuotePrices(ctx, &pb.BatchQuotePricesRequest{ProductIds: []string{productID}, PriceListId: lo.PriceListId})
+	require.NoError(t, err)
+	require.Equal(t, "430.00", batch.Quotes[0].Price.Effective)
+	_, err = e.client.QuotePrice(ctx, &pb.QuotePriceRequest{ProductId: productID, CustomerGroup: "Not A Group"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	getResp, err = e.client.GetProduct(ctx, &pb.GetProductRequest{ProductId: productID, PriceListId: lo.PriceListId})
+	require.NoError(t, err)
+	require.Equal(t, "430.00", getResp.Price.Effective)
+
+	_, err = e.client.UpdatePriceList(ctx, &pb.UpdatePriceListRequest{PriceListId: hi.PriceListId, Name: "Wholesale promo", Priority: 2, ValidToTimestamp: e.clock.Now().Unix()})
+	require.NoError(t, err)
+	getResp, err = e.client.GetProduct(ctx, &pb.GetProductRequest{ProductId: productID, CustomerGroup: "wholesale"})
+	require.NoError(t, err)
+	require.Equal(t, lo.PriceListId, getResp.PriceListId)
+	getResp, err = e.client.GetProduct(ctx, &pb.GetProductRequest{ProductId: productID})
+	require.NoError(t, err)
+	require.Empty(t, getResp.PriceListId)
+	require.Equal(t, "449.99", getResp.Price.Effective)
+	require.Equal(t, "449.99", getResp.LowestPrice.Amount)
+	quote, err = e.client.QuotePrice(ctx, &pb.QuotePriceRequest{ProductId: productID, AtTimestamp: e.clock.Now().Add(-time.Minute).Unix(), CustomerGroup: "wholesale"})
+	require.NoError(t, err)
+	require.Equal(t, hi.PriceListId, quote.Quote.PriceListId)
+
+	_, err = e.client.RemovePriceListEntry(ctx, &pb.RemovePriceListEntryRequest{PriceListId: lo.PriceListId, ProductId: productID, Currency: "USD"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = e.client.GetProduct(ctx, &pb.GetProductRequest{ProductId: productID, PriceListId: "nope"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = e.client.CreatePriceList(ctx, &pb.CreatePriceListRequest{Name: "Bad", CustomerGroup: "Not A Group"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGRPC_Variants(t *testing.T) {
+	ctx := context.Background()
+	e := newGRPCEnv(ctx, t)
+	productID := e.createProduct(ctx, t, "electronics", 44999, 100)
+
+	// Variants carry their own SKU and, optionally, price
+	red, err := e.client.AddVariant(ctx, &pb.AddVariantRequest{ProductId: productID, Sku: "DESK-RED", Attributes: map[string]string{"color": "red"}})
+	require.NoError(t, err)
+	blue, err := e.client.AddVariant(ctx, &pb.AddVariantRequest{ProductId: productID, Sku: "DESK-BLUE", Attributes: map[string]string{"color": "blue"}, PriceNumerator: 46999, PriceDenominator: 100})
+	require.NoError(t, err)
+	_, err = e.client.AddVariant(ctx, &pb.AddVariantRequest{ProductId: productID, Sku: "DESK-RED"})
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+	_, err = e.client.UpdateVariant(ctx, &pb.UpdateVariantRequest{ProductId: productID, VariantId: red.VariantId, Sku: "DESK-RED", Attributes: map[string]string{"color": "red", "finish": "matte"}, Status: "inactive"})
+	require.NoError(t, err)
+	getResp, err := e.client.GetProduct(ctx, &pb.GetProductRequest{ProductId: productID})
+	require.NoError(t, err)
+	require.Len(t, getResp.Variants, 2)
+	require.Equal(t, "DESK-BLUE", getResp.Variants[0].Sku)
+	require.Equal(t, "469.99", getResp.Variants[0].Price)
+	require.Equal(t, "inactive", getResp.Variants[1].Status)
+	require.Equal(t, "matte", getResp.Variants[1].Attributes["finish"])
+	require.Empty(t, getResp.Variants[1].Price)
+
+	_, err = e.client.RemoveVariant(ctx, &pb.RemoveVariantRequest{ProductId: productID, VariantId: blue.VariantId})
+	require.NoError(t, err)
+	_, err = e.client.RemoveVariant(ctx, &pb.RemoveVariantRequest{ProductId: productID, VariantId: blue.VariantId})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	getResp, err = e.client.GetProduct(ctx, &pb.GetProductRequest{ProductId: productID})
+	require.NoError(t, err)
+	require.Len(t, getResp.Variants, 1)
+	require.Equal(t, red.VariantId, getResp.Variants[0].VariantId)
+
+	// A removed variant's SKU is free again
+	_, err = e.client.AddVariant(ctx, &pb.AddVariantRequest{ProductId: productID, Sku: "DESK-BLUE"})
+	require.NoError(t, err)
+}
+
+func TestGRPC_Identifiers(t *testing.T) {
+	ctx := context.Background()
+	e := newGRPCEnv(ctx, t)
+	productID := e.createProduct(ctx, t, "electronics", 44999, 100)
+	_, err := e.client.AddVariant(ctx, &pb.AddVariantRequest{ProductId: productID, Sku: "LAMP-RED", Attributes: map[string]string{"color": "red"}})
+	require.NoError(t, err)
+
+	// SKUs and GTINs are unique, and products can be found by either
+	_, err = e.client.SetProductIdentifiers(ctx, &pb.SetProductIdentifiersRequest{ProductId: productID, Sku: "LAMP", Gtin: "4006381333931"})
+	require.NoError(t, err)
+	_, err = e.client.SetProductIdentifiers(ctx, &pb.SetProductIdentifiersRequest{ProductId: productID, Gtin: "4006381333932"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	for _, req := range []*pb.CreateProductRequest{
+		{Name: "Lamp copy", Category: "electronics", BasePriceNumerator: 1, BasePriceDenominator: 1, Sku: "LAMP"},
+		{Name: "Lamp copy", Category: "electronics", BasePriceNumerator: 1, BasePriceDenominator: 1, Sku: "LAMP-RED"},
+		{Name: "Lamp copy", Category: "electronics", BasePriceNumerator: 1, BasePriceDenominator: 1, Gtin: "04006381333931"},
+	} {
+		_, err = e.client.CreateProduct(ctx, req)
+		require.Equal(t, codes.AlreadyExists, status.Code(err), req.String())
+	}
+	other, err := e.client.CreateProduct(ctx, &pb.CreateProductRequest{Name: "Shade", Category: "electronics", BasePriceNumerator: 20, BasePriceDenominator: 1, Sku: "SHADE", Gtin: "036000291452"})
+	require.NoError(t, err)
+
+	bySku, err := e.client.GetProductBySku(ctx, &pb.GetProductBySkuRequest{Sku: "LAMP-RED"})
+	require.NoError(t, err)
+	require.Equal(t, productID, bySku.ProductId)
+	require.Equal(t, "LAMP", bySku.Sku)
+	require.Equal(t, "04006381333931", bySku.Gtin)
+	byGtin, err := e.client.GetProductBySku(ctx, &pb.GetProductBySkuRequest{Gtin: "00036000291452"})
+	require.NoError(t, err)
+	require.Equal(t, other.ProductId, byGtin.ProductId)
+	_, err = e.client.GetProductBySku(ctx, &pb.GetProductBySkuRequest{Sku: "NOPE"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = e.client.GetProductBySku(ctx, &pb.GetProductBySkuRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	batch, err := e.client.BatchGetProducts(ctx, &pb.BatchGetProductsRequest{ProductIds: []string{other.ProductId, productID}})
+	require.NoError(t, err)
+	require.Len(t, batch.Products, 2)
+	require.Equal(t, "SHADE", batch.Products[0].Sku)
+	require.Equal(t, "LAMP", batch.Products[1].Sku)
+	_, err = e.client.BatchGetProducts(ctx, &pb.BatchGetProductsRequest{ProductIds: []string{productID, "nope"}})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestGRPC_ArchiveRestore(t *testing.T) {
+	ctx := context.Background()
+	e := newGRPCEnv(ctx, t)
+	productID := e.createProduct(ctx, t, "garden", 40, 1)
+
+	_, err := e.client.RestoreProduct(ctx, &pb.RestoreProductRequest{ProductId: productID})
+	require.NoError(t, err)
+	_, err = e.client.ArchiveProduct(ctx, &pb.ArchiveProductRequest{ProductId: productID})
+	require.NoError(t, err)
+	_, err = e.client.RestoreProduct(ctx, &pb.RestoreProductRequest{ProductId: productID})
+	require.NoError(t, err)
+
+	// Past the retention window restores are refused
+	_, err = e.client.ArchiveProduct(ctx, &pb.ArchiveProductRequest{ProductId: productID})
+	require.NoError(t, err)
+	e.clock.now = e.clock.now.Add(30*24*time.Hour + time.Second)
+	_, err = e.client.RestoreProduct(ctx, &pb.RestoreProductRequest{ProductId: productID})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
 }
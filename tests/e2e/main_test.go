@@ -0,0 +1,187 @@
+package e2e
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"cloud.google.com/go/spanner/apiv1/spannerpb"
+	"cloud.google.com/go/spanner/spannertest"
+	"cloud.google.com/go/spanner/spansql"
+	"google.golang.org/api/option"
+	"google.golang.org/grpc"
+)
+
+// fakeColumns holds the columns whose declared type the fake cannot store,
+// keyed by table and then column, with the type code the client should see
+// on reads.
+var fakeColumns = map[string]map[string]spannerpb.TypeCode{}
+
+// TestMain runs the suite against an in-process Spanner fake with every
+// file in migrations/ applied, so no emulator or network is needed.
+func TestMain(m *testing.M) {
+	srv, err := spannertest.NewServer("localhost:0")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "start spanner fake: %v\n", err)
+		os.Exit(1)
+	}
+
+	if err := applyMigrations(srv, filepath.Join("..", "..", "migrations")); err != nil {
+		srv.Close()
+		fmt.Fprintf(os.Stderr, "apply migrations: %v\n", err)
+		os.Exit(1)
+	}
+
+	os.Setenv("SPANNER_EMULATOR_HOST", srv.Addr)
+	code := m.Run()
+	srv.Close()
+	os.Exit(code)
+}
+
+func applyMigrations(srv *spannertest.Server, dir string) error {
+	files, err := filepath.Glob(filepath.Join(dir, "*.sql"))
+	if err != nil {
+		return err
+	}
+	sort.Strings(files)
+
+	for _, f := range files {
+		b, err := os.ReadFile(f)
+		if err != nil {
+			return err
+		}
+		ddl, err := spansql.ParseDDL(filepath.Base(f), string(b))
+		if err != nil {
+			return fmt.Errorf("%s: %w", f, err)
+		}
+		for _, stmt := range ddl.List {
+			switch s := stmt.(type) {
+			case *spansql.CreateTable:
+				for i := range s.Columns {
+					downgradeColumn(string(s.Name), &s.Columns[i])
+				}
+			case *spansql.AlterTable:
+				switch alt := s.Alteration.(type) {
+				case spansql.AddColumn:
+					downgradeColumn(string(s.Name), &alt.Def)
+					s.Alteration = alt
+				case spansql.AlterColumn:
+					if set, ok := alt.Alteration.(spansql.SetColumnType); ok {
+						def := spansql.ColumnDef{Name: alt.Name, Type: set.Type}
+						downgradeColumn(string(s.Name), &def)
+						set.Type = def.Type
+						alt.Alteration = set
+						s.Alteration = alt
+					}
+				}
+			}
+		}
+		if err := srv.UpdateDDL(ddl); err != nil {
+			return fmt.Errorf("%s: %w", f, err)
+		}
+	}
+	return nil
+}
+
+// downgradeColumn declares NUMERIC and JSON columns as STRING(MAX), which
+// spannertest does support. The client already sends both as strings, so
+// writes need no help; reads are fixed up by restoreColumnTypes.
+func downgradeColumn(table string, c *spansql.ColumnDef) {
+	var code spannerpb.TypeCode
+	switch c.Type.Base {
+	case spansql.Numeric:
+		code = spannerpb.TypeCode_NUMERIC
+	case spansql.JSON:
+		code = spannerpb.TypeCode_JSON
+	default:
+		return
+	}
+	if fakeColumns[table] == nil {
+		fakeColumns[table] = map[string]spannerpb.TypeCode{}
+	}
+	fakeColumns[table][string(c.Name)] = code
+	c.Type.Base = spansql.String
+	c.Type.Len = spansql.MaxLen
+}
+
+// fakeClientOptions makes result sets from the fake report the declared
+// column types, so NullNumeric and NullJSON decode as against real Spanner.
+func fakeClientOptions() []option.ClientOption {
+	return []option.ClientOption{
+		option.WithGRPCDialOption(grpc.WithChainStreamInterceptor(restoreColumnTypes)),
+	}
+}
+
+func restoreColumnTypes(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	cs, err := streamer(ctx, desc, cc, method, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &typedStream{ClientStream: cs}, nil
+}
+
+// typedStream remembers which tables its request reads, so that only
+// their downgraded columns are restored.
+type typedStream struct {
+	grpc.ClientStream
+	tables []string
+}
+
+func (s *typedStream) SendMsg(m any) error {
+	switch req := m.(type) {
+	case *spannerpb.ReadRequest:
+		s.tables = []string{req.Table}
+	case *spannerpb.ExecuteSqlRequest:
+		s.tables = queryTables(req.Sql)
+	}
+	return s.ClientStream.SendMsg(m)
+}
+
+func (s *typedStream) RecvMsg(m any) error {
+	if err := s.ClientStream.RecvMsg(m); err != nil {
+		return err
+	}
+	prs, ok := m.(*spannerpb.PartialResultSet)
+	if !ok || prs.GetMetadata().GetRowType() == nil {
+		return nil
+	}
+	for _, f := range prs.Metadata.RowType.Fields {
+		if f.Type.GetCode() != spannerpb.TypeCode_STRING {
+			continue
+		}
+		for _, t := range s.tables {
+			if code, ok := fakeColumns[t][f.Name]; ok {
+				f.Type.Code = code
+				break
+			}
+		}
+	}
+	return nil
+}
+
+// queryTables lists the tables a query selects from, or none if the fake's
+// parser cannot read it.
+func queryTables(sql string) []string {
+	q, err := spansql.ParseQuery(sql)
+	if err != nil {
+		return nil
+	}
+	var tables []string
+	var walk func(spansql.SelectFrom)
+	walk = func(sf spansql.SelectFrom) {
+		switch sf := sf.(type) {
+		case spansql.SelectFromTable:
+			tables = append(tables, string(sf.Table))
+		case spansql.SelectFromJoin:
+			walk(sf.LHS)
+			walk(sf.RHS)
+		}
+	}
+	for _, sf := range q.Select.From {
+		walk(sf)
+	}
+	return tables
+}
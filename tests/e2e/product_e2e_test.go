@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"math/big"
-	"os"
 	"testing"
 	"time"
 
@@ -14,13 +13,22 @@ import (
 	"github.com/stretchr/testify/require"
 	"google.golang.org/api/iterator"
 
+	"product-catalog-service/internal/app/product/contracts"
 	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/app/product/domain/services"
+	"product-catalog-service/internal/app/product/queries/quote_price"
 	"product-catalog-service/internal/app/product/repo"
+	"product-catalog-service/internal/app/product/sweeper"
 	"product-catalog-service/internal/app/product/usecases/activate_product"
+	"product-catalog-service/internal/app/product/usecases/advance_discounts"
 	"product-catalog-service/internal/app/product/usecases/apply_discount"
+	"product-catalog-service/internal/app/product/usecases/archive_product"
 	"product-catalog-service/internal/app/product/usecases/create_product"
+	"product-catalog-service/internal/app/product/usecases/restore_product"
+	"product-catalog-service/internal/app/product/usecases/set_price"
 	"product-catalog-service/internal/infra/spannerx"
 	"product-catalog-service/internal/pkg/committer"
+	"product-catalog-service/internal/pkg/pagetoken"
 )
 
 const (
@@ -30,12 +38,8 @@ const (
 )
 
 func getSpannerClient(ctx context.Context, t *testing.T) *spanner.Client {
-	if os.Getenv("SPANNER_EMULATOR_HOST") == "" {
-		os.Setenv("SPANNER_EMULATOR_HOST", "localhost:9010")
-	}
-
 	dbPath := fmt.Sprintf("projects/%s/instances/%s/databases/%s", projectID, instanceID, databaseID)
-	client, err := spanner.NewClient(ctx, dbPath)
+	client, err := spanner.NewClient(ctx, dbPath, fakeClientOptions()...)
 	require.NoError(t, err)
 	return client
 }
@@ -48,23 +52,6 @@ func (c *testClock) Now() time.Time {
 	return c.now
 }
 
-type spannerCommitter struct {
-	client *spanner.Client
-}
-
-func (s *spannerCommitter) Apply(ctx context.Context, plan *committer.Plan) error {
-	_, err := s.client.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
-		var spannerMutations []*spanner.Mutation
-		for _, m := range plan.Mutations() {
-			if sm, ok := m.(spannerx.Mutation); ok {
-				spannerMutations = append(spannerMutations, sm.M)
-			}
-		}
-		return txn.BufferWrite(spannerMutations)
-	})
-	return err
-}
-
 func TestProductCreationFlow(t *testing.T) {
 	ctx := context.Background()
 	client := getSpannerClient(ctx, t)
@@ -72,12 +59,13 @@ func TestProductCreationFlow(t *testing.T) {
 
 	// Dependencies
 	clk := &testClock{now: time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC)}
-	comm := &spannerCommitter{client: client}
+	comm := spannerx.NewCommitter(client)
 	productRepo := repo.NewProductRepo(client, clk)
 	outboxRepo := repo.NewOutboxRepo(clk)
+	historyRepo := repo.NewPriceHistoryRepo(client, clk)
 
 	// Usecase
-	uc := create_product.New(productRepo, outboxRepo, comm, clk)
+	uc := create_product.New(productRepo, outboxRepo, historyRepo, comm, clk)
 
 	// Data
 	productID := uuid.NewString()
@@ -119,12 +107,13 @@ func TestDiscountApplicationFlow(t *testing.T) {
 
 	// Dependencies
 	clk := &testClock{now: time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC)}
-	comm := &spannerCommitter{client: client}
+	comm := spannerx.NewCommitter(client)
 	productRepo := repo.NewProductRepo(client, clk)
 	outboxRepo := repo.NewOutboxRepo(clk)
+	historyRepo := repo.NewPriceHistoryRepo(client, clk)
 
-	createUc := create_product.New(productRepo, outboxRepo, comm, clk)
-	discountUc := apply_discount.New(productRepo, outboxRepo, comm, clk)
+	createUc := create_product.New(productRepo, outboxRepo, historyRepo, comm, clk)
+	discountUc := apply_discount.New(productRepo, outboxRepo, historyRepo, comm, clk)
 
 	// 1. Create Product
 	productID := uuid.NewString()
@@ -146,18 +135,224 @@ func TestDiscountApplicationFlow(t *testing.T) {
 	// 50% discount
 	discountPercent := big.NewRat(50, 100)
 	err = discountUc.Execute(ctx, apply_discount.Request{
-		ProductID: productID,
-		Percent:   discountPercent,
-		Start:     clk.Now().Add(-1 * time.Hour), // Started 1 hour ago
-		End:       clk.Now().Add(24 * time.Hour), // Ends tomorrow
+		ProductID:  productID,
+		DiscountID: "d1",
+		Percent:    discountPercent,
+		Start:      clk.Now().Add(-1 * time.Hour), // Started 1 hour ago
+		End:        clk.Now().Add(24 * time.Hour), // Ends tomorrow
+	})
+	require.NoError(t, err)
+
+	p, err := productRepo.GetByID(ctx, productID)
+	require.NoError(t, err)
+
+	ds := p.DiscountsAt(clk.Now())
+	require.Len(t, ds, 1)
+	d := ds[0]
+	assert.Equal(t, "d1", d.ID())
+	assert.Equal(t, domain.DiscountActive, d.Status())
+	assert.Equal(t, "1/2", d.Percent().String())
+}
+
+func TestFixedAmountDiscountRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	client := getSpannerClient(ctx, t)
+	defer client.Close()
+
+	clk := &testClock{now: time.Date(2023, 4, 1, 12, 0, 0, 0, time.UTC)}
+	comm := spannerx.NewCommitter(client)
+	productRepo := repo.NewProductRepo(client, clk)
+	outboxRepo := repo.NewOutboxRepo(clk)
+	historyRepo := repo.NewPriceHistoryRepo(client, clk)
+	readModel := repo.NewSpannerReadModel(client, clk, pagetoken.NewCodec([]byte("e2e")), services.NewCurrencyConverter(domain.DefaultRounding), 30, domain.TaxModeNet)
+
+	productID := uuid.NewString()
+	basePrice, _ := domain.NewMoneyFromFraction(1999, 100)
+	_, err := create_product.New(productRepo, outboxRepo, historyRepo, comm, clk).Execute(ctx, create_product.Request{
+		ID:        productID,
+		Name:      "Fixed Discount Product",
+		Category:  "books",
+		BasePrice: basePrice,
+	})
+	require.NoError(t, err)
+	require.NoError(t, activate_product.New(productRepo, outboxRepo, comm, clk).Execute(ctx, activate_product.Request{ProductID: productID}))
+
+	off := big.NewRat(5, 1)
+	err = apply_discount.New(productRepo, outboxRepo, historyRepo, comm, clk).Execute(ctx, apply_discount.Request{
+		ProductID:  productID,
+		DiscountID: "five-off",
+		Kind:       domain.DiscountFixedAmount,
+		Amount:     off,
+		Start:      clk.Now().Add(-time.Hour),
+		End:        clk.Now().Add(time.Hour),
+	})
+	require.NoError(t, err)
+
+	p, err := productRepo.GetByID(ctx, productID)
+	require.NoError(t, err)
+	require.Len(t, p.Discounts(), 1)
+	assert.Equal(t, domain.DiscountFixedAmount, p.Discounts()[0].Kind())
+	assert.Nil(t, p.Discounts()[0].Percent())
+	assert.Equal(t, "5", p.Discounts()[0].Amount().Rat().RatString())
+
+	dto, err := readModel.GetProduct(ctx, productID, "", "", contracts.PriceListSelector{})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1499), dto.EffectiveNum)
+	assert.Equal(t, int64(100), dto.EffectiveDen)
+	require.Len(t, dto.Discounts, 1)
+	assert.Equal(t, "fixed_amount", dto.Discounts[0].Kind)
+	assert.Empty(t, dto.Discounts[0].Percent)
+}
+
+func TestProductPricesPerCurrency(t *testing.T) {
+	ctx := context.Background()
+	client := getSpannerClient(ctx, t)
+	defer client.Close()
+
+	clk := &testClock{now: time.Date(2023, 4, 1, 12, 0, 0, 0, time.UTC)}
+	comm := spannerx.NewCommitter(client)
+	productRepo := repo.NewProductRepo(client, clk)
+	outboxRepo := repo.NewOutboxRepo(clk)
+	historyRepo := repo.NewPriceHistoryRepo(client, clk)
+	readModel := repo.NewSpannerReadModel(client, clk, pagetoken.NewCodec([]byte("e2e")), services.NewCurrencyConverter(domain.DefaultRounding), 30, domain.TaxModeNet)
+
+	productID := uuid.NewString()
+	basePrice, _ := domain.NewMoneyFromFractionIn(1999, 100, "GBP")
+	_, err := create_product.New(productRepo, outboxRepo, historyRepo, comm, clk).Execute(ctx, create_product.Request{
+		ID:        productID,
+		Name:      "Multi-currency Product",
+		Category:  "books",
+		BasePrice: basePrice,
+	})
+	require.NoError(t, err)
+	require.NoError(t, activate_product.New(productRepo, outboxRepo, comm, clk).Execute(ctx, activate_product.Request{ProductID: productID}))
+
+	usd, _ := domain.NewMoneyFromFractionIn(2499, 100, "USD")
+	require.NoError(t, set_price.New(productRepo, outboxRepo, historyRepo, comm, clk).Execute(ctx, set_price.Request{ProductID: productID, Price: usd}))
+
+	err = apply_discount.New(productRepo, outboxRepo, historyRepo, comm, clk).Execute(ctx, apply_discount.Request{
+		ProductID:      productID,
+		DiscountID:     "usd-off",
+		Kind:           domain.DiscountFixedAmount,
+		Amount:         big.NewRat(5, 1),
+		AmountCurrency: "USD",
+		Start:          clk.Now().Add(-time.Hour),
+		End:            clk.Now().Add(time.Hour),
 	})
 	require.NoError(t, err)
 
 	p, err := productRepo.GetByID(ctx, productID)
 	require.NoError(t, err)
+	assert.Equal(t, domain.Currency("GBP"), p.BasePrice().Currency())
+	require.Len(t, p.Prices(), 2)
+	assert.Equal(t, domain.Currency("USD"), p.Discounts()[0].Amount().Currency())
+
+	dto, err := readModel.GetProduct(ctx, productID, "USD", "", contracts.PriceListSelector{})
+	require.NoError(t, err)
+	assert.Equal(t, "USD", dto.Currency)
+	assert.Equal(t, int64(1999), dto.EffectiveNum)
+	assert.Equal(t, int64(100), dto.EffectiveDen)
+
+	// The USD discount does not touch the GBP price.
+	dto, err = readModel.GetProduct(ctx, productID, "", "", contracts.PriceListSelector{})
+	require.NoError(t, err)
+	assert.Equal(t, "GBP", dto.Currency)
+	assert.Equal(t, int64(1999), dto.EffectiveNum)
+	assert.False(t, dto.DiscountActive)
+}
+
+func TestScheduledDiscountSweep(t *testing.T) {
+	ctx := context.Background()
+	client := getSpannerClient(ctx, t)
+	defer client.Close()
+
+	clk := &testClock{now: time.Date(2023, 3, 1, 12, 0, 0, 0, time.UTC)}
+	comm := spannerx.NewCommitter(client)
+	productRepo := repo.NewProductRepo(client, clk)
+	outboxRepo := repo.NewOutboxRepo(clk)
+	historyRepo := repo.NewPriceHistoryRepo(client, clk)
+	readModel := repo.NewSpannerReadModel(client, clk, pagetoken.NewCodec([]byte("e2e")), services.NewCurrencyConverter(domain.DefaultRounding), 30, domain.TaxModeNet)
+
+	productID := uuid.NewString()
+	basePrice, _ := domain.NewMoneyFromFraction(100, 1)
+	_, err := create_product.New(productRepo, outboxRepo, historyRepo, comm, clk).Execute(ctx, create_product.Request{
+		ID:        productID,
+		Name:      "Scheduled Product",
+		Category:  "books",
+		BasePrice: basePrice,
+	})
+	require.NoError(t, err)
+	require.NoError(t, activate_product.New(productRepo, outboxRepo, comm, clk).Execute(ctx, activate_product.Request{ProductID: productID}))
+
+	start := clk.Now().Add(time.Hour)
+	err = apply_discount.New(productRepo, outboxRepo, historyRepo, comm, clk).Execute(ctx, apply_discount.Request{
+		ProductID:  productID,
+		DiscountID: "d1",
+		Percent:    big.NewRat(10, 1),
+		Start:      start,
+		End:        start.Add(time.Hour),
+	})
+	require.NoError(t, err)
+
+	dto, err := readModel.GetProduct(ctx, productID, "", "", contracts.PriceListSelector{})
+	require.NoError(t, err)
+	require.Len(t, dto.Discounts, 1)
+	assert.Equal(t, "scheduled", dto.Discounts[0].Status)
+	assert.False(t, dto.DiscountActive)
+
+	s := sweeper.New(repo.NewDiscountSchedule(client), advance_discounts.New(productRepo, outboxRepo, historyRepo, comm, clk), clk, sweeper.Config{})
+
+	clk.now = start
+	_, err = s.RunOnce(ctx)
+	require.NoError(t, err)
+
+	dto, err = readModel.GetProduct(ctx, productID, "", "", contracts.PriceListSelector{})
+	require.NoError(t, err)
+	require.Len(t, dto.Discounts, 1)
+	assert.Equal(t, "active", dto.Discounts[0].Status)
+	assert.True(t, dto.DiscountActive)
+
+	clk.now = start.Add(time.Hour)
+	_, err = s.RunOnce(ctx)
+	require.NoError(t, err)
+
+	p, err := productRepo.GetByID(ctx, productID)
+	require.NoError(t, err)
+	require.Len(t, p.Discounts(), 1)
+	assert.Equal(t, domain.DiscountExpired, p.Discounts()[0].Status())
+
+	dto, err = readModel.GetProduct(ctx, productID, "", "", contracts.PriceListSelector{})
+	require.NoError(t, err)
+	assert.Empty(t, dto.Discounts)
+	assert.False(t, dto.DiscountActive)
+
+	// A quote inside the window the discount covered still applies it.
+	quotes := quote_price.New(productRepo, repo.NewPriceListRepo(client, clk), repo.NewExchangeRateRepo(client, clk), repo.NewTaxRepo(client, clk), services.NewCurrencyConverter(domain.DefaultRounding), domain.TaxModeNet, clk)
+	q, err := quotes.Execute(ctx, productID, start.Add(30*time.Minute), "", "", 1, contracts.PriceListSelector{})
+	require.NoError(t, err)
+	require.Len(t, q.Applied, 1)
+	assert.Equal(t, "d1", q.Applied[0].Discount.ID())
+	q, err = quotes.Execute(ctx, productID, time.Time{}, "", "", 1, contracts.PriceListSelector{})
+	require.NoError(t, err)
+	assert.Empty(t, q.Applied)
 
-	require.NotNil(t, p.Discount())
-	assert.Equal(t, "1/2", p.Discount().Percent().String())
+	// Once past the retention the expired discount is due for pruning
+	clk.now = start.Add(2 * time.Hour)
+	due, err := repo.NewDiscountSchedule(client).DueProducts(ctx, clk.now, start.Add(2*time.Hour), 100)
+	require.NoError(t, err)
+	assert.Contains(t, due, productID)
+	require.NoError(t, advance_discounts.New(productRepo, outboxRepo, historyRepo, comm, clk).Execute(ctx, advance_discounts.Request{ProductID: productID, PruneBefore: clk.now}))
+	p, err = productRepo.GetByID(ctx, productID)
+	require.NoError(t, err)
+	assert.Empty(t, p.Discounts())
+
+	var types []string
+	for _, e := range getOutboxEvents(ctx, t, client, productID) {
+		types = append(types, e.EventType)
+	}
+	assert.Contains(t, types, "discount.scheduled")
+	assert.Contains(t, types, "discount.started")
+	assert.Contains(t, types, "discount.expired")
 }
 
 // Helper to query outbox events
@@ -199,11 +394,12 @@ func TestProductActivationDeactivation(t *testing.T) {
 
 	// Dependencies
 	clk := &testClock{now: time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC)}
-	comm := &spannerCommitter{client: client}
+	comm := spannerx.NewCommitter(client)
 	productRepo := repo.NewProductRepo(client, clk)
 	outboxRepo := repo.NewOutboxRepo(clk)
+	historyRepo := repo.NewPriceHistoryRepo(client, clk)
 
-	createUc := create_product.New(productRepo, outboxRepo, comm, clk)
+	createUc := create_product.New(productRepo, outboxRepo, historyRepo, comm, clk)
 	activateUc := activate_product.New(productRepo, outboxRepo, comm, clk)
 
 	// Create product
@@ -242,6 +438,105 @@ func TestProductActivationDeactivation(t *testing.T) {
 	assert.Contains(t, types, "product.activated")
 }
 
+func TestProductArchiveRestore(t *testing.T) {
+	ctx := context.Background()
+	client := getSpannerClient(ctx, t)
+	defer client.Close()
+
+	// Dependencies
+	clk := &testClock{now: time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC)}
+	comm := spannerx.NewCommitter(client)
+	productRepo := repo.NewProductRepo(client, clk)
+	outboxRepo := repo.NewOutboxRepo(clk)
+	historyRepo := repo.NewPriceHistoryRepo(client, clk)
+
+	createUc := create_product.New(productRepo, outboxRepo, historyRepo, comm, clk)
+	archiveUc := archive_product.New(productRepo, outboxRepo, comm, clk)
+	restoreUc := restore_product.New(productRepo, outboxRepo, comm, clk, 30*24*time.Hour)
+
+	productID := uuid.NewString()
+	basePrice, _ := domain.NewMoneyFromFraction(100, 1)
+	_, err := createUc.Execute(ctx, create_product.Request{
+		ID:        productID,
+		Name:      "Test Product",
+		Category:  "test",
+		BasePrice: basePrice,
+	})
+	require.NoError(t, err)
+
+	// Restoring a product that was never archived writes nothing
+	require.NoError(t, restoreUc.Execute(ctx, restore_product.Request{ProductID: productID}))
+	p, _ := productRepo.GetByID(ctx, productID)
+	assert.Nil(t, p.ArchivedAt())
+	assert.Equal(t, int64(1), p.Version())
+
+	// Archive, then restore on the last day of the window
+	require.NoError(t, archiveUc.Execute(ctx, archive_product.Request{ProductID: productID}))
+	p, _ = productRepo.GetByID(ctx, productID)
+	require.NotNil(t, p.ArchivedAt())
+	assert.Equal(t, clk.now, *p.ArchivedAt())
+
+	clk.now = clk.now.Add(30 * 24 * time.Hour)
+	require.NoError(t, restoreUc.Execute(ctx, restore_product.Request{ProductID: productID}))
+	p, _ = productRepo.GetByID(ctx, productID)
+	assert.Nil(t, p.ArchivedAt())
+
+	// Once the window has passed the product stays archived
+	require.NoError(t, archiveUc.Execute(ctx, archive_product.Request{ProductID: productID}))
+	clk.now = clk.now.Add(30*24*time.Hour + time.Second)
+	err = restoreUc.Execute(ctx, restore_product.Request{ProductID: productID})
+	require.ErrorIs(t, err, domain.ErrRestoreWindowExpired)
+	p, _ = productRepo.GetByID(ctx, productID)
+	assert.NotNil(t, p.ArchivedAt())
+
+	types := make([]string, 0)
+	for _, e := range getOutboxEvents(ctx, t, client, productID) {
+		types = append(types, e.EventType)
+	}
+	assert.ElementsMatch(t, []string{"product.created", "product.archived", "product.restored", "product.archived"}, types)
+}
+
+func TestStaleWriteRejected(t *testing.T) {
+	ctx := context.Background()
+	client := getSpannerClient(ctx, t)
+	defer client.Close()
+
+	clk := &testClock{now: time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC)}
+	comm := spannerx.NewCommitter(client)
+	productRepo := repo.NewProductRepo(client, clk)
+	outboxRepo := repo.NewOutboxRepo(clk)
+	historyRepo := repo.NewPriceHistoryRepo(client, clk)
+
+	productID := uuid.NewString()
+	basePrice, _ := domain.NewMoneyFromFraction(100, 1)
+	_, err := create_product.New(productRepo, outboxRepo, historyRepo, comm, clk).Execute(ctx, create_product.Request{
+		ID:        productID,
+		Name:      "Test Product",
+		Category:  "test",
+		BasePrice: basePrice,
+	})
+	require.NoError(t, err)
+
+	// Two writers load the same version; the version guard lets only the
+	// first one commit
+	plans := make([]*committer.Plan, 2)
+	for i, name := range []string{"First", "Second"} {
+		p, err := productRepo.GetByID(ctx, productID)
+		require.NoError(t, err)
+		require.NoError(t, p.UpdateDetails(name, "", "test", clk.Now()))
+		plans[i] = committer.NewPlan()
+		plans[i].Add(productRepo.UpdateMut(p))
+	}
+	require.NoError(t, comm.Apply(ctx, plans[0]))
+	err = comm.Apply(ctx, plans[1])
+	require.ErrorIs(t, err, committer.ErrConcurrentModification)
+
+	p, err := productRepo.GetByID(ctx, productID)
+	require.NoError(t, err)
+	assert.Equal(t, "First", p.Name())
+	assert.Equal(t, int64(2), p.Version())
+}
+
 func TestBusinessRuleValidation(t *testing.T) {
 	ctx := context.Background()
 	client := getSpannerClient(ctx, t)
@@ -249,12 +544,13 @@ func TestBusinessRuleValidation(t *testing.T) {
 
 	// Dependencies
 	clk := &testClock{now: time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC)}
-	comm := &spannerCommitter{client: client}
+	comm := spannerx.NewCommitter(client)
 	productRepo := repo.NewProductRepo(client, clk)
 	outboxRepo := repo.NewOutboxRepo(clk)
+	historyRepo := repo.NewPriceHistoryRepo(client, clk)
 
-	createUc := create_product.New(productRepo, outboxRepo, comm, clk)
-	discountUc := apply_discount.New(productRepo, outboxRepo, comm, clk)
+	createUc := create_product.New(productRepo, outboxRepo, historyRepo, comm, clk)
+	discountUc := apply_discount.New(productRepo, outboxRepo, historyRepo, comm, clk)
 
 	// Create inactive product
 	productID := uuid.NewString()
@@ -270,13 +566,71 @@ func TestBusinessRuleValidation(t *testing.T) {
 	// Try to apply discount to inactive product - should fail
 	discountPercent := big.NewRat(10, 100)
 	err = discountUc.Execute(ctx, apply_discount.Request{
-		ProductID: productID,
-		Percent:   discountPercent,
-		Start:     clk.Now().Add(-1 * time.Hour),
-		End:       clk.Now().Add(24 * time.Hour),
+		ProductID:  productID,
+		DiscountID: "d1",
+		Percent:    discountPercent,
+		Start:      clk.Now().Add(-1 * time.Hour),
+		End:        clk.Now().Add(24 * time.Hour),
 	})
 
 	// Verify error
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "not active")
 }
+
+func TestListProductsPagination(t *testing.T) {
+	ctx := context.Background()
+	client := getSpannerClient(ctx, t)
+	defer client.Close()
+
+	// Dependencies
+	clk := &testClock{now: time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC)}
+	comm := spannerx.NewCommitter(client)
+	productRepo := repo.NewProductRepo(client, clk)
+	outboxRepo := repo.NewOutboxRepo(clk)
+	historyRepo := repo.NewPriceHistoryRepo(client, clk)
+	readModel := repo.NewSpannerReadModel(client, clk, pagetoken.NewCodec([]byte("e2e")), services.NewCurrencyConverter(domain.DefaultRounding), 30, domain.TaxModeNet)
+
+	createUc := create_product.New(productRepo, outboxRepo, historyRepo, comm, clk)
+
+	// Same created_at for every row, so ordering relies on the product_id tie-break
+	category := "paging-" + uuid.NewString()
+	created := map[string]bool{}
+	for i := 0; i < 5; i++ {
+		basePrice, _ := domain.NewMoneyFromFraction(10, 1)
+		id, err := createUc.Execute(ctx, create_product.Request{
+			ID:        uuid.NewString(),
+			Name:      fmt.Sprintf("Product %d", i),
+			Category:  category,
+			BasePrice: basePrice,
+		})
+		require.NoError(t, err)
+		created[id] = true
+	}
+
+	// Walk all pages
+	seen := map[string]bool{}
+	token := ""
+	pages := 0
+	for {
+		res, err := readModel.ListProducts(ctx, contracts.ListProductsFilter{Category: category, Limit: 2, PageToken: token})
+		require.NoError(t, err)
+		pages++
+		for _, p := range res.Items {
+			assert.False(t, seen[p.ID], "product %s returned twice", p.ID)
+			seen[p.ID] = true
+		}
+		if res.NextPageToken == "" {
+			break
+		}
+		token = res.NextPageToken
+	}
+	assert.Equal(t, 3, pages)
+	assert.Equal(t, created, seen)
+
+	// A token is bound to the filter it was issued for
+	first, err := readModel.ListProducts(ctx, contracts.ListProductsFilter{Category: category, Limit: 2})
+	require.NoError(t, err)
+	_, err = readModel.ListProducts(ctx, contracts.ListProductsFilter{Category: "other", Limit: 2, PageToken: first.NextPageToken})
+	require.ErrorIs(t, err, pagetoken.ErrInvalid)
+}